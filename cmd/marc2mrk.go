@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	cmd "github.com/gsiems/go-marc21/cmd/pkg"
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+func main() {
+
+	flag.Parse()
+
+	marcfile := flag.Arg(0)
+	if marcfile == "" {
+		showHelp()
+	}
+
+	fi, err := os.Open(marcfile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer fi.Close()
+
+	w := marc21.NewMRKWriter(os.Stdout)
+
+	rdr := marc21.NewReader(fi)
+	for rdr.Next() {
+		cmd.ReportWarnings(rdr)
+
+		rec := rdr.Record()
+		if rec == nil {
+			break
+		}
+
+		err := w.Write(rec)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := rdr.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	err = w.Flush()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func showHelp() {
+	fmt.Println(os.Args[0])
+	fmt.Println("   Converts a MARC file to MARCMaker (.mrk) text.")
+	fmt.Printf("    Usage: %s <MARC file to convert>\n", os.Args[0])
+	fmt.Println()
+	os.Exit(0)
+}
@@ -1,61 +1,93 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
 
+	cmd "github.com/gsiems/go-marc21/cmd/pkg"
 	"github.com/gsiems/go-marc21/pkg/marc21"
 )
 
 func main() {
 
-	var marcfile string
-	if len(os.Args) > 1 {
-		marcfile = os.Args[1]
-	}
+	var skipCorrupt bool
+	var quarantineFile string
+	var lenient bool
+	var defaultNamespace bool
+	var escapeIllegal bool
+
+	flag.BoolVar(&skipCorrupt, "s", false, "Skip over corrupt records rather than stopping.")
+	flag.StringVar(&quarantineFile, "q", "", "The file to write any skipped data to (implies -s).")
+	flag.BoolVar(&lenient, "l", false, "Recover what can be read from records that have structural problems (the problems are logged).")
+	flag.BoolVar(&defaultNamespace, "n", false, "Use the MARCXML namespace as the default namespace rather than the marc: prefix.")
+	flag.BoolVar(&escapeIllegal, "e", false, "Escape characters that are not allowed in XML 1.0 (as private use characters that are restored when the MARCXML is read by this package) rather than removing them.")
+	flag.Parse()
 
+	marcfile := flag.Arg(0)
 	if marcfile == "" {
 		showHelp()
 	}
 
 	fi, err := os.Open(marcfile)
 	if err != nil {
-		log.Fatal(fmt.Printf("File open failed: %q", err))
+		log.Fatalf("File open failed: %q", err)
+	}
+	defer fi.Close()
+
+	qf := cmd.OpenQuarantine(quarantineFile)
+	if qf != nil {
+		defer cmd.CloseFile(qf)
+	}
+
+	w := marc21.NewXMLWriter(os.Stdout)
+	if defaultNamespace {
+		w.Prefix = ""
+	}
+	if escapeIllegal {
+		w.IllegalChars = marc21.EscapeIllegal
+	}
+
+	rdr := marc21.NewReader(fi)
+	rdr.SkipCorrupt = skipCorrupt || qf != nil
+	if lenient {
+		rdr.Mode = marc21.Lenient
+	}
+	if qf != nil {
+		rdr.Quarantine = qf
 	}
-	defer func() {
-		if cerr := fi.Close(); cerr != nil && err == nil {
-			err = cerr
-		}
-	}()
 
-	fmt.Print(marc21.CollectionXMLHeader)
+	for rdr.Next() {
+		cmd.ReportSkipped(rdr.Skipped())
+		cmd.ReportWarnings(rdr)
 
-	for {
-		rec, err := marc21.ParseNextRecord(fi)
-		if err == io.EOF {
+		rec := rdr.Record()
+		if rec == nil {
 			break
 		}
-		if err != nil {
-			log.Fatal(err)
-		}
 
-		recxml, err := rec.AsXML()
+		err := w.Write(rec)
 		if err != nil {
 			log.Fatal(err)
 		}
-
-		fmt.Print(recxml)
+	}
+	cmd.ReportSkipped(rdr.Skipped())
+	if err := rdr.Err(); err != nil {
+		log.Fatal(err)
 	}
 
-	fmt.Print(marc21.CollectionXMLFooter)
+	err = w.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
 }
 
 func showHelp() {
 	fmt.Println(os.Args[0])
 	fmt.Println("   Converts a MARC file to MARCXML.")
-	fmt.Printf("    Usage: %s <MARC file to convert>\n", os.Args[0])
+	fmt.Printf("    Usage: %s [-s] [-q <quarantine file>] [-l] [-n] [-e] <MARC file to convert>\n", os.Args[0])
+	flag.PrintDefaults()
 	fmt.Println()
 	os.Exit(0)
 }
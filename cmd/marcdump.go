@@ -1,51 +1,72 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
 
+	cmd "github.com/gsiems/go-marc21/cmd/pkg"
 	"github.com/gsiems/go-marc21/pkg/marc21"
 )
 
 func main() {
 
-	var marcfile string
-	if len(os.Args) > 1 {
-		marcfile = os.Args[1]
-	}
+	var skipCorrupt bool
+	var quarantineFile string
+	var lenient bool
+
+	flag.BoolVar(&skipCorrupt, "s", false, "Skip over corrupt records rather than stopping.")
+	flag.StringVar(&quarantineFile, "q", "", "The file to write any skipped data to (implies -s).")
+	flag.BoolVar(&lenient, "l", false, "Recover what can be read from records that have structural problems (the problems are logged).")
+	flag.Parse()
 
+	marcfile := flag.Arg(0)
 	if marcfile == "" {
 		showHelp()
 	}
 
 	fi, err := os.Open(marcfile)
 	if err != nil {
-		log.Fatal(fmt.Printf("File open failed: %q", err))
+		log.Fatalf("File open failed: %q", err)
 	}
-	defer func() {
-		if cerr := fi.Close(); cerr != nil && err == nil {
-			err = cerr
-		}
-	}()
+	defer fi.Close()
 
-	for {
-		rec, err := marc21.ParseNextRecord(fi)
-		if err == io.EOF {
+	qf := cmd.OpenQuarantine(quarantineFile)
+	if qf != nil {
+		defer cmd.CloseFile(qf)
+	}
+
+	rdr := marc21.NewReader(fi)
+	rdr.SkipCorrupt = skipCorrupt || qf != nil
+	if lenient {
+		rdr.Mode = marc21.Lenient
+	}
+	if qf != nil {
+		rdr.Quarantine = qf
+	}
+
+	for rdr.Next() {
+		cmd.ReportSkipped(rdr.Skipped())
+		cmd.ReportWarnings(rdr)
+
+		rec := rdr.Record()
+		if rec == nil {
 			break
 		}
-		if err != nil {
-			log.Fatal(err)
-		}
 		fmt.Println(rec)
 	}
+	cmd.ReportSkipped(rdr.Skipped())
+	if err := rdr.Err(); err != nil {
+		log.Fatal(err)
+	}
 }
 
 func showHelp() {
 	fmt.Println(os.Args[0])
 	fmt.Println("   Dumps a MARC file as \"pretty printed\" text.")
-	fmt.Printf("    Usage: %s <MARC file to dump>\n", os.Args[0])
+	fmt.Printf("    Usage: %s [-s] [-q <quarantine file>] [-l] <MARC file to dump>\n", os.Args[0])
+	flag.PrintDefaults()
 	fmt.Println()
 	os.Exit(0)
 }
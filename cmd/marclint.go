@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	cmd "github.com/gsiems/go-marc21/cmd/pkg"
+	"github.com/gsiems/go-marc21/pkg/marc21"
+	"github.com/gsiems/go-marc21/pkg/marc21/lint"
+)
+
+func main() {
+
+	var skipCorrupt bool
+	var quarantineFile string
+	var lenient bool
+	var errorsOnly bool
+	var disable string
+	var fixFile string
+	var keep string
+	var migrate bool
+
+	flag.BoolVar(&skipCorrupt, "s", false, "Skip over corrupt records rather than stopping.")
+	flag.StringVar(&quarantineFile, "q", "", "The file to write any skipped data to (implies -s).")
+	flag.BoolVar(&lenient, "l", false, "Recover what can be read from records that have structural problems (the problems are logged).")
+	flag.BoolVar(&errorsOnly, "e", false, "Only report errors (not warnings).")
+	flag.StringVar(&disable, "d", "", "A comma separated list of the lint rules (and fixes and migrations) to skip.")
+	flag.StringVar(&fixFile, "f", "", "Fix the records, reporting each change, and write them to the specified file.")
+	flag.BoolVar(&migrate, "m", false, "Also migrate the obsolete fields when fixing.")
+	flag.StringVar(&keep, "k", "first", "Which of the repeated non-repeatable subfields to keep when fixing (first or last).")
+	flag.Parse()
+
+	marcfile := flag.Arg(0)
+	if marcfile == "" {
+		showHelp()
+	}
+
+	linter, err := lint.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fixer, err := lint.NewFixer()
+	if err != nil {
+		log.Fatal(err)
+	}
+	migrator, err := lint.NewMigrator()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !migrate {
+		migrator.Migrations = nil
+	}
+	if keep == "last" {
+		fixer.Keep = lint.KeepLast
+	}
+	if disable != "" {
+		linter.Disable(strings.Split(disable, ",")...)
+		fixer.Disable(strings.Split(disable, ",")...)
+		migrator.Disable(strings.Split(disable, ",")...)
+	}
+
+	fi, err := os.Open(marcfile)
+	if err != nil {
+		log.Fatalf("File open failed: %q", err)
+	}
+	defer fi.Close()
+
+	qf := cmd.OpenQuarantine(quarantineFile)
+	if qf != nil {
+		defer cmd.CloseFile(qf)
+	}
+
+	var w *marc21.Writer
+	if fixFile != "" {
+		out := cmd.OpenOutput(fixFile)
+		defer cmd.CloseFile(out)
+		bw := bufio.NewWriter(out)
+		defer cmd.Flush(bw)
+		w = marc21.NewWriter(bw)
+	}
+
+	rdr := marc21.NewReader(fi)
+	rdr.SkipCorrupt = skipCorrupt || qf != nil
+	if lenient {
+		rdr.Mode = marc21.Lenient
+	}
+	if qf != nil {
+		rdr.Quarantine = qf
+	}
+
+	n := 0
+	for rdr.Next() {
+		cmd.ReportSkipped(rdr.Skipped())
+		cmd.ReportWarnings(rdr)
+
+		rec := rdr.Record()
+		if rec == nil {
+			break
+		}
+		if w != nil {
+			for _, c := range migrator.Migrate(rec) {
+				fmt.Printf("%d: migrated %s\n", n, c)
+			}
+			for _, c := range fixer.Fix(rec) {
+				fmt.Printf("%d: fixed %s\n", n, c)
+			}
+			if err := w.Write(rec); err != nil {
+				log.Fatal(err)
+			}
+		}
+		for _, f := range linter.Lint(rec) {
+			if errorsOnly && f.Severity != lint.Error {
+				continue
+			}
+			fmt.Printf("%d: %s\n", n, f)
+		}
+		n++
+	}
+	cmd.ReportSkipped(rdr.Skipped())
+	if err := rdr.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func showHelp() {
+	fmt.Println(os.Args[0])
+	fmt.Println("   Reports the problems found in the records of a MARC file.")
+	fmt.Printf("    Usage: %s [-s] [-q <quarantine file>] [-l] [-e] [-d <rules>] [-f <fixed file> [-k first|last] [-m]] <MARC file to lint>\n", os.Args[0])
+	flag.PrintDefaults()
+	fmt.Println()
+	fmt.Println("    Rules:")
+	for _, r := range lint.DefaultRules() {
+		fmt.Printf("      %-20s %s\n", r.Name, r.Description)
+	}
+	fmt.Println()
+	fmt.Println("    Fixes:")
+	for _, f := range lint.DefaultFixes() {
+		fmt.Printf("      %-20s %s\n", f.Name, f.Description)
+	}
+	fmt.Println()
+	fmt.Println("    Migrations:")
+	for _, m := range lint.DefaultMigrations() {
+		fmt.Printf("      %-20s %s\n", m.Name, m.Description)
+	}
+	fmt.Println()
+	os.Exit(0)
+}
@@ -3,10 +3,10 @@ package main
 import (
 	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	//
+	cmd "github.com/gsiems/go-marc21/cmd/pkg"
 	"github.com/gsiems/go-marc21/pkg/marc21"
 )
 
@@ -15,54 +15,57 @@ func main() {
 	var recsPerFile int
 	var marcFile string
 	var dir string
+	var skipCorrupt bool
+	var quarantineFile string
 
 	flag.IntVar(&recsPerFile, "c", 1000, "The number of MARC records per output file (defaults to 1000).")
 	flag.StringVar(&marcFile, "m", "", "The file that contains the MARC records.")
 	flag.StringVar(&dir, "d", "mark_split", "The directory to write the output files to (defaults to mark_split).")
+	flag.BoolVar(&skipCorrupt, "s", false, "Skip over corrupt records rather than stopping.")
+	flag.StringVar(&quarantineFile, "q", "", "The file to write any skipped data to (implies -s).")
 	flag.Parse()
 
 	fi, err := os.Open(marcFile)
 	if err != nil {
-		log.Fatal(fmt.Printf("File open failed: %q", err))
+		log.Fatalf("File open failed: %q", err)
 	}
-	defer func() {
-		if cerr := fi.Close(); cerr != nil && err == nil {
-			err = cerr
-		}
-	}()
+	defer fi.Close()
 
 	recCount := 0
 	fOut, fileCount := nextFile(dir, 0)
 
-	for {
-		rawRec, err := marc21.NextRecord(fi)
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			log.Fatal(err)
-		}
+	qf := cmd.OpenQuarantine(quarantineFile)
+	if qf != nil {
+		defer cmd.CloseFile(qf)
+	}
+
+	rdr := marc21.NewReader(fi)
+	rdr.SkipCorrupt = skipCorrupt || qf != nil
+	if qf != nil {
+		rdr.Quarantine = qf
+	}
 
-		if _, err := fOut.Write(rawRec); err != nil {
+	for rdr.Next() {
+		// The records are copied as-is, without being parsed
+		cmd.ReportSkipped(rdr.Skipped())
+
+		if _, err := fOut.Write(rdr.Raw()); err != nil {
 			log.Fatal(err)
 		}
 
 		recCount++
 		if recCount >= recsPerFile {
-			closeFile(fOut)
+			cmd.CloseFile(fOut)
 			fOut, fileCount = nextFile(dir, fileCount)
 			recCount = 0
 		}
 	}
-
-	closeFile(fOut)
-}
-
-func closeFile(f *os.File) {
-	err := f.Close()
-	if err != nil {
+	cmd.ReportSkipped(rdr.Skipped())
+	if err := rdr.Err(); err != nil {
 		log.Fatal(err)
 	}
+
+	cmd.CloseFile(fOut)
 }
 
 func nextFile(dir string, i int) (*os.File, int) {
@@ -70,7 +73,7 @@ func nextFile(dir string, i int) (*os.File, int) {
 	fileName := fmt.Sprintf("%s/%06d.mrc", dir, i)
 	f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0640)
 	if err != nil {
-		log.Fatal(fmt.Printf("File open failed: %q", err))
+		log.Fatalf("File open failed: %q", err)
 	}
 	return f, i
 }
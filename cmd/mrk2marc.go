@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+func main() {
+
+	flag.Parse()
+
+	mrkfile := flag.Arg(0)
+	if mrkfile == "" {
+		showHelp()
+	}
+
+	fi, err := os.Open(mrkfile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer fi.Close()
+
+	out := bufio.NewWriter(os.Stdout)
+	w := marc21.NewWriter(out)
+
+	rdr := marc21.NewMRKReader(fi)
+	for rdr.Next() {
+		err := w.Write(rdr.Record())
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := rdr.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	err = out.Flush()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func showHelp() {
+	fmt.Println(os.Args[0])
+	fmt.Println("   Converts a MARCMaker (.mrk) file to MARC.")
+	fmt.Printf("    Usage: %s <MARCMaker file to convert>\n", os.Args[0])
+	fmt.Println()
+	os.Exit(0)
+}
@@ -0,0 +1,64 @@
+// Helpers shared by the command line tools
+
+package cmd
+
+import (
+	"bufio"
+	"log"
+	"os"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+// OpenOutput creates (or truncates) the named file for writing
+func OpenOutput(fileName string) *os.File {
+	f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		log.Fatalf("File open failed: %q", err)
+	}
+	return f
+}
+
+// OpenQuarantine opens the file that skipped data is written to. There
+// is no quarantine file when fileName is empty.
+func OpenQuarantine(fileName string) *os.File {
+	if fileName == "" {
+		return nil
+	}
+	return OpenOutput(fileName)
+}
+
+// ReportSkipped logs the byte ranges that were skipped over by a Reader
+func ReportSkipped(skips []marc21.Skip) {
+	for _, s := range skips {
+		log.Printf("Skipped %d bytes at offset %d: %v\n", s.Length, s.Offset, s.Err)
+	}
+}
+
+// CloseFile closes a file, stopping on error
+func CloseFile(f *os.File) {
+	err := f.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Flush flushes a buffered writer, stopping on error
+func Flush(w *bufio.Writer) {
+	err := w.Flush()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ReportWarnings logs the structural problems that were found (and
+// recovered from) when parsing the current record of a Reader
+func ReportWarnings(rdr *marc21.Reader) {
+	rec := rdr.Record()
+	if rec == nil {
+		return
+	}
+	for _, w := range rec.Warnings {
+		log.Printf("Record %d at offset %d: %s\n", rdr.Index(), rdr.Offset(), w)
+	}
+}
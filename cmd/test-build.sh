@@ -9,9 +9,18 @@ go build xml2marc.go
 echo "Building marc2xml"
 go build marc2xml.go
 
+echo "Building marclint"
+go build marclint.go
+
 echo "Building marcsplit"
 go build marcsplit.go
 
+echo "Building marc2mrk"
+go build marc2mrk.go
+
+echo "Building mrk2marc"
+go build mrk2marc.go
+
 echo ""
 echo "Testing marcdump"
 time ./marcdump ../git_ignore/malc-20180115.mrc > marcdump.out
@@ -24,9 +33,20 @@ echo ""
 echo "Testing marc2xml"
 time ./marc2xml ../git_ignore/malc-20180112.mrc > marc2xml.out
 
+echo ""
+echo "Testing marclint"
+time ./marclint ../git_ignore/malc-20180115.mrc > marclint.out
+
 echo ""
 echo "Testing marcsplit"
 [ -d split_out ] && rm split_out/*.mrc
 [ -d split_out ] || mkdir split_out
 time ./marcsplit -m ../git_ignore/malc-20180112.mrc -d split_out
 
+echo ""
+echo "Testing marc2mrk"
+time ./marc2mrk ../git_ignore/malc-20180112.mrc > marc2mrk.out
+
+echo ""
+echo "Testing mrk2marc"
+time ./mrk2marc marc2mrk.out > mrk2marc.out
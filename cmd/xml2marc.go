@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bufio"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -10,33 +12,66 @@ import (
 
 func main() {
 
-	var marcfile string
-	if len(os.Args) > 1 {
-		marcfile = os.Args[1]
-	}
+	var policy string
+
+	flag.StringVar(&policy, "p", "reject", "How to handle fields and records that are too long for MARC (reject, truncate, or split).")
+	flag.Parse()
 
+	marcfile := flag.Arg(0)
 	if marcfile == "" {
 		showHelp()
 	}
 
-	c, err := marc21.LoadXML(marcfile)
+	fi, err := os.Open(marcfile)
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer fi.Close()
 
-	for _, rec := range c.Records {
-		marc, err := rec.RecordAsMARC()
+	out := bufio.NewWriter(os.Stdout)
+
+	w := marc21.NewWriter(out)
+	switch policy {
+	case "reject":
+		w.Policy = marc21.RejectOversize
+	case "truncate":
+		w.Policy = marc21.TruncateOversize
+	case "split":
+		w.Policy = marc21.SplitOversize
+	default:
+		showHelp()
+	}
+
+	rdr := marc21.NewXMLReader(fi)
+	for rdr.Next() {
+		err := w.Write(rdr.Record())
 		if err != nil {
 			log.Fatal(err)
 		}
-		fmt.Print(string(marc))
+		for _, t := range w.Truncated() {
+			if t.Dropped {
+				log.Printf("Record %d: dropped %s field (%d bytes)\n", rdr.Index(), t.Tag, t.Length)
+			} else {
+				log.Printf("Record %d: truncated %s field (%d bytes)\n", rdr.Index(), t.Tag, t.Length)
+			}
+		}
+	}
+	if err := rdr.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	err = out.Flush()
+	if err != nil {
+		log.Fatal(err)
 	}
+	log.Printf("Wrote %d records (%d bytes, %d truncated)\n", w.Records(), w.Bytes(), w.TruncatedRecords())
 }
 
 func showHelp() {
 	fmt.Println(os.Args[0])
 	fmt.Println("   Converts a MARCXML file to MARC.")
-	fmt.Printf("    Usage: %s <MARCXML file to convert>\n", os.Args[0])
+	fmt.Printf("    Usage: %s [-p reject|truncate|split] <MARCXML file to convert>\n", os.Args[0])
+	flag.PrintDefaults()
 	fmt.Println()
 	os.Exit(0)
 }
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"path/filepath"
+	//
+	codegen "github.com/gsiems/go-marc21/codegen/pkg"
+)
+
+func main() {
+
+	var input string
+	var output string
+
+	flag.StringVar(&input, "i", "input", "The directory containing the saved LoC field list HTML files.")
+	flag.StringVar(&output, "o", "lists", "The directory to write the extracted field lists to.")
+	flag.Parse()
+
+	ldrs := make(map[string]codegen.Ldr)
+	cfs := make(map[string]codegen.CfTags)
+	dfs := make(map[string]codegen.DfTags)
+	for _, format := range codegen.Formats {
+		file := filepath.Join(input, codegen.FieldListFiles[format])
+		ldrs[format] = codegen.ExtractLdrStruct(file)
+		cfs[format] = codegen.ExtractCfStruct(file)
+		dfs[format] = codegen.ExtractDfStruct(file)
+	}
+
+	err := codegen.WriteList(filepath.Join(output, "leader.json"), ldrs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = codegen.WriteList(filepath.Join(output, "controlfields.json"), cfs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = codegen.WriteList(filepath.Join(output, "datafields.json"), dfs)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
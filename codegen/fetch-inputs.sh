@@ -0,0 +1,23 @@
+#!/bin/sh
+
+# Fetch the LoC pages that the field lists (and the MARC-8 tables) are
+# extracted from into codegen/input. The pages are fetched from an
+# Internet Archive snapshot so that the same version is fetched each
+# time; change SNAPSHOT to pick up later LoC updates.
+
+SNAPSHOT=20240101000000
+
+cd "$(dirname "$0")" || exit 1
+mkdir -p input
+
+for page in \
+	bibliographic/ecbdlist.html \
+	holdings/echdlist.html \
+	authority/ecadlist.html \
+	classification/eccdlist.html \
+	community/eccilist.html \
+	specifications/codetables.xml
+do
+	url="https://web.archive.org/web/${SNAPSHOT}id_/https://www.loc.gov/marc/${page}"
+	curl -fsSL -o "input/$(basename "$page")" "$url" || exit 1
+done
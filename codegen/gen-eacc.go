@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	//
+	codegen "github.com/gsiems/go-marc21/codegen/pkg"
+)
+
+func main() {
+
+	var input string
+	var output string
+
+	flag.StringVar(&input, "i", "input/codetables.xml", "The saved LoC MARC-8 code tables XML file.")
+	flag.StringVar(&output, "o", "eacctable.go", "The Go file to write.")
+	flag.Parse()
+
+	// 31 being the ISO code for the EACC character set
+	codes := codegen.ExtractMARC8Codes(input, "31")
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by codegen/gen-eacc.go; DO NOT EDIT.\n\n")
+	b.WriteString("package marc21\n\n")
+	b.WriteString("func init() {\n")
+	b.WriteString("\teaccToUCS = map[int]rune{\n")
+	for _, c := range codes {
+		fmt.Fprintf(&b, "\t\t0x%06X: 0x%04X,\n", c.MARC, c.UCS)
+	}
+	b.WriteString("\t}\n")
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(output, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	//
+	codegen "github.com/gsiems/go-marc21/codegen/pkg"
+)
+
+func main() {
+
+	var lists string
+	var output string
+
+	flag.StringVar(&lists, "i", "lists", "The directory containing the extracted field lists.")
+	flag.StringVar(&output, "o", ".", "The directory to write the Go files to.")
+	flag.Parse()
+
+	ldrs := make(map[string]codegen.Ldr)
+	err := codegen.ReadList(filepath.Join(lists, "leader.json"), &ldrs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cfs := make(map[string]codegen.CfTags)
+	err = codegen.ReadList(filepath.Join(lists, "controlfields.json"), &cfs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dfs := make(map[string]codegen.DfTags)
+	err = codegen.ReadList(filepath.Join(lists, "datafields.json"), &dfs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := codegen.LdrGoSource(ldrs, "codegen/gen-tables.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	writeFile(filepath.Join(output, "leadertables.go"), src)
+
+	src, err = codegen.LdrTestSource(ldrs, "codegen/gen-tables.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	writeFile(filepath.Join(output, "leadertables_test.go"), src)
+
+	src, err = codegen.CfGoSource(cfs, "codegen/gen-tables.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	writeFile(filepath.Join(output, "cftables.go"), src)
+
+	src, err = codegen.CfTestSource(cfs, "codegen/gen-tables.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	writeFile(filepath.Join(output, "cftables_test.go"), src)
+
+	src, err = codegen.DfGoSource(dfs, "codegen/gen-tables.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	writeFile(filepath.Join(output, "dftables.go"), src)
+}
+
+func writeFile(filename string, src []byte) {
+	err := ioutil.WriteFile(filename, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -23,27 +23,27 @@ type CfTags struct {
 type CfTag struct {
 	Tag     string
 	Label   string
-	Subtags []*CfSubtag
+	Subtags []*CfSubtag `json:",omitempty"`
 }
 
 // CfSubtag is a Control field *subtag* definition (not that all control
 // fields have subtags (in which case the subtag label is "DEFAULT"))
 type CfSubtag struct {
 	Label    string
-	Elements []*CfElement
+	Elements []*CfElement `json:",omitempty"`
 }
 
 // CfElement is the definition for one or more characters that make up
 // an "element"
 type CfElement struct {
 	Name         string
-	CamelName    string
+	CamelName    string `json:",omitempty"`
 	Offset       int
 	Width        int
 	wrapIndent   int
-	CodeWidth    int
-	FnType       string
-	LookupValues []*LookupValue
+	CodeWidth    int            `json:",omitempty"`
+	FnType       string         `json:",omitempty"`
+	LookupValues []*LookupValue `json:",omitempty"`
 }
 
 // String should return a string that matches, excepting some whitespace
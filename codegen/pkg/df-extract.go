@@ -0,0 +1,341 @@
+// Parses a LoC MARC21 fields list page and extracts the variable data
+// field definitions contained within
+
+package codegen
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// DfTags is a list of Data field definitions
+type DfTags struct {
+	Tags []*DfTag
+}
+
+// DfTag is a Data field definition
+type DfTag struct {
+	Tag        string
+	Label      string
+	Repeatable bool `json:",omitempty"`
+	Obsolete   bool `json:",omitempty"`
+	Indicators [2]*DfIndicator
+	Subfields  []*DfSubfield `json:",omitempty"`
+}
+
+// DfIndicator is the definition of one of the two indicators of a Data
+// field. Indicators that have no values listed (such as those of the
+// 880) may contain any value.
+type DfIndicator struct {
+	Label      string
+	wrapIndent int
+	Values     []*DfIndicatorValue `json:",omitempty"`
+}
+
+// DfIndicatorValue is the definition of a single indicator value
+type DfIndicatorValue struct {
+	Code     string
+	Label    string
+	Obsolete bool `json:",omitempty"`
+}
+
+// DfSubfield is the definition of a subfield code
+type DfSubfield struct {
+	Code       string
+	Label      string
+	Repeatable bool `json:",omitempty"`
+	Obsolete   bool `json:",omitempty"`
+}
+
+// String should return a string that matches, excepting some whitespace
+// and cleaned-up linewraps, the input data. This is primarily intended
+// for testing that the parsing/extraction is working correctly.
+func (t DfTag) String() string {
+	var lines []string
+
+	lines = append(lines, fmt.Sprintf("%s - %s", t.Tag, markLabel(t.Label, t.Repeatable, t.Obsolete)))
+
+	if t.Indicators[0] != nil || t.Indicators[1] != nil {
+		lines = append(lines, "   Indicators")
+	}
+	for i, ind := range t.Indicators {
+		if ind == nil {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("      %s - %s", []string{"First", "Second"}[i], ind.Label))
+		for _, v := range ind.Values {
+			label := v.Label
+			if v.Obsolete {
+				label += " [OBSOLETE]"
+			}
+			lines = append(lines, fmt.Sprintf("         %s - %s", v.Code, label))
+		}
+	}
+
+	if len(t.Subfields) > 0 {
+		lines = append(lines, "   Subfield Codes")
+	}
+	for _, sf := range t.Subfields {
+		lines = append(lines, fmt.Sprintf("      $%s - %s", sf.Code, markLabel(sf.Label, sf.Repeatable, sf.Obsolete)))
+	}
+
+	lines = append(lines, "")
+	return strings.Join(lines, "\n")
+}
+
+// markLabel re-adds the repeatability and obsolete markers to a label
+func markLabel(label string, repeatable, obsolete bool) string {
+	if repeatable {
+		label += " (R)"
+	} else {
+		label += " (NR)"
+	}
+	if obsolete {
+		label += " [OBSOLETE]"
+	}
+	return label
+}
+
+var (
+	reDfTag      = regexp.MustCompile("^([0-9][0-9X][0-9X]) - (.*)$")
+	reDfInd      = regexp.MustCompile("^(First|Second) - (.*)$")
+	reDfSubfield = regexp.MustCompile(`^\$([^ ]+) - (.*)$`)
+	reObsolete   = regexp.MustCompile(`\s*\[OBSOLETE[^\]]*\]`)
+	reRepeatable = regexp.MustCompile(`\s*\((N?R)\)\s*$`)
+)
+
+// ExtractDfStruct extracts the structure for the data field entries as
+// defined in the [saved-to-disc] fields list webpage
+func ExtractDfStruct(filename string) (tags DfTags) {
+
+	f, err := os.Open(filename)
+	if err != nil {
+		log.Fatal(fmt.Printf("File open failed: %q", err))
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	notInDfBlock := true
+
+	var dfTg *DfTag
+	var dfInd *DfIndicator
+	var dfSf *DfSubfield
+	inSubfields := false
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// We are only interested in looking at data field definitions
+		notInDfBlock = isNotInDfBlock(line, notInDfBlock)
+		if notInDfBlock {
+			continue
+		}
+
+		if canIgnoreDfLine(line) {
+			continue
+		}
+
+		//// Check if this is a "NEW TAG" line
+		if m := reDfTag.FindStringSubmatch(line); m != nil {
+			if dfTg != nil {
+				tags.Tags = append(tags.Tags, finishDfTag(dfTg))
+			}
+
+			dfTg, dfInd, dfSf = new(DfTag), nil, nil
+			inSubfields = false
+
+			dfTg.Tag = m[1]
+			dfTg.Label = m[2]
+			continue
+		}
+
+		// Just in case we are not in a tag block
+		if dfTg == nil {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+
+		switch strings.ToUpper(trimmed) {
+		case "INDICATORS":
+			inSubfields = false
+			continue
+		case "SUBFIELD CODES":
+			inSubfields = true
+			continue
+		}
+
+		if inSubfields {
+
+			//// Check if this is a "NEW SUBFIELD" line
+			if m := reDfSubfield.FindStringSubmatch(trimmed); m != nil {
+				for _, code := range expandCodes(m[1]) {
+					dfSf = &DfSubfield{Code: code, Label: m[2]}
+					dfTg.Subfields = append(dfTg.Subfields, dfSf)
+				}
+				continue
+			}
+
+			// Otherwise this is a continuation of the previous subfield
+			if dfSf != nil {
+				dfSf.Label += " " + trimmed
+			} else {
+				log.Printf("BAD PARSE: %s, %q\n", dfTg.Tag, line)
+			}
+			continue
+		}
+
+		//// Check if this is a "NEW INDICATOR" line
+		if m := reDfInd.FindStringSubmatch(trimmed); m != nil {
+			dfInd = &DfIndicator{Label: m[2]}
+			if m[1] == "First" {
+				dfTg.Indicators[0] = dfInd
+			} else {
+				dfTg.Indicators[1] = dfInd
+			}
+			continue
+		}
+
+		if dfInd == nil {
+			log.Printf("BAD PARSE: %s, %q\n", dfTg.Tag, line)
+			continue
+		}
+
+		//// At this point, we assert that this is an "INDICATOR VALUE" line
+
+		// Determine if this line is a continuation line from the previous
+		if len(dfInd.Values) > 0 && isWrappedLine(dfInd.wrapIndent, line) {
+			il := len(dfInd.Values) - 1
+			dfInd.Values[il].Label += " " + trimmed
+			continue
+		}
+
+		lv := strings.SplitN(trimmed, " ", 2)
+		if len(lv) == 1 {
+			log.Printf("BAD PARSE: %s, %q, %q\n", dfTg.Tag, dfInd.Label, line)
+			continue
+		}
+
+		if len(dfInd.Values) == 0 {
+			dfInd.wrapIndent = strings.Index(line, "-") + 1
+		}
+
+		label := strings.TrimLeft(lv[1], "- ")
+		for _, code := range expandCodes(lv[0]) {
+			dfInd.Values = append(dfInd.Values, &DfIndicatorValue{Code: code, Label: label})
+		}
+	}
+
+	if dfTg != nil {
+		tags.Tags = append(tags.Tags, finishDfTag(dfTg))
+	}
+
+	return tags
+}
+
+// finishDfTag moves the repeatability and obsolete markers out of the
+// labels of a tag (now that any wrapped lines have been joined)
+func finishDfTag(t *DfTag) *DfTag {
+
+	var r string
+	t.Label, r, t.Obsolete = splitLabel(t.Label)
+	t.Repeatable = r == "R"
+
+	for _, ind := range t.Indicators {
+		if ind == nil {
+			continue
+		}
+		ind.Label, _, _ = splitLabel(ind.Label)
+		for _, v := range ind.Values {
+			v.Label, _, v.Obsolete = splitLabel(v.Label)
+		}
+	}
+
+	for _, sf := range t.Subfields {
+		sf.Label, r, sf.Obsolete = splitLabel(sf.Label)
+		// Subfields that do not state their repeatability (the
+		// "Same as associated field" ranges of the 880) are allowed
+		// to repeat
+		sf.Repeatable = r != "NR"
+	}
+
+	return t
+}
+
+// splitLabel splits the "(R)"/"(NR)" repeatability and "[OBSOLETE]"
+// markers from a label
+func splitLabel(s string) (label, repeatable string, obsolete bool) {
+
+	if reObsolete.MatchString(s) {
+		obsolete = true
+		s = reObsolete.ReplaceAllString(s, "")
+	}
+
+	if m := reRepeatable.FindStringSubmatch(s); m != nil {
+		repeatable = m[1]
+		s = reRepeatable.ReplaceAllString(s, "")
+	}
+
+	return strings.TrimSpace(s), repeatable, obsolete
+}
+
+// expandCodes expands a range of codes (such as the "0-9" of a number
+// of nonfiling characters indicator, or the "a-z" of the 880) and
+// converts the "#" blank code
+func expandCodes(code string) []string {
+
+	code = strings.TrimRight(code, "- ")
+
+	if len(code) == 3 && code[1] == '-' && code[0] < code[2] {
+		var codes []string
+		for c := code[0]; c <= code[2]; c++ {
+			codes = append(codes, string(c))
+		}
+		return codes
+	}
+
+	// The subfield range "$a-z" is sometimes written as "$a-$z"
+	if len(code) == 4 && code[1] == '-' && code[2] == '$' {
+		return expandCodes(code[:2] + code[3:])
+	}
+
+	return []string{strings.Replace(code, "#", " ", -1)}
+}
+
+func canIgnoreDfLine(line string) bool {
+	// For the few lines that we really want to ignore
+	if pluckByte(line, 0) == "#" {
+		return true
+	}
+
+	// Toss the section headings
+	if pluckBytes(line, 0, 2) == "--" {
+		return true
+	}
+
+	// Empty lines.
+	if len(strings.TrimSpace(line)) < 1 {
+		return true
+	}
+
+	return false
+}
+
+func isNotInDfBlock(line string, notInDfBlock bool) bool {
+
+	// Start looking at data field definitions (the control fields are
+	// followed by the number and code fields)
+	if pluckBytes(line, 0, 8) == "--Number" {
+		return false
+	}
+
+	return notInDfBlock
+}
@@ -0,0 +1,685 @@
+// Generates the Go source for the leader, controlfield and datafield
+// tables of pkg/marc21 from the extracted definitions
+
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// Formats are the names of the record format constants of pkg/marc21,
+// in the order that the tables are written
+var Formats = []string{
+	"Bibliography",
+	"Holdings",
+	"Authority",
+	"Classification",
+	"Community",
+}
+
+// formatNames are the names used by pkg/marc21 for the record formats
+// (see marcFormatName)
+var formatNames = map[string]string{
+	"Bibliography":   "Bibliography",
+	"Holdings":       "Holdings",
+	"Authority":      "Authority",
+	"Classification": "Classification",
+	"Community":      "Community Information",
+}
+
+// materialTypes maps the bibliographic 006/008 subtag labels to the
+// material type codes of pkg/marc21 (see BibliographyMaterialType)
+var materialTypes = map[string]string{
+	"ALL MATERIALS":        "All Materials",
+	"BOOKS":                "BK",
+	"COMPUTER FILES":       "CF",
+	"MAPS":                 "MP",
+	"MUSIC":                "MU",
+	"CONTINUING RESOURCES": "CR",
+	"VISUAL MATERIALS":     "VM",
+	"MIXED MATERIALS":      "MX",
+}
+
+// tableElement is an element as it is written to the Go tables
+type tableElement struct {
+	Name      string
+	Offset    int
+	Width     int
+	CodeWidth int
+	Codes     []*LookupValue
+}
+
+// LdrGoSource returns the Go source for the leader element table and
+// the leader element accessors. The leaders are keyed by format (see
+// Formats).
+func LdrGoSource(ldrs map[string]Ldr, generator string) ([]byte, error) {
+
+	tables := ldrTables(ldrs)
+
+	var b bytes.Buffer
+	writeHeader(&b, generator, "The leader elements for each record format")
+
+	b.WriteString("var leaderElements = map[int][]fixedElement{\n")
+	for _, format := range Formats {
+		elements, ok := tables[format]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&b, "%s: {\n", format)
+		writeElements(&b, elements)
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+
+	for _, a := range ldrAccessors(tables) {
+		fmt.Fprintf(&b, "\n// %s returns the code and label indicating the %q of %s\n", a.Method, a.position+" - "+a.Name, a.subject())
+		fmt.Fprintf(&b, "func (rec Record) %s() (code, label string) {\n", a.Method)
+		fmt.Fprintf(&b, "return rec.leaderValue(%q)\n", a.Name)
+		b.WriteString("}\n")
+
+		fmt.Fprintf(&b, "\n// Set%s sets the %q of %s\n", a.Method, a.position+" - "+a.Name, a.subject())
+		fmt.Fprintf(&b, "func (rec *Record) Set%s(code string) error {\n", a.Method)
+		fmt.Fprintf(&b, "return rec.setLeaderValue(%q, code)\n", a.Name)
+		b.WriteString("}\n")
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// LdrTestSource returns the Go source for the tests of the leader
+// element accessors
+func LdrTestSource(ldrs map[string]Ldr, generator string) ([]byte, error) {
+
+	tables := ldrTables(ldrs)
+
+	var b bytes.Buffer
+	writeTestHeader(&b, generator, "errors", "strings", "testing")
+
+	b.WriteString("func TestLeaderAccessors(t *testing.T) {\n\n")
+	b.WriteString("tests := []struct {\n")
+	b.WriteString("name string\nleader string\n")
+	b.WriteString("get func(Record) (string, string)\nset func(*Record, string) error\n")
+	b.WriteString("code string\nlabel string\n")
+	b.WriteString("}{\n")
+	for _, a := range ldrAccessors(tables) {
+		for _, key := range a.keys {
+			e, _ := findTableElement(tables[key], a.Name)
+			code, label := firstCode(e)
+			fmt.Fprintf(&b, "{%q, %q, Record.%s, (*Record).Set%s, %q, %q},\n",
+				a.Method+"/"+formatNames[key], testLeaders[key], a.Method, a.Method, code, label)
+		}
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString(`for _, tc := range tests {
+		rec := Record{Leader: Leader{Text: tc.leader}}
+		if err := tc.set(&rec, tc.code); err != nil {
+			t.Errorf("%s: set %q failed: %q", tc.name, tc.code, err)
+			continue
+		}
+		code, label := tc.get(rec)
+		if code != tc.code || label != tc.label {
+			t.Errorf("%s = %q, %q, expected %q, %q", tc.name, code, label, tc.code, tc.label)
+		}
+		bad := strings.Repeat("!", len(tc.code))
+		if err := tc.set(&rec, bad); !errors.Is(err, ErrBadFixedValue) {
+			t.Errorf("%s: set %q returned %v", tc.name, bad, err)
+		}
+	}
+}
+`)
+
+	return format.Source(b.Bytes())
+}
+
+// ldrTables returns the table elements of the leaders, by format
+func ldrTables(ldrs map[string]Ldr) map[string][]tableElement {
+	tables := make(map[string][]tableElement)
+	for _, format := range Formats {
+		ldr, ok := ldrs[format]
+		if !ok {
+			continue
+		}
+		var elements []tableElement
+		for _, e := range ldr.Elements {
+			elements = append(elements, newTableElement(e.Name, e.Offset, e.Width, e.CodeWidth, e.FnType, e.LookupValues))
+		}
+		tables[format] = elements
+	}
+	return tables
+}
+
+// ldrAccessors returns the accessors for the coded leader elements.
+// Accessors are not written for the structural elements (the lengths,
+// counts and addresses), for the undefined positions or for those
+// elements that have hand written accessors (see leaderSkip).
+func ldrAccessors(tables map[string][]tableElement) (accessors []*accessor) {
+
+	byName := make(map[string]*accessor)
+	for _, format := range Formats {
+		for _, e := range tables[format] {
+			if len(e.Codes) == 0 || leaderSkip[e.Name] || strings.HasPrefix(e.Name, "Undefined") {
+				continue
+			}
+			if e.Offset < 5 || (e.Offset > 9 && e.Offset < 17) || e.Offset > 19 {
+				continue
+			}
+			a, ok := byName[e.Name]
+			if !ok {
+				a = &accessor{Name: e.Name, Method: methodName(e.Name), Codes: true, offset: e.Offset}
+				a.position = positions("", e)
+				byName[e.Name] = a
+				accessors = append(accessors, a)
+			}
+			a.keys = append(a.keys, format)
+		}
+	}
+
+	sort.SliceStable(accessors, func(i, j int) bool { return accessors[i].offset < accessors[j].offset })
+	return accessors
+}
+
+// CfGoSource returns the Go source for the fixed length controlfield
+// (006, 007 and 008) element tables and the 008 element accessors. The
+// controlfields are keyed by format (see Formats).
+func CfGoSource(cfs map[string]CfTags, generator string) ([]byte, error) {
+
+	tables, err := cfTables(cfs)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	writeHeader(&b, generator, "The elements of the fixed length controlfields, by tag and by format\n// (or material type)")
+
+	b.WriteString("var controlfieldElements = map[string]map[string][]fixedElement{\n")
+	for _, tag := range []string{"006", "007", "008"} {
+		fmt.Fprintf(&b, "%q: {\n", tag)
+		for _, k := range sortedKeys(tables[tag]) {
+			fmt.Fprintf(&b, "%q: {\n", k)
+			writeElements(&b, tables[tag][k])
+			b.WriteString("},\n")
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+
+	for _, a := range cfAccessors(tables["008"]) {
+		if a.Codes {
+			fmt.Fprintf(&b, "\n// %s returns the code and label indicating the %q of %s\n", a.Method, a.position+" - "+a.Name, a.subject())
+			fmt.Fprintf(&b, "func (rec Record) %s() (code, label string) {\n", a.Method)
+			fmt.Fprintf(&b, "return rec.f008Value(%q)\n", a.Name)
+		} else {
+			fmt.Fprintf(&b, "\n// %s returns the %q of %s\n", a.Method, a.position+" - "+a.Name, a.subject())
+			fmt.Fprintf(&b, "func (rec Record) %s() string {\n", a.Method)
+			fmt.Fprintf(&b, "code, _ := rec.f008Value(%q)\n", a.Name)
+			b.WriteString("return code\n")
+		}
+		b.WriteString("}\n")
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// CfTestSource returns the Go source for the tests of the 008 element
+// accessors
+func CfTestSource(cfs map[string]CfTags, generator string) ([]byte, error) {
+
+	tables, err := cfTables(cfs)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	writeTestHeader(&b, generator, "strings", "testing")
+
+	b.WriteString("func TestFixed008Accessors(t *testing.T) {\n\n")
+	b.WriteString("tests := []struct {\n")
+	b.WriteString("name string\nleader string\n")
+	b.WriteString("get func(Record) (string, string)\n")
+	b.WriteString("element string\nvalue string\nlabel string\n")
+	b.WriteString("}{\n")
+	for _, a := range cfAccessors(tables["008"]) {
+		get := "Record." + a.Method
+		if !a.Codes {
+			get = fmt.Sprintf("func(rec Record) (string, string) { return rec.%s(), \"\" }", a.Method)
+		}
+		for _, key := range a.keys {
+			e, _ := findTableElement(tables["008"][key], a.Name)
+			value := strings.Repeat("x", e.Width)
+			var label string
+			if len(e.Codes) > 0 {
+				var code string
+				code, label = firstCode(e)
+				value = strings.Repeat(code, e.Width/e.CodeWidth)
+			}
+			fmt.Fprintf(&b, "{%q, %q, %s, %q, %q, %q},\n", a.Method+"/"+key, testLeaders[key], get, a.Name, value, label)
+		}
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString(`for _, tc := range tests {
+		rec := Record{Leader: Leader{Text: tc.leader}}
+		rec.SetControlfield("008", strings.Repeat(" ", 40))
+		if err := rec.SetFixed008Element(tc.element, tc.value); err != nil {
+			t.Errorf("%s: set %q failed: %q", tc.name, tc.value, err)
+			continue
+		}
+		code, label := tc.get(rec)
+		if code != tc.value || label != tc.label {
+			t.Errorf("%s = %q, %q, expected %q, %q", tc.name, code, label, tc.value, tc.label)
+		}
+	}
+}
+`)
+
+	return format.Source(b.Bytes())
+}
+
+// cfTables returns the table elements of the fixed length
+// controlfields, by tag and by format (or material type)
+func cfTables(cfs map[string]CfTags) (map[string]map[string][]tableElement, error) {
+
+	tables := map[string]map[string][]tableElement{
+		"006": {},
+		"007": {},
+		"008": {},
+	}
+
+	for _, format := range Formats {
+		for _, tag := range cfs[format].Tags {
+			switch {
+			case tag.Tag == "008" && format == "Bibliography":
+				for _, st := range tag.Subtags {
+					key, ok := materialTypes[strings.ToUpper(strings.TrimSpace(st.Label))]
+					if !ok {
+						return nil, fmt.Errorf("unknown 008 material type %q", st.Label)
+					}
+					tables["008"][key] = cfTableElements(st)
+				}
+			case tag.Tag == "008":
+				for _, st := range tag.Subtags {
+					tables["008"][formatNames[format]] = cfTableElements(st)
+				}
+			case tag.Tag == "006" && format == "Bibliography":
+				// Positions 01-17 are those of the 008/18-34, so only the
+				// form of material (with the codes from every material
+				// type) is kept
+				form := tableElement{Name: "Form of material", Width: 1, CodeWidth: 1}
+				for _, st := range tag.Subtags {
+					for _, e := range cfTableElements(st) {
+						if e.Offset == 0 {
+							form.Codes = append(form.Codes, e.Codes...)
+						}
+					}
+				}
+				tables["006"]["All Materials"] = []tableElement{form}
+			case tag.Tag == "007" && format == "Bibliography":
+				// Keyed by category of material (007/00)
+				for _, st := range tag.Subtags {
+					elements := cfTableElements(st)
+					if len(elements) == 0 || elements[0].Offset != 0 || len(elements[0].Codes) == 0 {
+						return nil, fmt.Errorf("007 %q has no category of material", st.Label)
+					}
+					tables["007"][elements[0].Codes[0].Code] = elements
+				}
+			}
+		}
+	}
+
+	return tables, nil
+}
+
+// cfAccessors returns the accessors for the 008 elements. Accessors are
+// written for the elements that are common to all material types of
+// Bibliography records and for those that are shared by several
+// formats or material types.
+func cfAccessors(tables map[string][]tableElement) (accessors []*accessor) {
+
+	byName := make(map[string]*accessor)
+	var names []string
+	for _, key := range cfKeyOrder {
+		for _, e := range tables[key] {
+			if strings.HasPrefix(e.Name, "Undefined") {
+				continue
+			}
+			a, ok := byName[e.Name]
+			if !ok {
+				a = &accessor{Name: e.Name, Method: methodName(e.Name), offset: e.Offset}
+				byName[e.Name] = a
+				names = append(names, e.Name)
+			}
+			a.keys = append(a.keys, key)
+			a.Codes = a.Codes || len(e.Codes) > 0
+			if a.position == "" {
+				a.position = positions("008/", e)
+			} else if a.position != positions("008/", e) {
+				a.position = "008"
+			}
+		}
+	}
+
+	for _, name := range names {
+		a := byName[name]
+		if a.keys[0] == "All Materials" || len(a.keys) > 1 {
+			accessors = append(accessors, a)
+		}
+	}
+	return accessors
+}
+
+// cfTableElements returns the table elements for a controlfield subtag
+func cfTableElements(st *CfSubtag) (elements []tableElement) {
+	for _, e := range st.Elements {
+		elements = append(elements, newTableElement(e.Name, e.Offset, e.Width, e.CodeWidth, e.FnType, e.LookupValues))
+	}
+	return elements
+}
+
+// newTableElement returns the table element for an extracted element.
+// Only elements that consist of a list of codes have their codes
+// written, as elements having ranges of values (numbers, dates) are not
+// checked against a list.
+func newTableElement(name string, offset, width, codeWidth int, fnType string, values []*LookupValue) tableElement {
+
+	if codeWidth <= 0 || codeWidth > width {
+		codeWidth = width
+	}
+
+	e := tableElement{Name: name, Offset: offset, Width: width, CodeWidth: codeWidth}
+	if fnType != "lookup" && fnType != "multi" {
+		return e
+	}
+
+	for _, v := range values {
+		e.Codes = append(e.Codes, &LookupValue{Code: normalizeCode(v.Code, codeWidth), Label: v.Label})
+	}
+
+	return e
+}
+
+// normalizeCode converts the LoC representation of a code to the value
+// found in a record: a "#" is a blank and the fill character is
+// repeated to the width of the code
+func normalizeCode(code string, width int) string {
+	code = strings.Replace(code, "#", " ", -1)
+	if strings.Trim(code, "|") == "" {
+		code = strings.Repeat("|", width)
+	}
+	if strings.TrimSpace(code) == "" {
+		code = strings.Repeat(" ", width)
+	}
+	return code
+}
+
+// writeHeader writes the header of a generated file
+func writeHeader(b *bytes.Buffer, generator, comment string) {
+	fmt.Fprintf(b, "// Code generated by %s; DO NOT EDIT.\n\n", generator)
+	b.WriteString("package marc21\n\n")
+	fmt.Fprintf(b, "// %s, from the LoC field lists\n", comment)
+	b.WriteString("//\n")
+	b.WriteString("//\thttp://www.loc.gov/marc/bibliographic/ecbdlist.html\n")
+	b.WriteString("//\thttp://www.loc.gov/marc/holdings/echdlist.html\n")
+	b.WriteString("//\thttp://www.loc.gov/marc/authority/ecadlist.html\n")
+	b.WriteString("//\thttp://www.loc.gov/marc/classification/eccdlist.html\n")
+	b.WriteString("//\thttp://www.loc.gov/marc/community/eccilist.html\n")
+}
+
+// writeElements writes a list of fixedElement literals
+func writeElements(b *bytes.Buffer, elements []tableElement) {
+	for _, e := range elements {
+		fmt.Fprintf(b, "{Name: %q, Offset: %d, Width: %d, CodeWidth: %d", e.Name, e.Offset, e.Width, e.CodeWidth)
+		if len(e.Codes) > 0 {
+			b.WriteString(", Codes: map[string]string{\n")
+			seen := make(map[string]bool)
+			for _, v := range e.Codes {
+				if seen[v.Code] {
+					continue
+				}
+				seen[v.Code] = true
+				fmt.Fprintf(b, "%q: %q,\n", v.Code, v.Label)
+			}
+			b.WriteString("}")
+		}
+		b.WriteString("},\n")
+	}
+}
+
+// accessor is a generated element accessor method of Record
+type accessor struct {
+	// Name is the name of the element
+	Name string
+	// Method is the name of the accessor method
+	Method string
+	// Codes indicates whether the element has a list of codes
+	Codes bool
+	// keys are the formats (or material types) that define the element
+	keys     []string
+	offset   int
+	position string
+}
+
+// subject returns the description of the records that an accessor
+// applies to, as used in the doc comments
+func (a *accessor) subject() string {
+
+	var formats []string
+	seen := make(map[string]bool)
+	types := 0
+	for _, key := range a.keys {
+		format, isType := keyFormat(key)
+		if isType {
+			types++
+		}
+		if !seen[format] {
+			seen[format] = true
+			formats = append(formats, format)
+		}
+	}
+
+	if len(formats) == len(Formats) {
+		return "the record"
+	}
+
+	s := strings.Join(formats, ", ")
+	if i := strings.LastIndex(s, ", "); i >= 0 {
+		s = s[:i] + " or " + s[i+2:]
+	}
+	article := "a "
+	if strings.IndexAny(s[:1], "AEIOU") == 0 {
+		article = "an "
+	}
+	s = article + s + " record"
+
+	// Elements of some, but not all, of the bibliographic material types
+	if types > 0 && types < len(materialTypes)-1 {
+		s += ", for those material types that define it"
+	}
+	return s
+}
+
+// keyFormat returns the format name for a table key, and whether the
+// key is that of a bibliographic material type
+func keyFormat(key string) (format string, isType bool) {
+	if f, ok := formatNames[key]; ok {
+		return f, false
+	}
+	for _, f := range formatNames {
+		if f == key {
+			return f, false
+		}
+	}
+	return "Bibliography", key != "All Materials"
+}
+
+// methodNames are the accessor method names that differ from the
+// camel cased element names
+var methodNames = map[string]string{
+	"Item information in record":                     "ItemInformation",
+	"Type of date/Publication status":                "TypeOfDate",
+	"Place of publication, production, or execution": "PlaceOfPublication",
+	// Not Index, which the readers use for the position of a record
+	"Index": "IndexPresent",
+}
+
+// methodName returns the name of the accessor method for an element
+func methodName(name string) string {
+	if m, ok := methodNames[name]; ok {
+		return m
+	}
+	return camelizeString(name)
+}
+
+// leaderSkip are the leader elements that have hand written accessors
+// (as their codes are merged across formats)
+var leaderSkip = map[string]bool{
+	"Type of record":          true,
+	"Character coding scheme": true,
+}
+
+// cfKeyOrder is the order in which the 008 tables are searched for
+// elements needing accessors
+var cfKeyOrder = []string{
+	"All Materials", "BK", "CF", "CR", "MP", "MU", "MX", "VM",
+	"Holdings", "Authority", "Classification", "Community Information",
+}
+
+// testLeaders are the leaders used by the generated tests for each
+// format (or material type)
+var testLeaders = map[string]string{
+	"Bibliography":          "00000nam a2200000   4500",
+	"All Materials":         "00000nam a2200000   4500",
+	"BK":                    "00000nam a2200000   4500",
+	"CF":                    "00000nmm a2200000   4500",
+	"CR":                    "00000nas a2200000   4500",
+	"MP":                    "00000nem a2200000   4500",
+	"MU":                    "00000ncm a2200000   4500",
+	"MX":                    "00000npm a2200000   4500",
+	"VM":                    "00000ngm a2200000   4500",
+	"Holdings":              "00000nx  a2200000   4500",
+	"Authority":             "00000nz  a2200000n  4500",
+	"Classification":        "00000nw  a2200000n  4500",
+	"Community":             "00000nq  a2200000n  4500",
+	"Community Information": "00000nq  a2200000n  4500",
+}
+
+// positions returns the character positions of an element, as in
+// "05" or "008/07-10"
+func positions(prefix string, e tableElement) string {
+	if e.Width > 1 {
+		return fmt.Sprintf("%s%02d-%02d", prefix, e.Offset, e.Offset+e.Width-1)
+	}
+	return fmt.Sprintf("%s%02d", prefix, e.Offset)
+}
+
+// findTableElement returns the named element
+func findTableElement(elements []tableElement, name string) (tableElement, bool) {
+	for _, e := range elements {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return tableElement{}, false
+}
+
+// firstCode returns the first code (in sort order) of an element
+func firstCode(e tableElement) (code, label string) {
+	for i, v := range e.Codes {
+		if i == 0 || v.Code < code {
+			code, label = v.Code, v.Label
+		}
+	}
+	return code, label
+}
+
+// sortedKeys returns the keys of a table in sort order
+func sortedKeys(tables map[string][]tableElement) (keys []string) {
+	for k := range tables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeTestHeader writes the header of a generated test file
+func writeTestHeader(b *bytes.Buffer, generator string, imports ...string) {
+	fmt.Fprintf(b, "// Code generated by %s; DO NOT EDIT.\n\n", generator)
+	b.WriteString("package marc21\n\n")
+	b.WriteString("import (\n")
+	for _, imp := range imports {
+		fmt.Fprintf(b, "%q\n", imp)
+	}
+	b.WriteString(")\n\n")
+}
+
+// DfGoSource returns the Go source for the datafield definitions. The
+// datafields are keyed by format (see Formats).
+func DfGoSource(dfs map[string]DfTags, generator string) ([]byte, error) {
+
+	var b bytes.Buffer
+	writeHeader(&b, generator, "The datafield definitions for each record format")
+
+	b.WriteString("var datafieldDefs = map[int][]DatafieldDef{\n")
+	for _, format := range Formats {
+		tags, ok := dfs[format]
+		if !ok {
+			continue
+		}
+
+		fmt.Fprintf(&b, "%s: {\n", format)
+		for _, t := range tags.Tags {
+			fmt.Fprintf(&b, "{Tag: %q, Label: %q%s, Indicators: [2]IndicatorDef{\n", t.Tag, t.Label, flags(t.Repeatable, t.Obsolete))
+			for _, ind := range t.Indicators {
+				writeIndicator(&b, ind)
+			}
+			b.WriteString("}")
+
+			if len(t.Subfields) > 0 {
+				b.WriteString(", Subfields: []SubfieldDef{\n")
+				for _, sf := range t.Subfields {
+					fmt.Fprintf(&b, "{Code: %q, Label: %q%s},\n", sf.Code, sf.Label, flags(sf.Repeatable, sf.Obsolete))
+				}
+				b.WriteString("}")
+			}
+			b.WriteString("},\n")
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+
+	return format.Source(b.Bytes())
+}
+
+// writeIndicator writes an IndicatorDef literal
+func writeIndicator(b *bytes.Buffer, ind *DfIndicator) {
+	if ind == nil {
+		b.WriteString("{},\n")
+		return
+	}
+
+	fmt.Fprintf(b, "{Label: %q", ind.Label)
+	if len(ind.Values) > 0 {
+		b.WriteString(", Values: []IndicatorValue{\n")
+		for _, v := range ind.Values {
+			fmt.Fprintf(b, "{Code: %q, Label: %q%s},\n", v.Code, v.Label, flags(false, v.Obsolete))
+		}
+		b.WriteString("}")
+	}
+	b.WriteString("},\n")
+}
+
+// flags returns the Repeatable and Obsolete fields of a literal, for
+// those that are set
+func flags(repeatable, obsolete bool) (s string) {
+	if repeatable {
+		s += ", Repeatable: true"
+	}
+	if obsolete {
+		s += ", Obsolete: true"
+	}
+	return s
+}
@@ -18,13 +18,13 @@ type Ldr struct {
 // an "element"
 type LdrElement struct {
 	Name         string
-	CamelName    string
+	CamelName    string `json:",omitempty"`
 	Offset       int
 	Width        int
 	wrapIndent   int
-	CodeWidth    int
-	FnType       string
-	LookupValues []*LookupValue
+	CodeWidth    int            `json:",omitempty"`
+	FnType       string         `json:",omitempty"`
+	LookupValues []*LookupValue `json:",omitempty"`
 }
 
 // String should return a string that matches, excepting some whitespace
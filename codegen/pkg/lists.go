@@ -0,0 +1,39 @@
+// Reads and writes the field lists that are extracted from the LoC
+// field list pages, so that the Go tables can be generated from the
+// (committed) lists without the pages
+
+package codegen
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// FieldListFiles are the saved LoC field list pages for each format
+var FieldListFiles = map[string]string{
+	"Bibliography":   "ecbdlist.html",
+	"Holdings":       "echdlist.html",
+	"Authority":      "ecadlist.html",
+	"Classification": "eccdlist.html",
+	"Community":      "eccilist.html",
+}
+
+// WriteList writes an extracted list (keyed by format) as JSON
+func WriteList(filename string, list interface{}) error {
+
+	b, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, append(b, '\n'), 0644)
+}
+
+// ReadList reads an extracted list that was written by WriteList
+func ReadList(filename string, list interface{}) error {
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, list)
+}
@@ -0,0 +1,89 @@
+// Parses the LoC MARC-8 code tables XML document and extracts the
+// code to Unicode mappings for a character set
+
+package codegen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// MARC8Code is a single MARC-8 to Unicode mapping
+type MARC8Code struct {
+	MARC int
+	UCS  int
+	Name string
+}
+
+type codeTables struct {
+	CharacterSets []characterSet `xml:"codeTable>characterSet"`
+}
+
+type characterSet struct {
+	Name    string      `xml:"name,attr"`
+	ISOcode string      `xml:"ISOcode,attr"`
+	Codes   []codeEntry `xml:"code"`
+}
+
+type codeEntry struct {
+	MARC string `xml:"marc"`
+	UCS  string `xml:"ucs"`
+	Alt  string `xml:"alt"`
+	Name string `xml:"name"`
+}
+
+// ExtractMARC8Codes extracts the code mappings for the character set
+// identified by isoCode from the [saved-to-disc] codetables.xml
+// document (https://www.loc.gov/marc/specifications/codetables.xml)
+func ExtractMARC8Codes(filename, isoCode string) (codes []*MARC8Code) {
+
+	f, err := os.Open(filename)
+	if err != nil {
+		log.Fatal(fmt.Printf("File open failed: %q", err))
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	var doc codeTables
+	err = xml.NewDecoder(f).Decode(&doc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, cs := range doc.CharacterSets {
+		if cs.ISOcode != isoCode {
+			continue
+		}
+
+		for _, c := range cs.Codes {
+
+			ucs := strings.TrimSpace(c.UCS)
+			if ucs == "" {
+				ucs = strings.TrimSpace(c.Alt)
+			}
+
+			m, err := strconv.ParseInt(strings.TrimSpace(c.MARC), 16, 32)
+			if err != nil {
+				log.Printf("BAD PARSE: %q, %q\n", cs.Name, c.MARC)
+				continue
+			}
+
+			u, err := strconv.ParseInt(ucs, 16, 32)
+			if err != nil {
+				log.Printf("BAD PARSE: %q, %q, %q\n", cs.Name, c.MARC, ucs)
+				continue
+			}
+
+			codes = append(codes, &MARC8Code{MARC: int(m), UCS: int(u), Name: strings.TrimSpace(c.Name)})
+		}
+	}
+
+	return codes
+}
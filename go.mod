@@ -1,5 +1,5 @@
 module github.com/gsiems/go-marc21
 
-go 1.13
+go 1.18
 
 require github.com/gsiems/go-isbn v0.0.0-20180127031122-d51736927d43
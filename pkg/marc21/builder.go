@@ -0,0 +1,290 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+/*
+http://www.loc.gov/marc/bibliographic/bd005.html
+
+    005 - Date and Time of Latest Transaction (NR)
+
+    Sixteen characters that specify the date and time of the latest
+    record transaction and serve as a version identifier for the
+    record. They are recorded according to Representation of Dates
+    and Times (ISO 8601). The date requires 8 numeric characters in the
+    pattern yyyymmdd. The time requires 8 numeric characters in the
+    pattern hhmmss.f, expressed in terms of the 24-hour (00-23) clock.
+*/
+
+/*
+http://www.loc.gov/marc/bibliographic/bd008.html
+
+    008 - Fixed-Length Data Elements-General Information (NR)
+
+    Forty character positions (00-39) that provide coded information
+    about the record as a whole and about special bibliographic aspects
+    of the item being cataloged. [...] Character positions 00-17 and
+    35-39 are defined the same across all types of material, with
+    special consideration for position 06. The definition of character
+    positions 18-34 differs according to the type of material.
+
+    00-05 - Date entered on file
+*/
+
+// Template identifies the kind of record that a Builder starts from.
+// The bibliographic templates use the material type codes returned by
+// BibliographyMaterialType.
+type Template string
+
+const (
+	// TemplateBK is a bibliographic record for a book
+	TemplateBK Template = "BK"
+	// TemplateCR is a bibliographic record for a continuing resource
+	TemplateCR Template = "CR"
+	// TemplateMU is a bibliographic record for a musical sound recording
+	TemplateMU Template = "MU"
+	// TemplateMP is a bibliographic record for a map
+	TemplateMP Template = "MP"
+	// TemplateVM is a bibliographic record for a projected medium
+	TemplateVM Template = "VM"
+	// TemplateCF is a bibliographic record for a computer file
+	TemplateCF Template = "CF"
+	// TemplateMX is a bibliographic record for mixed materials
+	TemplateMX Template = "MX"
+	// TemplateAuthority is an authority record for an established heading
+	TemplateAuthority Template = "AUT"
+	// TemplateHoldings is a single-part item holdings record
+	TemplateHoldings Template = "HLD"
+	// TemplateClassification is a classification schedule record for a
+	// single number
+	TemplateClassification Template = "CLS"
+	// TemplateCommunity is a community information record for an
+	// organization
+	TemplateCommunity Template = "COM"
+)
+
+// ErrUnknownTemplate indicates that a Builder was created for a
+// template that does not exist
+var ErrUnknownTemplate = errors.New("unknown record template")
+
+// recordTemplate contains the default leader and 008 for a template.
+// The first six positions of the 008 (date entered on file) are set
+// when the record is built.
+type recordTemplate struct {
+	leader string
+	f008   string
+}
+
+// The bibliographic 008 positions 00-17 and 35-39: no dates, place of
+// publication unknown, language undetermined and cataloged by other
+// than the national bibliographic agency
+const (
+	bib008Start = "      nuuuuuuuuxx "
+	bib008End   = "und d"
+)
+
+var recordTemplates = map[Template]recordTemplate{
+	TemplateBK: {
+		leader: "00000nam a2200000 i 4500",
+		// no illustrations, not a conference publication, festschrift,
+		// or indexed, and not fiction
+		f008: bib008Start + "           000 0 " + bib008End,
+	},
+	TemplateCR: {
+		leader: "00000nas a2200000 i 4500",
+		// regularity unknown and successive entry
+		f008: bib008Start + " u         0    0" + bib008End,
+	},
+	TemplateMU: {
+		leader: "00000njm a2200000 i 4500",
+		// form of composition unknown, format of music and music parts
+		// not applicable, and no transposition or arrangement
+		f008: bib008Start + "uunn           n " + bib008End,
+	},
+	TemplateMP: {
+		leader: "00000nem a2200000 i 4500",
+		// a single map that is not indexed
+		f008: bib008Start + "       a     0   " + bib008End,
+	},
+	TemplateVM: {
+		leader: "00000ngm a2200000 i 4500",
+		// running time not applicable, a live action videorecording
+		f008: bib008Start + "nnn            vl" + bib008End,
+	},
+	TemplateCF: {
+		leader: "00000nmm a2200000 i 4500",
+		// an online resource of unknown type
+		f008: bib008Start + "     o  u        " + bib008End,
+	},
+	TemplateMX: {
+		leader: "00000npc a2200000 i 4500",
+		f008:   bib008Start + "                 " + bib008End,
+	},
+	TemplateAuthority: {
+		leader: "00000nz  a2200000n  4500",
+		// an established personal or corporate name heading, formulated
+		// according to other rules, appropriate for use as a main,
+		// added or subject entry and fully established
+		f008: "      nn azannaabn           n ana     d",
+	},
+	TemplateHoldings: {
+		leader: "00000nx  a22000001n 4500",
+		// acquisition and retention unknown, one copy
+		f008: "      0u    0   4001uu   0      ",
+	},
+	TemplateClassification: {
+		leader: "00000nw  a2200000n  4500",
+		// a valid, fully established, standard single number in a
+		// schedule, that is displayed and not synthesized
+		f008: "      aaaaaaaa",
+	},
+	TemplateCommunity: {
+		leader: "00000nqo a2200000   4500",
+		// language undetermined
+		f008: "                                   und  ",
+	},
+}
+
+// Builder creates new records from a template. The methods of a Builder
+// may be chained:
+//
+//	marc, err := marc21.NewBuilder(marc21.TemplateBK).
+//		Controlfield("001", "12345").
+//		Datafield("100", "1", " ", "a", "Smith, John.").
+//		Datafield("245", "1", "0", "a", "A title /", "c", "John Smith.").
+//		RecordAsMARC()
+//
+// The first error encountered by the methods of the Builder is returned
+// by Record and RecordAsMARC.
+type Builder struct {
+	rec *Record
+	err error
+}
+
+// NewBuilder returns a new Builder for a record having the leader and
+// 008 defaults of the template. The 005 and the date entered on file
+// (008/00-05) are set to the current time.
+func NewBuilder(t Template) *Builder {
+
+	b := &Builder{rec: new(Record)}
+
+	tmpl, ok := recordTemplates[t]
+	if !ok {
+		b.err = fmt.Errorf("%w: %q", ErrUnknownTemplate, string(t))
+		return b
+	}
+
+	b.rec.Leader.Text = tmpl.leader
+	b.rec.Controlfields = []*Controlfield{
+		{Tag: "005"},
+		{Tag: "008", Text: tmpl.f008},
+	}
+
+	return b.Timestamp(time.Now())
+}
+
+// Timestamp sets the 005 (date and time of latest transaction) and the
+// date entered on file (008/00-05) to the specified time
+func (b *Builder) Timestamp(t time.Time) *Builder {
+	if b.err != nil {
+		return b
+	}
+
+	b.rec.SetControlfield("005", t.Format("20060102150405")+fmt.Sprintf(".%d", t.Nanosecond()/1e8))
+	return b.Position("008", 0, t.Format("060102"))
+}
+
+// Position sets the values at the specified (zero-based) character
+// position of the leader (tag "LDR") or of a controlfield
+func (b *Builder) Position(tag string, pos int, value string) *Builder {
+	if b.err != nil {
+		return b
+	}
+
+	text := b.rec.Leader.Text
+	if tag != "LDR" {
+		text = b.rec.GetControlfield(tag)
+	}
+
+	if pos < 0 || pos+len(value) > len(text) {
+		b.err = fmt.Errorf("position %d (%q) is outside of the %s", pos, value, tag)
+		return b
+	}
+	text = text[:pos] + value + text[pos+len(value):]
+
+	if tag == "LDR" {
+		b.rec.Leader.Text = text
+	} else {
+		b.rec.SetControlfield(tag, text)
+	}
+	return b
+}
+
+// Controlfield sets the text of a controlfield, adding the field (in
+// tag order) if the record does not have it
+func (b *Builder) Controlfield(tag, text string) *Builder {
+	if b.err != nil {
+		return b
+	}
+
+	if len(tag) != 3 {
+		b.err = &ValidationError{Record: -1, Tag: tag, Err: ErrBadTag}
+		return b
+	}
+	b.rec.SetControlfield(tag, text)
+	return b
+}
+
+// Datafield adds a datafield (in tag order) to the record. The
+// subfields are specified as pairs of code and text.
+func (b *Builder) Datafield(tag, ind1, ind2 string, subfields ...string) *Builder {
+	if b.err != nil {
+		return b
+	}
+
+	if len(tag) != 3 {
+		b.err = &ValidationError{Record: -1, Tag: tag, Err: ErrBadTag}
+		return b
+	}
+	if len(subfields)%2 != 0 {
+		b.err = fmt.Errorf("datafield %s: subfield %q has no text", tag, subfields[len(subfields)-1])
+		return b
+	}
+
+	df := &Datafield{Tag: tag}
+	err := df.SetIndicators(ind1, ind2)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	for i := 0; i < len(subfields); i += 2 {
+		df.AddSubfield(subfields[i], subfields[i+1])
+	}
+
+	b.rec.InsertDatafield(df)
+	return b
+}
+
+// Record returns the record that was built
+func (b *Builder) Record() (*Record, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.rec, nil
+}
+
+// RecordAsMARC returns the record that was built as a MARC record byte
+// array
+func (b *Builder) RecordAsMARC() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.rec.RecordAsMARC()
+}
@@ -0,0 +1,126 @@
+package marc21
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBuilderTemplates(t *testing.T) {
+
+	for tmpl, def := range recordTemplates {
+		rec, err := NewBuilder(tmpl).Record()
+		if err != nil {
+			t.Fatalf("%s: Record() failed: %q", tmpl, err)
+		}
+
+		if len(rec.Leader.Text) != leaderLen {
+			t.Errorf("%s: leader %q is not %d bytes long", tmpl, rec.Leader.Text, leaderLen)
+		}
+		for _, v := range rec.LeaderElements() {
+			if !v.Valid {
+				t.Errorf("%s: leader %v is not valid", tmpl, v)
+			}
+		}
+
+		f008 := rec.GetControlfield("008")
+		length := 40
+		switch rec.RecordFormat() {
+		case Holdings:
+			length = 32
+		case Classification:
+			length = 14
+		}
+		if len(f008) != length || f008[6:] != def.f008[6:] {
+			t.Errorf("%s: bad 008 %q", tmpl, f008)
+		}
+		if len(rec.GetControlfield("005")) != 16 {
+			t.Errorf("%s: bad 005 %q", tmpl, rec.GetControlfield("005"))
+		}
+
+		switch tmpl {
+		case TemplateAuthority:
+			if rec.RecordFormat() != Authority {
+				t.Errorf("%s: format is %s", tmpl, rec.RecordFormatName())
+			}
+		case TemplateHoldings:
+			if rec.RecordFormat() != Holdings {
+				t.Errorf("%s: format is %s", tmpl, rec.RecordFormatName())
+			}
+		case TemplateClassification:
+			if rec.RecordFormat() != Classification {
+				t.Errorf("%s: format is %s", tmpl, rec.RecordFormatName())
+			}
+			for _, name := range []string{"Kind of record", "Type of number", "Classification validity", "Level of establishment"} {
+				if v, ok := rec.Fixed008Element(name); !ok || v.Code != "a" {
+					t.Errorf("%s: %s is %v", tmpl, name, v)
+				}
+			}
+		case TemplateCommunity:
+			if rec.RecordFormat() != Community {
+				t.Errorf("%s: format is %s", tmpl, rec.RecordFormatName())
+			}
+			if v, ok := rec.Fixed008Element("Language"); !ok || v.Code != "und" {
+				t.Errorf("%s: Language is %v", tmpl, v)
+			}
+		default:
+			if code, _ := rec.BibliographyMaterialType(); code != string(tmpl) {
+				t.Errorf("%s: material type is %q", tmpl, code)
+			}
+		}
+	}
+}
+
+func TestBuilder(t *testing.T) {
+
+	ts := time.Date(2018, 1, 15, 9, 30, 5, 700000000, time.UTC)
+
+	rec, err := NewBuilder(TemplateBK).
+		Timestamp(ts).
+		Position("LDR", 17, "7").
+		Position("008", 35, "eng").
+		Controlfield("001", "12345").
+		Datafield("245", "1", "0", "a", "A title /", "c", "John Smith.").
+		Datafield("100", "1", " ", "a", "Smith, John.").
+		Record()
+	if err != nil {
+		t.Fatalf("Record() failed: %q", err)
+	}
+
+	if got := fieldTags(rec); got != "001 005 008 100 245" {
+		t.Errorf("fields are %q", got)
+	}
+	if rec.GetControlfield("005") != "20180115093005.7" {
+		t.Errorf("005 is %q", rec.GetControlfield("005"))
+	}
+	if f008 := rec.GetControlfield("008"); f008[:6] != "180115" || f008[35:38] != "eng" {
+		t.Errorf("008 is %q", f008)
+	}
+	if rec.Leader.Text[17] != '7' {
+		t.Errorf("leader is %q", rec.Leader.Text)
+	}
+
+	if _, err := rec.RecordAsMARC(); err != nil {
+		t.Errorf("RecordAsMARC() failed: %q", err)
+	}
+
+	_, err = NewBuilder("XX").Controlfield("001", "1").RecordAsMARC()
+	if !errors.Is(err, ErrUnknownTemplate) {
+		t.Errorf("RecordAsMARC() = %v, expected %v", err, ErrUnknownTemplate)
+	}
+
+	_, err = NewBuilder(TemplateBK).Datafield("245", "10", "0", "a", "Title").Record()
+	if !errors.Is(err, ErrBadIndicator) {
+		t.Errorf("Record() = %v, expected %v", err, ErrBadIndicator)
+	}
+
+	for _, b := range []*Builder{
+		NewBuilder(TemplateBK).Datafield("245", "1", "0", "a"),
+		NewBuilder(TemplateBK).Position("008", 38, "abc"),
+		NewBuilder(TemplateBK).Position("006", 0, "a"),
+	} {
+		if _, err := b.Record(); err == nil {
+			t.Errorf("Record() succeeded on a bad record")
+		}
+	}
+}
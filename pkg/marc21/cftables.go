@@ -0,0 +1,2443 @@
+// Code generated by codegen/gen-tables.go; DO NOT EDIT.
+
+package marc21
+
+// The elements of the fixed length controlfields, by tag and by format
+// (or material type), from the LoC field lists
+//
+//	http://www.loc.gov/marc/bibliographic/ecbdlist.html
+//	http://www.loc.gov/marc/holdings/echdlist.html
+//	http://www.loc.gov/marc/authority/ecadlist.html
+//	http://www.loc.gov/marc/classification/eccdlist.html
+//	http://www.loc.gov/marc/community/eccilist.html
+var controlfieldElements = map[string]map[string][]fixedElement{
+	"006": {
+		"All Materials": {
+			{Name: "Form of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Language material",
+				"c": "Notated music",
+				"d": "Manuscript notated music",
+				"e": "Cartographic material",
+				"f": "Manuscript cartographic material",
+				"g": "Projected medium",
+				"i": "Nonmusical sound recording",
+				"j": "Musical sound recording",
+				"k": "Two-dimensional nonprojectable graphic",
+				"m": "Computer file/Electronic resource",
+				"o": "Kit",
+				"p": "Mixed materials",
+				"r": "Three-dimensional artifact or naturally occurring object",
+				"s": "Serial/Integrating resource",
+				"t": "Manuscript language material",
+			}},
+		},
+	},
+	"007": {
+		"a": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Map",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"d": "Atlas",
+				"g": "Diagram",
+				"j": "Map",
+				"k": "Profile",
+				"q": "Model",
+				"r": "Remote-sensing image",
+				"s": "Section",
+				"u": "Unspecified",
+				"y": "View",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Color", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "One color",
+				"c": "Multicolored",
+				"|": "No attempt to code",
+			}},
+			{Name: "Physical medium", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Paper",
+				"b": "Wood",
+				"c": "Stone",
+				"d": "Metal",
+				"e": "Synthetic",
+				"f": "Skin",
+				"g": "Textiles",
+				"i": "Plastic",
+				"j": "Glass",
+				"l": "Vinyl",
+				"n": "Vellum",
+				"p": "Plaster",
+				"q": "Flexible base photographic, positive",
+				"r": "Flexible base photographic, negative",
+				"s": "Non-flexible base photographic, positive",
+				"t": "Non-flexible base photographic, negative",
+				"u": "Unknown",
+				"v": "Leather",
+				"w": "Parchment",
+				"y": "Other photographic medium",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of reproduction", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"f": "Facsimile",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Production/reproduction details", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Photocopy, blueline print",
+				"b": "Photocopy",
+				"c": "Pre-production",
+				"d": "Film",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Positive/negative aspect", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Positive",
+				"b": "Negative",
+				"m": "Mixed polarity",
+				"n": "Not applicable",
+				"|": "No attempt to code",
+			}},
+		},
+		"c": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"c": "Electronic resource",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Tape cartridge",
+				"b": "Chip cartridge",
+				"c": "Computer optical disc cartridge",
+				"d": "Computer disc, type unspecified",
+				"e": "Computer disc cartridge, type unspecified",
+				"f": "Tape cassette",
+				"h": "Tape reel",
+				"j": "Magnetic disk",
+				"k": "Computer card",
+				"m": "Magneto-optical disc",
+				"o": "Optical disc",
+				"r": "Remote",
+				"s": "Standalone device",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Color", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "One color",
+				"b": "Black-and-white",
+				"c": "Multicolored",
+				"g": "Gray scale",
+				"m": "Mixed",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Dimensions", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "3 1/2 in.",
+				"e": "12 in.",
+				"g": "4 3/4 in. or 12 cm.",
+				"i": "1 1/8 x 2 3/8 in.",
+				"j": "3 7/8 x 2 1/2 in.",
+				"n": "Not applicable",
+				"o": "5 1/4 in.",
+				"u": "Unknown",
+				"v": "8 in.",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Sound", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No sound (silent)",
+				"a": "Sound on medium or separate",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Image bit depth", Offset: 6, Width: 3, CodeWidth: 3},
+			{Name: "File formats", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "One file format",
+				"m": "Multiple file formats",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Quality assurance targets", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Absent",
+				"n": "Not applicable",
+				"p": "Present",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Antecedent/source", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "File reproduced from original",
+				"b": "File reproduced from microform",
+				"c": "File reproduced from an electronic resource",
+				"d": "File reproduced from an intermediate (not microform)",
+				"m": "Mixed",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Level of compression", Offset: 12, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Uncompressed",
+				"b": "Lossless",
+				"d": "Lossy",
+				"m": "Mixed",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Reformatting quality", Offset: 13, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Access",
+				"n": "Not applicable",
+				"p": "Preservation",
+				"r": "Replacement",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+		},
+		"d": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"d": "Globe",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Celestial globe",
+				"b": "Planetary or lunar globe",
+				"c": "Terrestrial globe",
+				"e": "Earth moon globe",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Color", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "One color",
+				"c": "Multicolored",
+				"|": "No attempt to code",
+			}},
+			{Name: "Physical medium", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Paper",
+				"b": "Wood",
+				"c": "Stone",
+				"d": "Metal",
+				"e": "Synthetic",
+				"f": "Skin",
+				"g": "Textile",
+				"i": "Plastic",
+				"l": "Vinyl",
+				"n": "Vellum",
+				"p": "Plaster",
+				"u": "Unknown",
+				"v": "Leather",
+				"w": "Parchment",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of reproduction", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"f": "Facsimile",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"f": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"f": "Tactile material",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Moon",
+				"b": "Braille",
+				"c": "Combination",
+				"d": "Tactile, with no writing system",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Class of braille writing", Offset: 3, Width: 2, CodeWidth: 1, Codes: map[string]string{
+				" ": "No specified class of braille writing",
+				"a": "Literary braille",
+				"b": "Format code braille",
+				"c": "Mathematics and scientific braille",
+				"d": "Computer braille",
+				"e": "Music braille",
+				"m": "Multiple braille types",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Level of contraction", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Uncontracted",
+				"b": "Contracted",
+				"m": "Combination",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Braille music format", Offset: 6, Width: 3, CodeWidth: 1, Codes: map[string]string{
+				" ": "No specified braille music format",
+				"a": "Bar over bar",
+				"b": "Bar by bar",
+				"c": "Line over line",
+				"d": "Paragraph",
+				"e": "Single line",
+				"f": "Section by section",
+				"g": "Line by line",
+				"h": "Open score",
+				"i": "Spanner short form scoring",
+				"j": "Short form scoring",
+				"k": "Outline",
+				"l": "Vertical score",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Special physical characteristics", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Print/braille",
+				"b": "Jumbo or enlarged braille",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"g": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"g": "Projected graphic",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"c": "Filmstrip cartridge",
+				"d": "Filmslip",
+				"f": "Filmstrip, type unspecified",
+				"o": "Filmstrip roll",
+				"s": "Slide",
+				"t": "Transparency",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Color", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "One color",
+				"b": "Black-and-white",
+				"c": "Multicolored",
+				"h": "Hand colored",
+				"m": "Mixed",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Base of emulsion", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"d": "Glass",
+				"e": "Synthetic",
+				"j": "Safety film",
+				"k": "Film base, other than safety film",
+				"m": "Mixed collection",
+				"o": "Paper",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Sound on medium or separate", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No sound (silent)",
+				"a": "Sound on medium",
+				"b": "Sound separate from medium",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Medium for sound", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No sound (silent)",
+				"a": "Optical sound track on motion picture film",
+				"b": "Magnetic sound track on motion picture film",
+				"c": "Magnetic audio tape in cartridge",
+				"d": "Sound disc",
+				"e": "Magnetic audio tape on reel",
+				"f": "Magnetic audio tape in cassette",
+				"g": "Optical and magnetic sound track on motion picture film",
+				"h": "Videotape",
+				"i": "Videodisc",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Dimensions", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Standard 8 mm. film width",
+				"b": "Super 8 mm./single 8 mm. film width",
+				"c": "9.5 mm. film width",
+				"d": "16 mm. film width",
+				"e": "28 mm. film width",
+				"f": "35 mm. film width",
+				"g": "70 mm. film width",
+				"j": "2x2 in. or 5x5 cm.",
+				"k": "2 1/4 x 2 1/4 in. or 6x6 cm.",
+				"s": "4x5 in. or 10x13 cm.",
+				"t": "5x7 in. or 13x18 cm.",
+				"u": "Unknown",
+				"v": "8x10 in. or 21x26 cm.",
+				"w": "9x9 in. or 23x23 cm.",
+				"x": "10x10 in. or 26x26 cm.",
+				"y": "7x7 in. or 18x18 cm.",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Secondary support material", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No secondary support",
+				"c": "Cardboard",
+				"d": "Glass",
+				"e": "Synthetic",
+				"h": "Metal",
+				"j": "Metal and glass",
+				"k": "Synthetic and glass",
+				"m": "Mixed collection",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"h": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"h": "Microform",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Aperture card",
+				"b": "Microfilm cartridge",
+				"c": "Microfilm cassette",
+				"d": "Microfilm reel",
+				"e": "Microfiche",
+				"f": "Microfiche cassette",
+				"g": "Microopaque",
+				"h": "Microfilm slip",
+				"j": "Microfilm roll",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Positive/negative aspect", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Positive",
+				"b": "Negative",
+				"m": "Mixed polarity",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Dimensions", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "8 mm.",
+				"d": "16 mm.",
+				"f": "35 mm.",
+				"g": "70 mm.",
+				"h": "105 mm.",
+				"l": "3x5 in. or 8x13 cm.",
+				"m": "4x6 in. or 11x15 cm.",
+				"o": "6x9 in. or 16x23 cm.",
+				"p": "3 1/4 x 7 3/8 in. or 9x19 cm.",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Reduction ratio range", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Low reduction ratio",
+				"b": "Normal reduction",
+				"c": "High reduction",
+				"d": "Very high reduction",
+				"e": "Ultra high reduction",
+				"u": "Unknown",
+				"v": "Reduction rate varies",
+				"|": "No attempt to code",
+			}},
+			{Name: "Reduction ratio", Offset: 6, Width: 3, CodeWidth: 3},
+			{Name: "Color", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"b": "Black-and-white",
+				"c": "Multicolored",
+				"m": "Mixed",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Emulsion on film", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Silver halide",
+				"b": "Diazo",
+				"c": "Vesicular",
+				"m": "Mixed emulsion",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Generation", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "First generation (master)",
+				"b": "Printing master",
+				"c": "Service copy",
+				"m": "Mixed generation",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Base of film", Offset: 12, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Safety base, undetermined",
+				"c": "Safety base, acetate undetermined",
+				"d": "Safety base, diacetate",
+				"i": "Nitrate base",
+				"m": "Mixed base (nitrate and safety)",
+				"n": "Not applicable",
+				"p": "Safety base, polyester",
+				"r": "Safety base, mixed",
+				"t": "Safety base, triacetate",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"k": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"k": "Nonprojected graphic",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Activity card",
+				"c": "Collage",
+				"d": "Drawing",
+				"e": "Painting",
+				"f": "Photomechanical print",
+				"g": "Photonegative",
+				"h": "Photoprint",
+				"i": "Picture",
+				"j": "Print",
+				"k": "Poster",
+				"l": "Technical drawing",
+				"n": "Chart",
+				"o": "Flash card",
+				"p": "Postcard",
+				"q": "Icon",
+				"r": "Radiograph",
+				"s": "Study print",
+				"u": "Unspecified",
+				"v": "Photograph, type unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Color", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "One color",
+				"b": "Black-and-white",
+				"c": "Multicolored",
+				"h": "Hand colored",
+				"m": "Mixed",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Primary support material", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Canvas",
+				"b": "Bristol board",
+				"c": "Cardboard/illustration board",
+				"d": "Glass",
+				"e": "Synthetic",
+				"f": "Skin",
+				"g": "Textile",
+				"h": "Metal",
+				"i": "Plastic",
+				"l": "Vinyl",
+				"m": "Mixed collection",
+				"n": "Vellum",
+				"o": "Paper",
+				"p": "Plaster",
+				"q": "Hardboard",
+				"r": "Porcelain",
+				"s": "Stone",
+				"t": "Wood",
+				"u": "Unknown",
+				"v": "Leather",
+				"w": "Parchment",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Secondary support material", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No secondary support",
+				"a": "Canvas",
+				"b": "Bristol board",
+				"c": "Cardboard/illustration board",
+				"d": "Glass",
+				"e": "Synthetic",
+				"f": "Skin",
+				"g": "Textile",
+				"h": "Metal",
+				"i": "Plastic",
+				"l": "Vinyl",
+				"m": "Mixed collection",
+				"n": "Vellum",
+				"o": "Paper",
+				"p": "Plaster",
+				"q": "Hardboard",
+				"r": "Porcelain",
+				"s": "Stone",
+				"t": "Wood",
+				"u": "Unknown",
+				"v": "Leather",
+				"w": "Parchment",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"m": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"m": "Motion picture",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"c": "Film cartridge",
+				"f": "Film cassette",
+				"o": "Film roll",
+				"r": "Film reel",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Color", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"b": "Black-and-white",
+				"c": "Multicolored",
+				"h": "Hand colored",
+				"m": "Mixed",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Motion picture presentation format", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Standard sound aperture (reduced frame)",
+				"b": "Nonanamorphic (wide-screen)",
+				"c": "3D",
+				"d": "Anamorphic (wide-screen)",
+				"e": "Other wide-screen format",
+				"f": "Standard silent aperture (full frame)",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Sound on medium or separate", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No sound (silent)",
+				"a": "Sound on medium",
+				"b": "Sound separate from medium",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Medium for sound", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No sound (silent)",
+				"a": "Optical sound track on motion picture film",
+				"b": "Magnetic sound track on motion picture film",
+				"c": "Magnetic audio tape in cartridge",
+				"d": "Sound disc",
+				"e": "Magnetic audio tape on reel",
+				"f": "Magnetic audio tape in cassette",
+				"g": "Optical and magnetic sound track on motion picture film",
+				"h": "Videotape",
+				"i": "Videodisc",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Dimensions", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Standard 8 mm.",
+				"b": "Super 8 mm./single 8 mm.",
+				"c": "9.5 mm.",
+				"d": "16 mm.",
+				"e": "28 mm.",
+				"f": "35 mm.",
+				"g": "70 mm.",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Configuration of playback channels", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"k": "Mixed",
+				"m": "Monaural",
+				"n": "Not applicable",
+				"q": "Quadraphonic, multichannel, or surround",
+				"s": "Stereophonic",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Production elements", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Workprint",
+				"b": "Trims",
+				"c": "Outtakes",
+				"d": "Rushes",
+				"e": "Mixing tracks",
+				"f": "Title bands/intertitle rolls",
+				"g": "Production rolls",
+				"n": "Not applicable",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Positive/negative aspect", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Positive",
+				"b": "Negative",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Generation", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"d": "Duplicate",
+				"e": "Master",
+				"o": "Original",
+				"r": "Reference print/viewing copy",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Base of film", Offset: 12, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Safety base, undetermined",
+				"c": "Safety base, acetate undetermined",
+				"d": "Safety base, diacetate",
+				"i": "Nitrate base",
+				"m": "Mixed base (nitrate and safety)",
+				"n": "Not applicable",
+				"p": "Safety base, polyester",
+				"r": "Safety base, mixed",
+				"t": "Safety base, triacetate",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Refined categories of color", Offset: 13, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "3 layer color",
+				"b": "2 color, single strip",
+				"c": "Undetermined 2 color",
+				"d": "Undetermined 3 color",
+				"e": "3 strip color",
+				"f": "2 strip color",
+				"g": "Red strip",
+				"h": "Blue or green strip",
+				"i": "Cyan strip",
+				"j": "Magenta strip",
+				"k": "Yellow strip",
+				"l": "S E N 2",
+				"m": "S E N 3",
+				"n": "Not applicable",
+				"p": "Sepia tone",
+				"q": "Other tone",
+				"r": "Tint",
+				"s": "Tinted and toned",
+				"t": "Stencil color",
+				"u": "Unknown",
+				"v": "Hand colored",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Kind of color stock or print", Offset: 14, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Imbibition dye transfer prints",
+				"b": "Three-layer stock",
+				"c": "Three layer stock, low fade",
+				"d": "Duplitized stock",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Deterioration stage", Offset: 15, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "None apparent",
+				"b": "Nitrate: suspicious odor",
+				"c": "Nitrate: pungent odor",
+				"d": "Nitrate: brownish, discoloration, fading, dusty",
+				"e": "Nitrate: sticky",
+				"f": "Nitrate: frothy, bubbles, blisters",
+				"g": "Nitrate: congealed",
+				"h": "Nitrate: powder",
+				"k": "Non-nitrate: detectable deterioration",
+				"l": "Non-nitrate: advanced deterioration",
+				"m": "Non-nitrate: disaster",
+				"|": "No attempt to code",
+			}},
+			{Name: "Completeness", Offset: 16, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"c": "Complete",
+				"i": "Incomplete",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Film inspection date", Offset: 17, Width: 6, CodeWidth: 6},
+		},
+		"o": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"o": "Kit",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"u": "Unspecified",
+				"|": "No attempt to code",
+			}},
+		},
+		"q": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"q": "Notated music",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"u": "Unspecified",
+				"|": "No attempt to code",
+			}},
+		},
+		"r": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"r": "Remote-sensing image",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"u": "Unspecified",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Altitude of sensor", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Surface",
+				"b": "Airborne",
+				"c": "Spaceborne",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Attitude of sensor", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Low oblique",
+				"b": "High oblique",
+				"c": "Vertical",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Cloud cover", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "0-9%",
+				"1": "10-19%",
+				"2": "20-29%",
+				"3": "30-39%",
+				"4": "40-49%",
+				"5": "50-59%",
+				"6": "60-69%",
+				"7": "70-79%",
+				"8": "80-89%",
+				"9": "90-100%",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Platform construction type", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Balloon",
+				"b": "Aircraft--low altitude",
+				"c": "Aircraft--medium altitude",
+				"d": "Aircraft--high altitude",
+				"e": "Manned spacecraft",
+				"f": "Unmanned spacecraft",
+				"g": "Land-based remote-sensing device",
+				"h": "Water surface-based remote-sensing device",
+				"i": "Submersible remote-sensing device",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Platform use category", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Meteorological",
+				"b": "Surface observing",
+				"c": "Space observing",
+				"m": "Mixed uses",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Sensor type", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Active",
+				"b": "Passive",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Data type", Offset: 9, Width: 2, CodeWidth: 2, Codes: map[string]string{
+				"aa": "Visible light",
+				"da": "Near infrared",
+				"db": "Middle infrared",
+				"dc": "Far infrared",
+				"dd": "Thermal infrared",
+				"de": "Shortwave infrared (SWIR)",
+				"df": "Reflective infrared",
+				"dv": "Combinations",
+				"dz": "Other infrared data",
+				"ga": "Sidelooking airborne radar (SLAR)",
+				"gb": "Synthetic aperture radar (SAR)-Single frequency",
+				"gc": "SAR-multi-frequency (multichannel)",
+				"gd": "SAR-like polarization",
+				"ge": "SAR-cross polarization",
+				"gf": "Infometric SAR",
+				"gg": "Polarmetric SAR",
+				"gu": "Passive microwave mapping",
+				"gz": "Other microwave data",
+				"ja": "Far ultraviolet",
+				"jb": "Middle ultraviolet",
+				"jc": "Near ultraviolet",
+				"jv": "Ultraviolet combinations",
+				"jz": "Other ultraviolet data",
+				"ma": "Multi-spectral, multidata",
+				"mb": "Multi-temporal",
+				"mm": "Combination of various data types",
+				"nn": "Not applicable",
+				"pa": "Sonar--water depth",
+				"pb": "Sonar--bottom topography images, sidescan",
+				"pc": "Sonar--bottom topography, near-surface",
+				"pd": "Sonar--bottom topography, near-bottom",
+				"pe": "Seismic surveys",
+				"pz": "Other acoustical data",
+				"ra": "Gravity anomalies (general)",
+				"rb": "Free-air",
+				"rc": "Bouger",
+				"rd": "Isostatic",
+				"sa": "Magnetic field",
+				"ta": "Radiometric surveys",
+				"uu": "Unknown",
+				"zz": "Other",
+				"||": "No attempt to code",
+			}},
+		},
+		"s": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"s": "Sound recording",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"b": "Belt",
+				"d": "Sound disc",
+				"e": "Cylinder",
+				"g": "Sound cartridge",
+				"i": "Sound-track film",
+				"q": "Roll",
+				"r": "Remote",
+				"s": "Sound cassette",
+				"t": "Sound-tape reel",
+				"u": "Unspecified",
+				"w": "Wire recording",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Speed", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "16 rpm",
+				"b": "33 1/3 rpm",
+				"c": "45 rpm",
+				"d": "78 rpm",
+				"e": "8 rpm",
+				"f": "1.4 m. per sec.",
+				"h": "120 rpm",
+				"i": "160 rpm",
+				"k": "15/16 ips",
+				"l": "1 7/8 ips",
+				"m": "3 3/4 ips",
+				"n": "Not applicable",
+				"o": "7 1/2 ips",
+				"p": "15 ips",
+				"r": "30 ips",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Configuration of playback channels", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"m": "Monaural",
+				"q": "Quadraphonic, multichannel, or surround",
+				"s": "Stereophonic",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Groove width/groove pitch", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"m": "Microgroove/fine",
+				"n": "Not applicable",
+				"s": "Coarse/standard",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Dimensions", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "3 in.",
+				"b": "5 in.",
+				"c": "7 in.",
+				"d": "10 in.",
+				"e": "12 in.",
+				"f": "16 in.",
+				"g": "4 3/4 in. or 12 cm.",
+				"j": "3 7/8 x 2 1/2 in.",
+				"n": "Not applicable",
+				"o": "5 1/4 x 3 7/8 in.",
+				"s": "2 3/4 x 4 in.",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Tape width", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"l": "1/8 in.",
+				"m": "1/4 in.",
+				"n": "Not applicable",
+				"o": "1/2 in.",
+				"p": "1 in.",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Tape configuration", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Full (1) track",
+				"b": "Half (2) track",
+				"c": "Quarter (4) track",
+				"d": "Eight track",
+				"e": "Twelve track",
+				"f": "Sixteen track",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Kind of disc, cylinder, or tape", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Master tape",
+				"b": "Tape duplication master",
+				"d": "Disc master (negative)",
+				"i": "Instantaneous (recorded on the spot)",
+				"m": "Mass produced",
+				"n": "Not applicable",
+				"r": "Mother (positive)",
+				"s": "Stamper (negative)",
+				"t": "Test pressing",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Kind of material", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Lacquer coating",
+				"b": "Cellulose nitrate",
+				"c": "Acetate tape with ferrous oxide",
+				"g": "Glass with lacquer",
+				"i": "Aluminum with lacquer",
+				"l": "Metal",
+				"m": "Plastic with metal",
+				"n": "Not applicable",
+				"p": "Plastic",
+				"r": "Paper with lacquer or ferrous oxide",
+				"s": "Shellac",
+				"u": "Unknown",
+				"w": "Wax",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Kind of cutting", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"h": "Hill-and-dale cutting",
+				"l": "Lateral or combined cutting",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Special playback characteristics", Offset: 12, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "NAB standard",
+				"b": "CCIR standard",
+				"c": "Dolby-B encoded",
+				"d": "dbx encoded",
+				"e": "Digital recording",
+				"f": "Dolby-A encoded",
+				"g": "Dolby-C encoded",
+				"h": "CX encoded",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Capture and storage technique", Offset: 13, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Acoustical capture, direct storage",
+				"b": "Direct storage, not acoustical",
+				"d": "Digital storage",
+				"e": "Analog electrical storage",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"t": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"t": "Text",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Regular print",
+				"b": "Large print",
+				"c": "Braille",
+				"d": "Loose-leaf",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"v": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"v": "Videorecording",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"c": "Videocartridge",
+				"d": "Videodisc",
+				"f": "Videocassette",
+				"r": "Videoreel",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 2, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Color", Offset: 3, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "One color",
+				"b": "Black-and-white",
+				"c": "Multicolored",
+				"m": "Mixed",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Videorecording format", Offset: 4, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Beta (1/2 in., videocassette)",
+				"b": "VHS (1/2 in., videocassette)",
+				"c": "U-matic (3/4 in., videocassette)",
+				"d": "EIAJ (1/2 in., reel)",
+				"e": "Type C (1 in., reel)",
+				"f": "Quadruplex (1 in. or 2 in., reel)",
+				"g": "Laserdisc",
+				"h": "CED (Capacitance Electronic Disc) videodisc",
+				"i": "Betacam (1/2 in., videocassette)",
+				"j": "Betacam SP (1/2 in., videocassette)",
+				"k": "Super-VHS (1/2 in., videocassette)",
+				"m": "M-II (1/2 in., videocassette)",
+				"o": "D-2 (3/4 in., videocassette)",
+				"p": "8 mm.",
+				"q": "Hi-8 mm.",
+				"s": "Blu-ray disc",
+				"u": "Unknown",
+				"v": "DVD",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Sound on medium or separate", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No sound (silent)",
+				"a": "Sound on medium",
+				"b": "Sound separate from medium",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Medium for sound", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No sound (silent)",
+				"a": "Optical sound track on motion picture film",
+				"b": "Magnetic sound track on motion picture film",
+				"c": "Magnetic audio tape in cartridge",
+				"d": "Sound disc",
+				"e": "Magnetic audio tape on reel",
+				"f": "Magnetic audio tape in cassette",
+				"g": "Optical and magnetic sound track on motion picture film",
+				"h": "Videotape",
+				"i": "Videodisc",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Dimensions", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "8 mm.",
+				"m": "1/4 in.",
+				"o": "1/2 in.",
+				"p": "1 in.",
+				"q": "2 in.",
+				"r": "3/4 in.",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Configuration of playback channels", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"k": "Mixed",
+				"m": "Monaural",
+				"n": "Not applicable",
+				"q": "Quadraphonic, multichannel, or surround",
+				"s": "Stereophonic",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"z": {
+			{Name: "Category of material", Offset: 0, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"z": "Unspecified",
+			}},
+			{Name: "Specific material designation", Offset: 1, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"m": "Multiple physical forms",
+				"u": "Unspecified",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+	},
+	"008": {
+		"All Materials": {
+			{Name: "Date entered on file", Offset: 0, Width: 6, CodeWidth: 6},
+			{Name: "Type of date/Publication status", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"b": "No dates given; B.C. date involved",
+				"c": "Continuing resource currently published",
+				"d": "Continuing resource ceased publication",
+				"e": "Detailed date",
+				"i": "Inclusive dates of collection",
+				"k": "Range of years of bulk of collection",
+				"m": "Multiple dates",
+				"n": "Dates unknown",
+				"p": "Date of distribution/release/issue and production/recording session when different",
+				"q": "Questionable date",
+				"r": "Reprint/reissue date and original date",
+				"s": "Single known date/probable date",
+				"t": "Publication date and copyright date",
+				"u": "Continuing resource status unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Date 1", Offset: 7, Width: 4, CodeWidth: 4},
+			{Name: "Date 2", Offset: 11, Width: 4, CodeWidth: 4},
+			{Name: "Place of publication, production, or execution", Offset: 15, Width: 3, CodeWidth: 3},
+			{Name: "Language", Offset: 35, Width: 3, CodeWidth: 3},
+			{Name: "Modified record", Offset: 38, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not modified",
+				"d": "Dashed-on information omitted",
+				"o": "Completely romanized/printed cards romanized",
+				"r": "Completely romanized/printed cards in script",
+				"s": "Shortened",
+				"x": "Missing characters",
+				"|": "No attempt to code",
+			}},
+			{Name: "Cataloging source", Offset: 39, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "National bibliographic agency",
+				"c": "Cooperative cataloging program",
+				"d": "Other",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+		},
+		"Authority": {
+			{Name: "Date entered on file", Offset: 0, Width: 6, CodeWidth: 6},
+			{Name: "Direct or indirect geographic subdivision", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not subdivided geographically",
+				"d": "Subdivided geographically-direct",
+				"i": "Subdivided geographically-indirect",
+				"n": "Not applicable",
+				"|": "No attempt to code",
+			}},
+			{Name: "Romanization scheme", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "International standard",
+				"b": "National standard",
+				"c": "National library association standard",
+				"d": "National library or bibliographic agency standard",
+				"e": "Local standard",
+				"f": "Standard of unknown origin",
+				"g": "Conventional romanization or conventional form of name in language of cataloging agency",
+				"n": "Not applicable",
+				"|": "No attempt to code",
+			}},
+			{Name: "Language of catalog", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No information provided",
+				"a": "English and French",
+				"b": "English only",
+				"c": "French only",
+				"|": "No attempt to code",
+			}},
+			{Name: "Kind of record", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Established heading",
+				"b": "Untraced reference",
+				"c": "Traced reference",
+				"d": "Subdivision",
+				"e": "Node label",
+				"f": "Established heading and subdivision",
+				"g": "Reference and subdivision",
+			}},
+			{Name: "Descriptive cataloging rules", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Earlier rules",
+				"b": "AACR 1",
+				"c": "AACR 2",
+				"d": "AACR 2 compatible heading",
+				"n": "Not applicable",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Subject heading system/thesaurus", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Library of Congress Subject Headings",
+				"b": "LC subject headings for children's literature",
+				"c": "Medical Subject Headings",
+				"d": "National Agricultural Library subject authority file",
+				"k": "Canadian Subject Headings",
+				"n": "Not applicable",
+				"r": "Art and Architecture Thesaurus",
+				"s": "Sears List of Subject Headings",
+				"v": "Répertoire de vedettes-matière",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of series", Offset: 12, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Monographic series",
+				"b": "Multipart item",
+				"c": "Series-like phrase",
+				"n": "Not applicable",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Numbered or unnumbered series", Offset: 13, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Numbered",
+				"b": "Unnumbered",
+				"c": "Numbering varies",
+				"n": "Not applicable",
+				"|": "No attempt to code",
+			}},
+			{Name: "Heading use-main or added entry", Offset: 14, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Appropriate",
+				"b": "Not appropriate",
+				"|": "No attempt to code",
+			}},
+			{Name: "Heading use-subject added entry", Offset: 15, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Appropriate",
+				"b": "Not appropriate",
+				"|": "No attempt to code",
+			}},
+			{Name: "Heading use-series added entry", Offset: 16, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Appropriate",
+				"b": "Not appropriate",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of subject subdivision", Offset: 17, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Topical",
+				"b": "Form",
+				"c": "Chronological",
+				"d": "Geographic",
+				"e": "Language",
+				"n": "Not applicable",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined character positions", Offset: 18, Width: 10, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of government agency", Offset: 28, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not a government agency",
+				"a": "Autonomous or semi-autonomous component",
+				"c": "Multilocal",
+				"f": "Federal/national",
+				"i": "International intergovernmental",
+				"l": "Local",
+				"m": "Multistate",
+				"o": "Government agency-type undetermined",
+				"s": "State, provincial, territorial, dependent, etc.",
+				"u": "Unknown if heading is government agency",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Reference evaluation", Offset: 29, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Tracings are consistent with the heading",
+				"b": "Tracings are not necessarily consistent with the heading",
+				"n": "Not applicable",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined character position", Offset: 30, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Record update in process", Offset: 31, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Record can be used",
+				"b": "Record is being updated",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undifferentiated personal name", Offset: 32, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Differentiated personal name",
+				"b": "Undifferentiated personal name",
+				"n": "Not applicable",
+				"|": "No attempt to code",
+			}},
+			{Name: "Level of establishment", Offset: 33, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Fully established",
+				"b": "Memorandum",
+				"c": "Provisional",
+				"d": "Preliminary",
+				"n": "Not applicable",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined character positions", Offset: 34, Width: 4, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Modified record", Offset: 38, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not modified",
+				"s": "Shortened",
+				"x": "Missing characters",
+				"|": "No attempt to code",
+			}},
+			{Name: "Cataloging source", Offset: 39, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "National bibliographic agency",
+				"c": "Cooperative cataloging program",
+				"d": "Other",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+		},
+		"BK": {
+			{Name: "Illustrations", Offset: 18, Width: 4, CodeWidth: 1, Codes: map[string]string{
+				" ": "No illustrations",
+				"a": "Illustrations",
+				"b": "Maps",
+				"c": "Portraits",
+				"d": "Charts",
+				"e": "Plans",
+				"f": "Plates",
+				"g": "Music",
+				"h": "Facsimiles",
+				"i": "Coats of arms",
+				"j": "Genealogical tables",
+				"k": "Forms",
+				"l": "Samples",
+				"m": "Phonodisc, phonowire, etc.",
+				"o": "Photographs",
+				"p": "Illuminations",
+				"|": "No attempt to code",
+			}},
+			{Name: "Target audience", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Unknown or not specified",
+				"a": "Preschool",
+				"b": "Primary",
+				"c": "Pre-adolescent",
+				"d": "Adolescent",
+				"e": "Adult",
+				"f": "Specialized",
+				"g": "General",
+				"j": "Juvenile",
+				"|": "No attempt to code",
+			}},
+			{Name: "Form of item", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "None of the following",
+				"a": "Microfilm",
+				"b": "Microfiche",
+				"c": "Microopaque",
+				"d": "Large print",
+				"f": "Braille",
+				"o": "Online",
+				"q": "Direct electronic",
+				"r": "Regular print reproduction",
+				"s": "Electronic",
+				"|": "No attempt to code",
+			}},
+			{Name: "Nature of contents", Offset: 24, Width: 4, CodeWidth: 1, Codes: map[string]string{
+				" ": "No specified nature of contents",
+				"2": "Offprints",
+				"5": "Calendars",
+				"6": "Comics/graphic novels",
+				"a": "Abstracts/summaries",
+				"b": "Bibliographies",
+				"c": "Catalogs",
+				"d": "Dictionaries",
+				"e": "Encyclopedias",
+				"f": "Handbooks",
+				"g": "Legal articles",
+				"i": "Indexes",
+				"j": "Patent document",
+				"k": "Discographies",
+				"l": "Legislation",
+				"m": "Theses",
+				"n": "Surveys of literature in a subject area",
+				"o": "Reviews",
+				"p": "Programmed texts",
+				"q": "Filmographies",
+				"r": "Directories",
+				"s": "Statistics",
+				"t": "Technical reports",
+				"u": "Standards/specifications",
+				"v": "Legal cases and case notes",
+				"w": "Law reports and digests",
+				"y": "Yearbooks",
+				"z": "Treaties",
+				"|": "No attempt to code",
+			}},
+			{Name: "Government publication", Offset: 28, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not a government publication",
+				"a": "Autonomous or semi-autonomous component",
+				"c": "Multilocal",
+				"f": "Federal/national",
+				"i": "International intergovernmental",
+				"l": "Local",
+				"m": "Multistate",
+				"o": "Government publication-level undetermined",
+				"s": "State, provincial, territorial, dependent, etc.",
+				"u": "Unknown if item is government publication",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Conference publication", Offset: 29, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Not a conference publication",
+				"1": "Conference publication",
+				"|": "No attempt to code",
+			}},
+			{Name: "Festschrift", Offset: 30, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Not a festschrift",
+				"1": "Festschrift",
+				"|": "No attempt to code",
+			}},
+			{Name: "Index", Offset: 31, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "No index",
+				"1": "Index present",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 32, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Literary form", Offset: 33, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Not fiction (not further specified)",
+				"1": "Fiction (not further specified)",
+				"c": "Comic strips",
+				"d": "Dramas",
+				"e": "Essays",
+				"f": "Novels",
+				"h": "Humor, satires, etc.",
+				"i": "Letters",
+				"j": "Short stories",
+				"m": "Mixed forms",
+				"p": "Poetry",
+				"s": "Speeches",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Biography", Offset: 34, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No biographical material",
+				"a": "Autobiography",
+				"b": "Individual biography",
+				"c": "Collective biography",
+				"d": "Contains biographical information",
+				"|": "No attempt to code",
+			}},
+		},
+		"CF": {
+			{Name: "Undefined", Offset: 18, Width: 4, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Target audience", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Unknown or not specified",
+				"a": "Preschool",
+				"b": "Primary",
+				"c": "Pre-adolescent",
+				"d": "Adolescent",
+				"e": "Adult",
+				"f": "Specialized",
+				"g": "General",
+				"j": "Juvenile",
+				"|": "No attempt to code",
+			}},
+			{Name: "Form of item", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Unknown or not specified",
+				"o": "Online",
+				"q": "Direct electronic",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 24, Width: 2, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of computer file", Offset: 26, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Numeric data",
+				"b": "Computer program",
+				"c": "Representational",
+				"d": "Document",
+				"e": "Bibliographic data",
+				"f": "Font",
+				"g": "Game",
+				"h": "Sound",
+				"i": "Interactive multimedia",
+				"j": "Online system or service",
+				"m": "Combination",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 27, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Government publication", Offset: 28, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not a government publication",
+				"a": "Autonomous or semi-autonomous component",
+				"c": "Multilocal",
+				"f": "Federal/national",
+				"i": "International intergovernmental",
+				"l": "Local",
+				"m": "Multistate",
+				"o": "Government publication-level undetermined",
+				"s": "State, provincial, territorial, dependent, etc.",
+				"u": "Unknown if item is government publication",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 29, Width: 6, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+		},
+		"CR": {
+			{Name: "Frequency", Offset: 18, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No determinable frequency",
+				"a": "Annual",
+				"b": "Bimonthly",
+				"c": "Semiweekly",
+				"d": "Daily",
+				"e": "Biweekly",
+				"f": "Semiannual",
+				"g": "Biennial",
+				"h": "Triennial",
+				"i": "Three times a week",
+				"j": "Three times a month",
+				"k": "Continuously updated",
+				"m": "Monthly",
+				"q": "Quarterly",
+				"s": "Semimonthly",
+				"t": "Three times a year",
+				"u": "Unknown",
+				"w": "Weekly",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Regularity", Offset: 19, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"n": "Normalized irregular",
+				"r": "Regular",
+				"u": "Unknown",
+				"x": "Completely irregular",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 20, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of continuing resource", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "None of the following",
+				"d": "Updating database",
+				"g": "Magazine",
+				"h": "Blog",
+				"j": "Journal",
+				"l": "Updating loose-leaf",
+				"m": "Monographic series",
+				"n": "Newspaper",
+				"p": "Periodical",
+				"r": "Repository",
+				"s": "Newsletter",
+				"t": "Directory",
+				"w": "Updating Web site",
+				"|": "No attempt to code",
+			}},
+			{Name: "Form of original item", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "None of the following",
+				"a": "Microfilm",
+				"b": "Microfiche",
+				"c": "Microopaque",
+				"d": "Large print",
+				"e": "Newspaper format",
+				"f": "Braille",
+				"o": "Online",
+				"q": "Direct electronic",
+				"s": "Electronic",
+				"|": "No attempt to code",
+			}},
+			{Name: "Form of item", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "None of the following",
+				"a": "Microfilm",
+				"b": "Microfiche",
+				"c": "Microopaque",
+				"d": "Large print",
+				"f": "Braille",
+				"o": "Online",
+				"q": "Direct electronic",
+				"r": "Regular print reproduction",
+				"s": "Electronic",
+				"|": "No attempt to code",
+			}},
+			{Name: "Nature of entire work", Offset: 24, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not specified",
+				"5": "Calendars",
+				"6": "Comics/graphic novels",
+				"a": "Abstracts/summaries",
+				"b": "Bibliographies",
+				"c": "Catalogs",
+				"d": "Dictionaries",
+				"e": "Encyclopedias",
+				"f": "Handbooks",
+				"g": "Legal articles",
+				"h": "Biography",
+				"i": "Indexes",
+				"k": "Discographies",
+				"l": "Legislation",
+				"m": "Theses",
+				"n": "Surveys of literature in a subject area",
+				"o": "Reviews",
+				"p": "Programmed texts",
+				"q": "Filmographies",
+				"r": "Directories",
+				"s": "Statistics",
+				"t": "Technical reports",
+				"u": "Standards/specifications",
+				"v": "Legal cases and case notes",
+				"w": "Law reports and digests",
+				"y": "Yearbooks",
+				"z": "Treaties",
+				"|": "No attempt to code",
+			}},
+			{Name: "Nature of contents", Offset: 25, Width: 3, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not specified",
+				"5": "Calendars",
+				"6": "Comics/graphic novels",
+				"a": "Abstracts/summaries",
+				"b": "Bibliographies",
+				"c": "Catalogs",
+				"d": "Dictionaries",
+				"e": "Encyclopedias",
+				"f": "Handbooks",
+				"g": "Legal articles",
+				"h": "Biography",
+				"i": "Indexes",
+				"k": "Discographies",
+				"l": "Legislation",
+				"m": "Theses",
+				"n": "Surveys of literature in a subject area",
+				"o": "Reviews",
+				"p": "Programmed texts",
+				"q": "Filmographies",
+				"r": "Directories",
+				"s": "Statistics",
+				"t": "Technical reports",
+				"u": "Standards/specifications",
+				"v": "Legal cases and case notes",
+				"w": "Law reports and digests",
+				"y": "Yearbooks",
+				"z": "Treaties",
+				"|": "No attempt to code",
+			}},
+			{Name: "Government publication", Offset: 28, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not a government publication",
+				"a": "Autonomous or semi-autonomous component",
+				"c": "Multilocal",
+				"f": "Federal/national",
+				"i": "International intergovernmental",
+				"l": "Local",
+				"m": "Multistate",
+				"o": "Government publication-level undetermined",
+				"s": "State, provincial, territorial, dependent, etc.",
+				"u": "Unknown if item is government publication",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Conference publication", Offset: 29, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Not a conference publication",
+				"1": "Conference publication",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 30, Width: 3, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Original alphabet or script of title", Offset: 33, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No alphabet or script given/No key title",
+				"a": "Basic Roman",
+				"b": "Extended Roman",
+				"c": "Cyrillic",
+				"d": "Japanese",
+				"e": "Chinese",
+				"f": "Arabic",
+				"g": "Greek",
+				"h": "Hebrew",
+				"i": "Thai",
+				"j": "Devanagari",
+				"k": "Korean",
+				"l": "Tamil",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Entry convention", Offset: 34, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Successive entry",
+				"1": "Latest entry",
+				"2": "Integrated entry",
+				"|": "No attempt to code",
+			}},
+		},
+		"Classification": {
+			{Name: "Date entered on file", Offset: 0, Width: 6, CodeWidth: 6},
+			{Name: "Kind of record", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Schedule record",
+				"b": "Table record",
+				"c": "Index term record",
+			}},
+			{Name: "Type of number", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Single number",
+				"b": "Defined number span",
+				"c": "Summary number span",
+				"n": "Not applicable",
+			}},
+			{Name: "Classification validity", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Valid",
+				"b": "First number of span invalid",
+				"c": "Last number of span invalid",
+				"d": "Completely invalid",
+				"e": "Obsolete",
+				"n": "Not applicable",
+			}},
+			{Name: "Standard or optional designation", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Standard",
+				"b": "Optional",
+				"n": "Not applicable",
+			}},
+			{Name: "Record update in process", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Record can be used",
+				"b": "Record is being updated",
+			}},
+			{Name: "Level of establishment", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Fully established",
+				"c": "Provisional",
+			}},
+			{Name: "Synthesized number indication", Offset: 12, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Not synthesized",
+				"b": "Synthesized",
+				"n": "Not applicable",
+			}},
+			{Name: "Display controller", Offset: 13, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Displayed in standard schedules or tables",
+				"b": "Extended display",
+			}},
+		},
+		"Community Information": {
+			{Name: "Date entered on file", Offset: 0, Width: 6, CodeWidth: 6},
+			{Name: "Undefined character positions", Offset: 6, Width: 29, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Language", Offset: 35, Width: 3, CodeWidth: 3},
+			{Name: "Undefined character positions", Offset: 38, Width: 2, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+		},
+		"Holdings": {
+			{Name: "Date entered on file", Offset: 0, Width: 6, CodeWidth: 6},
+			{Name: "Receipt or acquisition status", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Unknown",
+				"1": "Other receipt or acquisition status",
+				"2": "Received and complete or ceased",
+				"3": "On order",
+				"4": "Currently received",
+				"5": "Not currently received",
+			}},
+			{Name: "Method of acquisition", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"c": "Cooperative or consortial purchase",
+				"d": "Deposit",
+				"e": "Exchange",
+				"f": "Free",
+				"g": "Gift",
+				"l": "Legal deposit",
+				"m": "Membership",
+				"n": "Non-library purchase",
+				"p": "Purchase",
+				"q": "Lease",
+				"u": "Unknown",
+				"z": "Other method of acquisition",
+			}},
+			{Name: "Expected acquisition end date", Offset: 8, Width: 4, CodeWidth: 4},
+			{Name: "General retention policy", Offset: 12, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Unknown",
+				"1": "Other general retention policy",
+				"2": "Retained except as replaced by updates",
+				"3": "Sample issue retained",
+				"4": "Retained until replaced by microform",
+				"5": "Retained until replaced by cumulation, replacement volume, or revision",
+				"6": "Retained for a limited period",
+				"7": "Not retained",
+				"8": "Permanently retained",
+			}},
+			{Name: "Specific retention policy", Offset: 13, Width: 3, CodeWidth: 3},
+			{Name: "Completeness", Offset: 16, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Other",
+				"1": "Complete",
+				"2": "Incomplete",
+				"3": "Very incomplete or scattered",
+				"4": "Not applicable",
+			}},
+			{Name: "Number of copies reported", Offset: 17, Width: 3, CodeWidth: 3},
+			{Name: "Lending policy", Offset: 20, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Will lend",
+				"b": "Will not lend",
+				"c": "Will lend hard copy only",
+				"l": "Limited lending policy",
+				"u": "Unknown",
+			}},
+			{Name: "Reproduction policy", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Will reproduce",
+				"b": "Will not reproduce",
+				"u": "Unknown",
+			}},
+			{Name: "Language", Offset: 22, Width: 3, CodeWidth: 3},
+			{Name: "Separate or composite copy report", Offset: 25, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "Separate copy report",
+				"1": "Composite copy report",
+			}},
+			{Name: "Date of report", Offset: 26, Width: 6, CodeWidth: 6},
+		},
+		"MP": {
+			{Name: "Relief", Offset: 18, Width: 4, CodeWidth: 1, Codes: map[string]string{
+				" ": "No relief shown",
+				"a": "Contours",
+				"b": "Shading",
+				"c": "Gradient and bathymetric tints",
+				"d": "Hachures",
+				"e": "Bathymetry/soundings",
+				"f": "Form lines",
+				"g": "Spot heights",
+				"i": "Pictorially",
+				"j": "Land forms",
+				"k": "Bathymetry/isolines",
+				"m": "Rock drawings",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Projection", Offset: 22, Width: 2, CodeWidth: 2, Codes: map[string]string{
+				"  ": "Projection not specified",
+				"aa": "Aitoff",
+				"ab": "Gnomic",
+				"ac": "Lambert's azimuthal equal area",
+				"ad": "Orthographic",
+				"ae": "Azimuthal equidistant",
+				"af": "Stereographic",
+				"ag": "General vertical near-sided",
+				"am": "Modified stereographic for Alaska",
+				"an": "Chamberlin trimetric",
+				"ap": "Polar stereographic",
+				"au": "Azimuthal, specific type unknown",
+				"az": "Azimuthal, other",
+				"ba": "Gall",
+				"bb": "Goode's homolographic",
+				"bc": "Lambert's cylindrical equal area",
+				"bd": "Mercator",
+				"be": "Miller",
+				"bf": "Mollweide",
+				"bg": "Sinusoidal",
+				"bh": "Transverse Mercator",
+				"bi": "Gauss-Kruger",
+				"bj": "Equirectangular",
+				"bk": "Krovak",
+				"bl": "Cassini-Soldner",
+				"bo": "Oblique Mercator",
+				"br": "Robinson",
+				"bs": "Space oblique Mercator",
+				"bu": "Cylindrical, specific type unknown",
+				"bz": "Cylindrical, other",
+				"ca": "Albers equal area",
+				"cb": "Bonne",
+				"cc": "Lambert's conformal conic",
+				"ce": "Equidistant conic",
+				"cp": "Polyconic",
+				"cu": "Conic, specific type unknown",
+				"cz": "Conic, other",
+				"da": "Armadillo",
+				"db": "Butterfly",
+				"dc": "Eckert",
+				"dd": "Goode's homolosine",
+				"de": "Miller's bipolar oblique conformal conic",
+				"df": "Van Der Grinten",
+				"dg": "Dimaxion",
+				"dh": "Cordiform",
+				"dl": "Lambert conformal",
+				"zz": "Other",
+				"||": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 24, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of cartographic material", Offset: 25, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Single map",
+				"b": "Map series",
+				"c": "Map serial",
+				"d": "Globe",
+				"e": "Atlas",
+				"f": "Separate supplement to another work",
+				"g": "Bound as part of another work",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 26, Width: 2, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Government publication", Offset: 28, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not a government publication",
+				"a": "Autonomous or semi-autonomous component",
+				"c": "Multilocal",
+				"f": "Federal/national",
+				"i": "International intergovernmental",
+				"l": "Local",
+				"m": "Multistate",
+				"o": "Government publication-level undetermined",
+				"s": "State, provincial, territorial, dependent, etc.",
+				"u": "Unknown if item is government publication",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Form of item", Offset: 29, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "None of the following",
+				"a": "Microfilm",
+				"b": "Microfiche",
+				"c": "Microopaque",
+				"d": "Large print",
+				"f": "Braille",
+				"o": "Online",
+				"q": "Direct electronic",
+				"r": "Regular print reproduction",
+				"s": "Electronic",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 30, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Index", Offset: 31, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"0": "No index",
+				"1": "Index present",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 32, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Special format characteristics", Offset: 33, Width: 2, CodeWidth: 1, Codes: map[string]string{
+				" ": "No specified special format characteristics",
+				"e": "Manuscript",
+				"j": "Picture card, post card",
+				"k": "Calendar",
+				"l": "Puzzle",
+				"n": "Game",
+				"o": "Wall map",
+				"p": "Playing cards",
+				"r": "Loose-leaf",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+		"MU": {
+			{Name: "Form of composition", Offset: 18, Width: 2, CodeWidth: 2, Codes: map[string]string{
+				"an": "Anthems",
+				"bd": "Ballads",
+				"bg": "Bluegrass music",
+				"bl": "Blues",
+				"bt": "Ballets",
+				"ca": "Chaconnes",
+				"cb": "Chants, Other religions",
+				"cc": "Chant, Christian",
+				"cg": "Concerti grossi",
+				"ch": "Chorales",
+				"cl": "Chorale preludes",
+				"cn": "Canons and rounds",
+				"co": "Concertos",
+				"cp": "Chansons, polyphonic",
+				"cr": "Carols",
+				"cs": "Chance compositions",
+				"ct": "Cantatas",
+				"cy": "Country music",
+				"cz": "Canzonas",
+				"df": "Dance forms",
+				"dv": "Divertimentos, serenades, cassations, divertissements, and notturni",
+				"fg": "Fugues",
+				"fl": "Flamenco",
+				"fm": "Folk music",
+				"ft": "Fantasias",
+				"gm": "Gospel music",
+				"hy": "Hymns",
+				"jz": "Jazz",
+				"mc": "Musical revues and comedies",
+				"md": "Madrigals",
+				"mi": "Minuets",
+				"mo": "Motets",
+				"mp": "Motion picture music",
+				"mr": "Marches",
+				"ms": "Masses",
+				"mu": "Multiple forms",
+				"mz": "Mazurkas",
+				"nc": "Nocturnes",
+				"nn": "Not applicable",
+				"op": "Operas",
+				"or": "Oratorios",
+				"ov": "Overtures",
+				"pg": "Program music",
+				"pm": "Passion music",
+				"po": "Polonaises",
+				"pp": "Popular music",
+				"pr": "Preludes",
+				"ps": "Passacaglias",
+				"pt": "Part-songs",
+				"pv": "Pavans",
+				"rc": "Rock music",
+				"rd": "Rondos",
+				"rg": "Ragtime music",
+				"ri": "Ricercars",
+				"rp": "Rhapsodies",
+				"rq": "Requiems",
+				"sd": "Square dance music",
+				"sg": "Songs",
+				"sn": "Sonatas",
+				"sp": "Symphonic poems",
+				"st": "Studies and exercises",
+				"su": "Suites",
+				"sy": "Symphonies",
+				"tc": "Toccatas",
+				"tl": "Teatro lirico",
+				"ts": "Trio-sonatas",
+				"uu": "Unknown",
+				"vi": "Villancicos",
+				"vr": "Variations",
+				"wz": "Waltzes",
+				"za": "Zarzuelas",
+				"zz": "Other",
+				"||": "No attempt to code",
+			}},
+			{Name: "Format of music", Offset: 20, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Full score",
+				"b": "Miniature or study score",
+				"c": "Accompaniment reduced for keyboard",
+				"d": "Voice score with accompaniment omitted",
+				"e": "Condensed score or piano-conductor score",
+				"g": "Close score",
+				"h": "Chorus score",
+				"i": "Condensed score",
+				"j": "Performer-conductor part",
+				"k": "Vocal score",
+				"l": "Score",
+				"m": "Multiple score formats",
+				"n": "Not applicable",
+				"p": "Piano score",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Music parts", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "No parts in hand or not specified",
+				"d": "Instrumental and vocal parts",
+				"e": "Instrumental parts",
+				"f": "Vocal parts",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Target audience", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Unknown or not specified",
+				"a": "Preschool",
+				"b": "Primary",
+				"c": "Pre-adolescent",
+				"d": "Adolescent",
+				"e": "Adult",
+				"f": "Specialized",
+				"g": "General",
+				"j": "Juvenile",
+				"|": "No attempt to code",
+			}},
+			{Name: "Form of item", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "None of the following",
+				"a": "Microfilm",
+				"b": "Microfiche",
+				"c": "Microopaque",
+				"d": "Large print",
+				"f": "Braille",
+				"o": "Online",
+				"q": "Direct electronic",
+				"r": "Regular print reproduction",
+				"s": "Electronic",
+				"|": "No attempt to code",
+			}},
+			{Name: "Accompanying matter", Offset: 24, Width: 6, CodeWidth: 1, Codes: map[string]string{
+				" ": "No accompanying matter",
+				"a": "Discography",
+				"b": "Bibliography",
+				"c": "Thematic index",
+				"d": "Libretto or text",
+				"e": "Biography of composer or author",
+				"f": "Biography of performer or history of ensemble",
+				"g": "Technical and/or historical information on instruments",
+				"h": "Technical information on music",
+				"i": "Historical information",
+				"k": "Ethnological information",
+				"r": "Instructional materials",
+				"s": "Music",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Literary text for sound recordings", Offset: 30, Width: 2, CodeWidth: 1, Codes: map[string]string{
+				" ": "Item is a music sound recording",
+				"a": "Autobiography",
+				"b": "Biography",
+				"c": "Conference proceedings",
+				"d": "Drama",
+				"e": "Essays",
+				"f": "Fiction",
+				"g": "Reporting",
+				"h": "History",
+				"i": "Instruction",
+				"j": "Language instruction",
+				"k": "Comedy",
+				"l": "Lectures, speeches",
+				"m": "Memoirs",
+				"n": "Not applicable",
+				"o": "Folktales",
+				"p": "Poetry",
+				"r": "Rehearsals",
+				"s": "Sounds",
+				"t": "Interviews",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 32, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Transposition and arrangement", Offset: 33, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not arrangement or transposition or not specified",
+				"a": "Transposition",
+				"b": "Arrangement",
+				"c": "Both transposed and arranged",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 34, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+		},
+		"MX": {
+			{Name: "Undefined", Offset: 18, Width: 5, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Form of item", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "None of the following",
+				"a": "Microfilm",
+				"b": "Microfiche",
+				"c": "Microopaque",
+				"d": "Large print",
+				"f": "Braille",
+				"o": "Online",
+				"q": "Direct electronic",
+				"r": "Regular print reproduction",
+				"s": "Electronic",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 24, Width: 11, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+		},
+		"VM": {
+			{Name: "Running time for motion pictures and videorecordings", Offset: 18, Width: 3, CodeWidth: 3},
+			{Name: "Undefined", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Target audience", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Unknown or not specified",
+				"a": "Preschool",
+				"b": "Primary",
+				"c": "Pre-adolescent",
+				"d": "Adolescent",
+				"e": "Adult",
+				"f": "Specialized",
+				"g": "General",
+				"j": "Juvenile",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 23, Width: 5, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Government publication", Offset: 28, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "Not a government publication",
+				"a": "Autonomous or semi-autonomous component",
+				"c": "Multilocal",
+				"f": "Federal/national",
+				"i": "International intergovernmental",
+				"l": "Local",
+				"m": "Multistate",
+				"o": "Government publication-level undetermined",
+				"s": "State, provincial, territorial, dependent, etc.",
+				"u": "Unknown if item is government publication",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Form of item", Offset: 29, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				" ": "None of the following",
+				"a": "Microfilm",
+				"b": "Microfiche",
+				"c": "Microopaque",
+				"d": "Large print",
+				"f": "Braille",
+				"o": "Online",
+				"q": "Direct electronic",
+				"r": "Regular print reproduction",
+				"s": "Electronic",
+				"|": "No attempt to code",
+			}},
+			{Name: "Undefined", Offset: 30, Width: 3, CodeWidth: 1, Codes: map[string]string{
+				" ": "Undefined",
+				"|": "No attempt to code",
+			}},
+			{Name: "Type of visual material", Offset: 33, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Art original",
+				"b": "Kit",
+				"c": "Art reproduction",
+				"d": "Diorama",
+				"f": "Filmstrip",
+				"g": "Game",
+				"i": "Picture",
+				"k": "Graphic",
+				"l": "Technical drawing",
+				"m": "Motion picture",
+				"n": "Chart",
+				"o": "Flash card",
+				"p": "Microscope slide",
+				"q": "Model",
+				"r": "Realia",
+				"s": "Slide",
+				"t": "Transparency",
+				"v": "Videorecording",
+				"w": "Toy",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+			{Name: "Technique", Offset: 34, Width: 1, CodeWidth: 1, Codes: map[string]string{
+				"a": "Animation",
+				"c": "Animation and live action",
+				"l": "Live action",
+				"n": "Not applicable",
+				"u": "Unknown",
+				"z": "Other",
+				"|": "No attempt to code",
+			}},
+		},
+	},
+}
+
+// DateEnteredOnFile returns the "008/00-05 - Date entered on file" of the record
+func (rec Record) DateEnteredOnFile() string {
+	code, _ := rec.f008Value("Date entered on file")
+	return code
+}
+
+// TypeOfDate returns the code and label indicating the "008/06 - Type of date/Publication status" of a Bibliography record
+func (rec Record) TypeOfDate() (code, label string) {
+	return rec.f008Value("Type of date/Publication status")
+}
+
+// Date1 returns the "008/07-10 - Date 1" of a Bibliography record
+func (rec Record) Date1() string {
+	code, _ := rec.f008Value("Date 1")
+	return code
+}
+
+// Date2 returns the "008/11-14 - Date 2" of a Bibliography record
+func (rec Record) Date2() string {
+	code, _ := rec.f008Value("Date 2")
+	return code
+}
+
+// PlaceOfPublication returns the "008/15-17 - Place of publication, production, or execution" of a Bibliography record
+func (rec Record) PlaceOfPublication() string {
+	code, _ := rec.f008Value("Place of publication, production, or execution")
+	return code
+}
+
+// Language returns the "008 - Language" of a Bibliography, Holdings or Community Information record
+func (rec Record) Language() string {
+	code, _ := rec.f008Value("Language")
+	return code
+}
+
+// ModifiedRecord returns the code and label indicating the "008/38 - Modified record" of a Bibliography or Authority record
+func (rec Record) ModifiedRecord() (code, label string) {
+	return rec.f008Value("Modified record")
+}
+
+// CatalogingSource returns the code and label indicating the "008/39 - Cataloging source" of a Bibliography or Authority record
+func (rec Record) CatalogingSource() (code, label string) {
+	return rec.f008Value("Cataloging source")
+}
+
+// TargetAudience returns the code and label indicating the "008/22 - Target audience" of a Bibliography record, for those material types that define it
+func (rec Record) TargetAudience() (code, label string) {
+	return rec.f008Value("Target audience")
+}
+
+// FormOfItem returns the code and label indicating the "008 - Form of item" of a Bibliography record
+func (rec Record) FormOfItem() (code, label string) {
+	return rec.f008Value("Form of item")
+}
+
+// NatureOfContents returns the code and label indicating the "008 - Nature of contents" of a Bibliography record, for those material types that define it
+func (rec Record) NatureOfContents() (code, label string) {
+	return rec.f008Value("Nature of contents")
+}
+
+// GovernmentPublication returns the code and label indicating the "008/28 - Government publication" of a Bibliography record, for those material types that define it
+func (rec Record) GovernmentPublication() (code, label string) {
+	return rec.f008Value("Government publication")
+}
+
+// ConferencePublication returns the code and label indicating the "008/29 - Conference publication" of a Bibliography record, for those material types that define it
+func (rec Record) ConferencePublication() (code, label string) {
+	return rec.f008Value("Conference publication")
+}
+
+// IndexPresent returns the code and label indicating the "008/31 - Index" of a Bibliography record, for those material types that define it
+func (rec Record) IndexPresent() (code, label string) {
+	return rec.f008Value("Index")
+}
+
+// KindOfRecord returns the code and label indicating the "008 - Kind of record" of an Authority or Classification record
+func (rec Record) KindOfRecord() (code, label string) {
+	return rec.f008Value("Kind of record")
+}
+
+// RecordUpdateInProcess returns the code and label indicating the "008 - Record update in process" of an Authority or Classification record
+func (rec Record) RecordUpdateInProcess() (code, label string) {
+	return rec.f008Value("Record update in process")
+}
+
+// LevelOfEstablishment returns the code and label indicating the "008 - Level of establishment" of an Authority or Classification record
+func (rec Record) LevelOfEstablishment() (code, label string) {
+	return rec.f008Value("Level of establishment")
+}
@@ -0,0 +1,78 @@
+// Code generated by codegen/gen-tables.go; DO NOT EDIT.
+
+package marc21
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFixed008Accessors(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		leader  string
+		get     func(Record) (string, string)
+		element string
+		value   string
+		label   string
+	}{
+		{"DateEnteredOnFile/All Materials", "00000nam a2200000   4500", func(rec Record) (string, string) { return rec.DateEnteredOnFile(), "" }, "Date entered on file", "xxxxxx", ""},
+		{"DateEnteredOnFile/Holdings", "00000nx  a2200000   4500", func(rec Record) (string, string) { return rec.DateEnteredOnFile(), "" }, "Date entered on file", "xxxxxx", ""},
+		{"DateEnteredOnFile/Authority", "00000nz  a2200000n  4500", func(rec Record) (string, string) { return rec.DateEnteredOnFile(), "" }, "Date entered on file", "xxxxxx", ""},
+		{"DateEnteredOnFile/Classification", "00000nw  a2200000n  4500", func(rec Record) (string, string) { return rec.DateEnteredOnFile(), "" }, "Date entered on file", "xxxxxx", ""},
+		{"DateEnteredOnFile/Community Information", "00000nq  a2200000n  4500", func(rec Record) (string, string) { return rec.DateEnteredOnFile(), "" }, "Date entered on file", "xxxxxx", ""},
+		{"TypeOfDate/All Materials", "00000nam a2200000   4500", Record.TypeOfDate, "Type of date/Publication status", "b", "No dates given; B.C. date involved"},
+		{"Date1/All Materials", "00000nam a2200000   4500", func(rec Record) (string, string) { return rec.Date1(), "" }, "Date 1", "xxxx", ""},
+		{"Date2/All Materials", "00000nam a2200000   4500", func(rec Record) (string, string) { return rec.Date2(), "" }, "Date 2", "xxxx", ""},
+		{"PlaceOfPublication/All Materials", "00000nam a2200000   4500", func(rec Record) (string, string) { return rec.PlaceOfPublication(), "" }, "Place of publication, production, or execution", "xxx", ""},
+		{"Language/All Materials", "00000nam a2200000   4500", func(rec Record) (string, string) { return rec.Language(), "" }, "Language", "xxx", ""},
+		{"Language/Holdings", "00000nx  a2200000   4500", func(rec Record) (string, string) { return rec.Language(), "" }, "Language", "xxx", ""},
+		{"Language/Community Information", "00000nq  a2200000n  4500", func(rec Record) (string, string) { return rec.Language(), "" }, "Language", "xxx", ""},
+		{"ModifiedRecord/All Materials", "00000nam a2200000   4500", Record.ModifiedRecord, "Modified record", " ", "Not modified"},
+		{"ModifiedRecord/Authority", "00000nz  a2200000n  4500", Record.ModifiedRecord, "Modified record", " ", "Not modified"},
+		{"CatalogingSource/All Materials", "00000nam a2200000   4500", Record.CatalogingSource, "Cataloging source", " ", "National bibliographic agency"},
+		{"CatalogingSource/Authority", "00000nz  a2200000n  4500", Record.CatalogingSource, "Cataloging source", " ", "National bibliographic agency"},
+		{"TargetAudience/BK", "00000nam a2200000   4500", Record.TargetAudience, "Target audience", " ", "Unknown or not specified"},
+		{"TargetAudience/CF", "00000nmm a2200000   4500", Record.TargetAudience, "Target audience", " ", "Unknown or not specified"},
+		{"TargetAudience/MU", "00000ncm a2200000   4500", Record.TargetAudience, "Target audience", " ", "Unknown or not specified"},
+		{"TargetAudience/VM", "00000ngm a2200000   4500", Record.TargetAudience, "Target audience", " ", "Unknown or not specified"},
+		{"FormOfItem/BK", "00000nam a2200000   4500", Record.FormOfItem, "Form of item", " ", "None of the following"},
+		{"FormOfItem/CF", "00000nmm a2200000   4500", Record.FormOfItem, "Form of item", " ", "Unknown or not specified"},
+		{"FormOfItem/CR", "00000nas a2200000   4500", Record.FormOfItem, "Form of item", " ", "None of the following"},
+		{"FormOfItem/MP", "00000nem a2200000   4500", Record.FormOfItem, "Form of item", " ", "None of the following"},
+		{"FormOfItem/MU", "00000ncm a2200000   4500", Record.FormOfItem, "Form of item", " ", "None of the following"},
+		{"FormOfItem/MX", "00000npm a2200000   4500", Record.FormOfItem, "Form of item", " ", "None of the following"},
+		{"FormOfItem/VM", "00000ngm a2200000   4500", Record.FormOfItem, "Form of item", " ", "None of the following"},
+		{"NatureOfContents/BK", "00000nam a2200000   4500", Record.NatureOfContents, "Nature of contents", "    ", "No specified nature of contents"},
+		{"NatureOfContents/CR", "00000nas a2200000   4500", Record.NatureOfContents, "Nature of contents", "   ", "Not specified"},
+		{"GovernmentPublication/BK", "00000nam a2200000   4500", Record.GovernmentPublication, "Government publication", " ", "Not a government publication"},
+		{"GovernmentPublication/CF", "00000nmm a2200000   4500", Record.GovernmentPublication, "Government publication", " ", "Not a government publication"},
+		{"GovernmentPublication/CR", "00000nas a2200000   4500", Record.GovernmentPublication, "Government publication", " ", "Not a government publication"},
+		{"GovernmentPublication/MP", "00000nem a2200000   4500", Record.GovernmentPublication, "Government publication", " ", "Not a government publication"},
+		{"GovernmentPublication/VM", "00000ngm a2200000   4500", Record.GovernmentPublication, "Government publication", " ", "Not a government publication"},
+		{"ConferencePublication/BK", "00000nam a2200000   4500", Record.ConferencePublication, "Conference publication", "0", "Not a conference publication"},
+		{"ConferencePublication/CR", "00000nas a2200000   4500", Record.ConferencePublication, "Conference publication", "0", "Not a conference publication"},
+		{"IndexPresent/BK", "00000nam a2200000   4500", Record.IndexPresent, "Index", "0", "No index"},
+		{"IndexPresent/MP", "00000nem a2200000   4500", Record.IndexPresent, "Index", "0", "No index"},
+		{"KindOfRecord/Authority", "00000nz  a2200000n  4500", Record.KindOfRecord, "Kind of record", "a", "Established heading"},
+		{"KindOfRecord/Classification", "00000nw  a2200000n  4500", Record.KindOfRecord, "Kind of record", "a", "Schedule record"},
+		{"RecordUpdateInProcess/Authority", "00000nz  a2200000n  4500", Record.RecordUpdateInProcess, "Record update in process", "a", "Record can be used"},
+		{"RecordUpdateInProcess/Classification", "00000nw  a2200000n  4500", Record.RecordUpdateInProcess, "Record update in process", "a", "Record can be used"},
+		{"LevelOfEstablishment/Authority", "00000nz  a2200000n  4500", Record.LevelOfEstablishment, "Level of establishment", "a", "Fully established"},
+		{"LevelOfEstablishment/Classification", "00000nw  a2200000n  4500", Record.LevelOfEstablishment, "Level of establishment", "a", "Fully established"},
+	}
+
+	for _, tc := range tests {
+		rec := Record{Leader: Leader{Text: tc.leader}}
+		rec.SetControlfield("008", strings.Repeat(" ", 40))
+		if err := rec.SetFixed008Element(tc.element, tc.value); err != nil {
+			t.Errorf("%s: set %q failed: %q", tc.name, tc.value, err)
+			continue
+		}
+		code, label := tc.get(rec)
+		if code != tc.value || label != tc.label {
+			t.Errorf("%s = %q, %q, expected %q, %q", tc.name, code, label, tc.value, tc.label)
+		}
+	}
+}
@@ -6,7 +6,6 @@ package marc21
 
 import (
 	"fmt"
-	"log"
 	"strings"
 )
 
@@ -39,7 +38,7 @@ http://www.loc.gov/marc/bibliographic/bdintro.html
 // http://www.loc.gov/marc/community/ci00x.html
 
 // extractControlfields extracts the control fields from the raw MARC record bytes
-func extractControlfields(rawRec []byte, baseAddress int, dir []*directoryEntry) (cfs []*Controlfield, err error) {
+func extractControlfields(rawRec []byte, baseAddress int, dir []*directoryEntry, ps *parseState) (cfs []*Controlfield, err error) {
 
 	// There are records where the 003 and 007 fields are dorky (this
 	// may happen to other fields also??) where the first byte is a
@@ -56,34 +55,38 @@ func extractControlfields(rawRec []byte, baseAddress int, dir []*directoryEntry)
 	// overlapping and the 007 and 008 tags overlapping with no actual
 	// data for either dorked-up 003/007 tag. Since the remainder of the
 	// record appears to be good we don't want to fail, but we do want
-	// to bring attention to the data issue.
-	var parseErrorTags []string
+	// to bring attention to the data issue (which is reported as a
+	// warning regardless of the parse mode).
 	var controlNumber string
 
-	for _, d := range dir {
-		if strings.HasPrefix(d.tag, "00") {
+	for i, d := range dir {
+		if d.invalid || !strings.HasPrefix(d.tag, "00") {
+			continue
+		}
 
-			start := baseAddress + d.startingPos
-			b := rawRec[start : start+d.fieldLength]
+		start := baseAddress + d.startingPos
+		b, ok := fieldBytes(rawRec, baseAddress, d)
+		if !ok {
+			if !ps.lenient() {
+				return nil, newParseError(start, d.tag, ErrFieldOutOfBounds)
+			}
+			ps.warn(ErrFieldOutOfBounds, d.tag, i, start, "field extends outside of the record, field skipped")
+			continue
+		}
 
-			if b[len(b)-1] == fieldTerminator {
-				if d.tag == "001" {
-					if controlNumber == "" {
-						controlNumber = string(b[:len(b)-1])
-					} else {
-						parseErrorTags = append(parseErrorTags, d.tag)
-					}
-				}
-				cfs = append(cfs, &Controlfield{Tag: d.tag, Text: string(b[:len(b)-1])})
+		if len(b) == 0 || b[len(b)-1] != fieldTerminator {
+			ps.warn(ErrNoFieldTerminator, d.tag, i, start, "field terminator not found at end of field, field skipped")
+			continue
+		}
+
+		if d.tag == "001" {
+			if controlNumber == "" {
+				controlNumber = string(b[:len(b)-1])
 			} else {
-				parseErrorTags = append(parseErrorTags, d.tag)
+				ps.warn(ErrRepeatedControlNumber, d.tag, i, start, "repeated control number field")
 			}
 		}
-	}
-
-	if len(parseErrorTags) > 0 {
-		badTags := strings.Join(parseErrorTags, ", ")
-		log.Printf("Control fields extraction error for ControlNumber %q (fields: %s)\n", controlNumber, badTags)
+		cfs = append(cfs, &Controlfield{Tag: d.tag, Text: string(b[:len(b)-1]), seq: i + 1})
 	}
 
 	return cfs, nil
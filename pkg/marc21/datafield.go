@@ -6,7 +6,6 @@ package marc21
 
 import (
 	"bytes"
-	"errors"
 	"strings"
 )
 
@@ -87,30 +86,59 @@ Also:
 */
 
 // extractDatafields extracts the data fields/sub-fields from the raw MARC record bytes
-func extractDatafields(rawRec []byte, baseAddress int, dir []*directoryEntry) (dfs []*Datafield, err error) {
+func extractDatafields(rawRec []byte, baseAddress int, dir []*directoryEntry, ps *parseState) (dfs []*Datafield, err error) {
 
-	for _, de := range dir {
-		if !strings.HasPrefix(de.tag, "00") {
-			start := baseAddress + de.startingPos
-			b := rawRec[start : start+de.fieldLength]
+	for i, de := range dir {
+		if de.invalid || strings.HasPrefix(de.tag, "00") {
+			continue
+		}
+
+		start := baseAddress + de.startingPos
+		b, ok := fieldBytes(rawRec, baseAddress, de)
+		if !ok {
+			if !ps.lenient() {
+				return nil, newParseError(start, de.tag, ErrFieldOutOfBounds)
+			}
+			ps.warn(ErrFieldOutOfBounds, de.tag, i, start, "field extends outside of the record, field skipped")
+			continue
+		}
+
+		if len(b) == 0 || b[len(b)-1] != fieldTerminator {
+			if !ps.lenient() {
+				return nil, newParseError(start, de.tag, ErrNoFieldTerminator)
+			}
 
-			if b[de.fieldLength-1] != fieldTerminator {
-				return nil, errors.New("extractDatafields: Field terminator not found at end of field")
+			// Use the data up to the first terminator, if there is
+			// one, otherwise use the data as given by the directory
+			if t := bytes.IndexByte(b, fieldTerminator); t >= 0 {
+				b = b[:t+1]
+			} else {
+				b = append(b[:len(b):len(b)], fieldTerminator)
 			}
+			ps.warn(ErrNoFieldTerminator, de.tag, i, start, "field terminator not found at end of field, field length adjusted to %d", len(b))
+		}
 
-			df := Datafield{
-				Tag:  de.tag,
-				Ind1: string(b[0]),
-				Ind2: string(b[1]),
+		if len(b) < 3 {
+			if !ps.lenient() {
+				return nil, newParseError(start, de.tag, ErrFieldTooShort)
 			}
+			ps.warn(ErrFieldTooShort, de.tag, i, start, "field is too short to contain indicators, field skipped")
+			continue
+		}
+
+		df := Datafield{
+			Tag:  de.tag,
+			Ind1: string(b[0]),
+			Ind2: string(b[1]),
+			seq:  i + 1,
+		}
 
-			for _, t := range bytes.Split(b[2:de.fieldLength-1], []byte{delimiter}) {
-				if len(t) > 0 {
-					df.Subfields = append(df.Subfields, &Subfield{Code: string(t[0]), Text: string(t[1:])})
-				}
+		for _, t := range bytes.Split(b[2:len(b)-1], []byte{delimiter}) {
+			if len(t) > 0 {
+				df.Subfields = append(df.Subfields, &Subfield{Code: string(t[0]), Text: string(t[1:])})
 			}
-			dfs = append(dfs, &df)
 		}
+		dfs = append(dfs, &df)
 	}
 
 	return dfs, nil
@@ -0,0 +1,146 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"sort"
+	"strings"
+)
+
+/*
+The content designators (tags, indicators and subfield codes) of the
+variable data fields are defined separately for each record format.
+The definitions (dftables.go) are generated from the LoC field lists
+(saved locally, see codegen/README.md).
+*/
+
+// DatafieldDef is the definition of a datafield for a record format
+type DatafieldDef struct {
+	// Tag is the tag of the field. The tags of local fields contain an
+	// "X" (as in "09X" or "9XX").
+	Tag string
+	// Label is the LoC name of the field
+	Label string
+	// Repeatable indicates that the field may occur more than once
+	Repeatable bool
+	// Obsolete indicates that the field is no longer defined
+	Obsolete bool
+	// Indicators are the definitions of the first and second indicators
+	Indicators [2]IndicatorDef
+	// Subfields are the definitions of the subfield codes. Fields with
+	// no subfield definitions (such as local fields) may contain any
+	// subfield.
+	Subfields []SubfieldDef
+}
+
+// IndicatorDef is the definition of an indicator of a datafield
+type IndicatorDef struct {
+	// Label is the LoC name of the indicator ("Undefined" if the
+	// indicator is undefined)
+	Label string
+	// Values are the valid values of the indicator. Indicators that
+	// have no values (such as those of the 880) may contain any value.
+	Values []IndicatorValue
+}
+
+// IndicatorValue is the definition of a single value of an indicator
+type IndicatorValue struct {
+	Code     string
+	Label    string
+	Obsolete bool
+}
+
+// SubfieldDef is the definition of a subfield code of a datafield
+type SubfieldDef struct {
+	Code       string
+	Label      string
+	Repeatable bool
+	Obsolete   bool
+}
+
+// datafieldIndex maps the tags of each format to their definitions
+var datafieldIndex = func() map[int]map[string]DatafieldDef {
+	index := make(map[int]map[string]DatafieldDef)
+	for format, defs := range datafieldDefs {
+		index[format] = make(map[string]DatafieldDef)
+		for _, def := range defs {
+			index[format][def.Tag] = def
+		}
+	}
+	return index
+}()
+
+// DatafieldDefinition returns the definition of a datafield tag for a
+// record format. Tags that are not otherwise defined are matched
+// against the local field definitions (such as "09X").
+func DatafieldDefinition(format int, tag string) (DatafieldDef, bool) {
+
+	defs := datafieldIndex[format]
+	if def, ok := defs[tag]; ok {
+		return def, true
+	}
+
+	for _, def := range datafieldDefs[format] {
+		if strings.Contains(def.Tag, "X") && MatchTag(def.Tag, tag) {
+			return def, true
+		}
+	}
+
+	return DatafieldDef{}, false
+}
+
+// DatafieldDefinitions returns the datafield definitions for a record
+// format, in tag order
+func DatafieldDefinitions(format int) []DatafieldDef {
+	defs := append([]DatafieldDef{}, datafieldDefs[format]...)
+	sort.SliceStable(defs, func(i, j int) bool { return defs[i].Tag < defs[j].Tag })
+	return defs
+}
+
+// DatafieldDefinition returns the definition of a datafield for the
+// format of the record
+func (rec Record) DatafieldDefinition(tag string) (DatafieldDef, bool) {
+	return DatafieldDefinition(rec.RecordFormat(), tag)
+}
+
+// Subfield returns the definition of a subfield code. Fields that have
+// no subfield definitions allow any code.
+func (def DatafieldDef) Subfield(code string) (SubfieldDef, bool) {
+
+	if len(def.Subfields) == 0 {
+		return SubfieldDef{Code: code, Repeatable: true}, true
+	}
+
+	for _, sf := range def.Subfields {
+		if sf.Code == code {
+			return sf, true
+		}
+	}
+
+	return SubfieldDef{}, false
+}
+
+// Indicator returns the definition of a value of the first (pos 1) or
+// second (pos 2) indicator. Indicators that have no defined values
+// allow any value.
+func (def DatafieldDef) Indicator(pos int, code string) (IndicatorValue, bool) {
+
+	if pos < 1 || pos > 2 {
+		return IndicatorValue{}, false
+	}
+
+	ind := def.Indicators[pos-1]
+	if len(ind.Values) == 0 {
+		return IndicatorValue{Code: code}, true
+	}
+
+	for _, v := range ind.Values {
+		if v.Code == code {
+			return v, true
+		}
+	}
+
+	return IndicatorValue{}, false
+}
@@ -0,0 +1,117 @@
+package marc21
+
+import (
+	"testing"
+)
+
+func TestDatafieldTables(t *testing.T) {
+
+	for format := Bibliography; format <= Community; format++ {
+		defs := DatafieldDefinitions(format)
+		if len(defs) == 0 {
+			t.Errorf("%s: no datafield definitions", marcFormatName[format])
+		}
+
+		for i, def := range defs {
+			if i > 0 && defs[i-1].Tag == def.Tag {
+				t.Errorf("%s: %s is defined more than once", marcFormatName[format], def.Tag)
+			}
+
+			codes := make(map[string]bool)
+			for _, sf := range def.Subfields {
+				if len(sf.Code) != 1 || codes[sf.Code] {
+					t.Errorf("%s %s: bad subfield code %q", marcFormatName[format], def.Tag, sf.Code)
+				}
+				codes[sf.Code] = true
+			}
+
+			for pos, ind := range def.Indicators {
+				for _, v := range ind.Values {
+					if len(v.Code) != 1 {
+						t.Errorf("%s %s: bad indicator %d code %q", marcFormatName[format], def.Tag, pos+1, v.Code)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDatafieldDefinition(t *testing.T) {
+
+	def, ok := DatafieldDefinition(Bibliography, "245")
+	if !ok {
+		t.Fatal("245 is not defined")
+	}
+	if def.Repeatable || def.Obsolete {
+		t.Errorf("245: Repeatable %v, Obsolete %v", def.Repeatable, def.Obsolete)
+	}
+	if sf, ok := def.Subfield("a"); !ok || sf.Repeatable {
+		t.Errorf("245 $a: %v, %v", sf, ok)
+	}
+	if sf, ok := def.Subfield("n"); !ok || !sf.Repeatable {
+		t.Errorf("245 $n: %v, %v", sf, ok)
+	}
+	if _, ok := def.Subfield("j"); ok {
+		t.Error("245 $j should not be defined")
+	}
+	for _, code := range []string{"0", "9"} {
+		if _, ok := def.Indicator(2, code); !ok {
+			t.Errorf("245 second indicator %q should be defined", code)
+		}
+	}
+	if _, ok := def.Indicator(1, " "); ok {
+		t.Error("245 first indicator blank should not be defined")
+	}
+	if _, ok := def.Indicator(3, "0"); ok {
+		t.Error("245 has no third indicator")
+	}
+
+	if def, ok := DatafieldDefinition(Bibliography, "440"); !ok || !def.Obsolete {
+		t.Errorf("440: %v, Obsolete %v", ok, def.Obsolete)
+	}
+
+	if def, ok := DatafieldDefinition(Bibliography, "880"); !ok {
+		t.Error("880 is not defined")
+	} else if _, ok := def.Indicator(1, "7"); !ok {
+		t.Error("880 should allow any indicator")
+	}
+
+	local := map[string]string{"090": "09X", "999": "9XX", "590": "59X"}
+	for tag, expected := range local {
+		if def, ok := DatafieldDefinition(Bibliography, tag); !ok || def.Tag != expected {
+			t.Errorf("%s: got %q, expected %q", tag, def.Tag, expected)
+		} else if _, ok := def.Subfield("z"); !ok {
+			t.Errorf("%s should allow any subfield", tag)
+		}
+	}
+
+	undefined := []struct {
+		format int
+		tag    string
+	}{
+		{Bibliography, "012"},
+		{Holdings, "245"},
+		{Authority, "245"},
+		{Classification, "245"},
+		{Community, "100"},
+		{FmtUnknown, "245"},
+	}
+	for _, u := range undefined {
+		if _, ok := DatafieldDefinition(u.format, u.tag); ok {
+			t.Errorf("%s %s should not be defined", marcFormatName[u.format], u.tag)
+		}
+	}
+}
+
+func TestRecordDatafieldDefinition(t *testing.T) {
+
+	rec := newTestRecord("1")
+	if def, ok := rec.DatafieldDefinition("100"); !ok || def.Label != "MAIN ENTRY--PERSONAL NAME" {
+		t.Errorf("100: %v, %q", ok, def.Label)
+	}
+
+	rec.Leader.Text = "00000nz  a2200000n  4500"
+	if def, ok := rec.DatafieldDefinition("100"); !ok || def.Label != "HEADING--PERSONAL NAME" {
+		t.Errorf("authority 100: %v, %q", ok, def.Label)
+	}
+}
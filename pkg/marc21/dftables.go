@@ -0,0 +1,10986 @@
+// Code generated by codegen/gen-tables.go; DO NOT EDIT.
+
+package marc21
+
+// The datafield definitions for each record format, from the LoC field lists
+//
+//	http://www.loc.gov/marc/bibliographic/ecbdlist.html
+//	http://www.loc.gov/marc/holdings/echdlist.html
+//	http://www.loc.gov/marc/authority/ecadlist.html
+//	http://www.loc.gov/marc/classification/eccdlist.html
+//	http://www.loc.gov/marc/community/eccilist.html
+var datafieldDefs = map[int][]DatafieldDef{
+	Bibliography: {
+		{Tag: "010", Label: "LIBRARY OF CONGRESS CONTROL NUMBER", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "LC control number"},
+			{Code: "b", Label: "NUCMC control number", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid LC control number", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "011", Label: "LINKING LIBRARY OF CONGRESS CONTROL NUMBER", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "LINKING LC control number", Repeatable: true},
+		}},
+		{Tag: "013", Label: "PATENT CONTROL INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Number"},
+			{Code: "b", Label: "Country"},
+			{Code: "c", Label: "Type of number"},
+			{Code: "d", Label: "Date", Repeatable: true},
+			{Code: "e", Label: "Status", Repeatable: true},
+			{Code: "f", Label: "Party to document", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "015", Label: "NATIONAL BIBLIOGRAPHY NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "National bibliography number", Repeatable: true},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid national bibliography number", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "016", Label: "NATIONAL BIBLIOGRAPHIC AGENCY CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "National bibliographic agency", Values: []IndicatorValue{
+				{Code: " ", Label: "Library and Archives Canada"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Record control number"},
+			{Code: "z", Label: "Canceled/invalid control number", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "017", Label: "COPYRIGHT OR LEGAL DEPOSIT NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Copyright or legal deposit number"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Copyright or legal deposit number", Repeatable: true},
+			{Code: "b", Label: "Assigning agency"},
+			{Code: "d", Label: "Date"},
+			{Code: "i", Label: "Display text"},
+			{Code: "z", Label: "Canceled/invalid copyright or legal deposit number", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "018", Label: "COPYRIGHT ARTICLE-FEE CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Copyright article-fee code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "020", Label: "INTERNATIONAL STANDARD BOOK NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "International Standard Book Number"},
+			{Code: "b", Label: "Binding information", Obsolete: true},
+			{Code: "c", Label: "Terms of availability"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid ISBN", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "022", Label: "INTERNATIONAL STANDARD SERIAL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Level of international interest", Values: []IndicatorValue{
+				{Code: " ", Label: "No level specified"},
+				{Code: "0", Label: "Continuing resource of international interest"},
+				{Code: "1", Label: "Continuing resource not of international interest"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "International Standard Serial Number"},
+			{Code: "l", Label: "ISSN-L"},
+			{Code: "m", Label: "Canceled ISSN-L", Repeatable: true},
+			{Code: "y", Label: "Incorrect ISSN", Repeatable: true},
+			{Code: "z", Label: "Canceled ISSN", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "024", Label: "OTHER STANDARD IDENTIFIER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of standard number or code", Values: []IndicatorValue{
+				{Code: "0", Label: "International Standard Recording Code"},
+				{Code: "1", Label: "Universal Product Code"},
+				{Code: "2", Label: "International Standard Music Number"},
+				{Code: "3", Label: "International Article Number"},
+				{Code: "4", Label: "Serial Item and Contribution Identifier"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+				{Code: "8", Label: "Unspecified type of standard number or code"},
+			}},
+			{Label: "Difference indicator", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No difference"},
+				{Code: "1", Label: "Difference"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Standard number or code"},
+			{Code: "c", Label: "Terms of availability"},
+			{Code: "d", Label: "Additional codes following the standard number or code"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid standard number or code", Repeatable: true},
+			{Code: "2", Label: "Source of number or code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "025", Label: "OVERSEAS ACQUISITION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Overseas acquisition number", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "026", Label: "FINGERPRINT IDENTIFIER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "First and second groups of characters"},
+			{Code: "b", Label: "Third and fourth groups of characters"},
+			{Code: "c", Label: "Date"},
+			{Code: "d", Label: "Number of volume or part", Repeatable: true},
+			{Code: "e", Label: "Unparsed fingerprint"},
+			{Code: "2", Label: "Source"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "027", Label: "STANDARD TECHNICAL REPORT NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Standard technical report number"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "028", Label: "PUBLISHER OR DISTRIBUTOR NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of number", Values: []IndicatorValue{
+				{Code: "0", Label: "Issue number"},
+				{Code: "1", Label: "Matrix number"},
+				{Code: "2", Label: "Plate number"},
+				{Code: "3", Label: "Other music publisher number"},
+				{Code: "4", Label: "Video recording publisher number"},
+				{Code: "5", Label: "Other publisher number"},
+				{Code: "6", Label: "Distributor number"},
+			}},
+			{Label: "Note/added entry controller", Values: []IndicatorValue{
+				{Code: "0", Label: "No note, no added entry"},
+				{Code: "1", Label: "Note, added entry"},
+				{Code: "2", Label: "Note, no added entry"},
+				{Code: "3", Label: "No note, added entry"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Publisher or distributor number"},
+			{Code: "b", Label: "Source"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "030", Label: "CODEN DESIGNATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "CODEN"},
+			{Code: "z", Label: "Canceled/invalid CODEN", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "031", Label: "MUSICAL INCIPITS INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Number of work"},
+			{Code: "b", Label: "Number of movement"},
+			{Code: "c", Label: "Number of excerpt"},
+			{Code: "d", Label: "Caption or heading", Repeatable: true},
+			{Code: "e", Label: "Role"},
+			{Code: "g", Label: "Clef"},
+			{Code: "m", Label: "Voice/instrument"},
+			{Code: "n", Label: "Key signature"},
+			{Code: "o", Label: "Time signature"},
+			{Code: "p", Label: "Musical notation"},
+			{Code: "q", Label: "General note", Repeatable: true},
+			{Code: "r", Label: "Key or mode"},
+			{Code: "s", Label: "Coded validity note", Repeatable: true},
+			{Code: "t", Label: "Text incipit", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "y", Label: "Link text", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "System code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "032", Label: "POSTAL REGISTRATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Postal registration number"},
+			{Code: "b", Label: "Source agency assigning number"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "033", Label: "DATE/TIME AND PLACE OF AN EVENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of date in subfield $a", Values: []IndicatorValue{
+				{Code: " ", Label: "No date information"},
+				{Code: "0", Label: "Single date"},
+				{Code: "1", Label: "Multiple single dates"},
+				{Code: "2", Label: "Range of dates"},
+			}},
+			{Label: "Type of event", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Capture"},
+				{Code: "1", Label: "Broadcast"},
+				{Code: "2", Label: "Finding"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Formatted date/time", Repeatable: true},
+			{Code: "b", Label: "Geographic classification area code", Repeatable: true},
+			{Code: "c", Label: "Geographic classification subarea code", Repeatable: true},
+			{Code: "p", Label: "Place of event", Repeatable: true},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "034", Label: "CODED CARTOGRAPHIC MATHEMATICAL DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of scale", Values: []IndicatorValue{
+				{Code: "0", Label: "Scale indeterminable/No scale recorded"},
+				{Code: "1", Label: "Single scale"},
+				{Code: "3", Label: "Range of scales"},
+			}},
+			{Label: "Type of ring", Values: []IndicatorValue{
+				{Code: " ", Label: "Not applicable"},
+				{Code: "0", Label: "Outer ring"},
+				{Code: "1", Label: "Exclusion ring"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Category of scale"},
+			{Code: "b", Label: "Constant ratio linear horizontal scale", Repeatable: true},
+			{Code: "c", Label: "Constant ratio linear vertical scale", Repeatable: true},
+			{Code: "d", Label: "Coordinates--westernmost longitude"},
+			{Code: "e", Label: "Coordinates--easternmost longitude"},
+			{Code: "f", Label: "Coordinates--northernmost latitude"},
+			{Code: "g", Label: "Coordinates--southernmost latitude"},
+			{Code: "h", Label: "Angular scale", Repeatable: true},
+			{Code: "j", Label: "Declination--northern limit"},
+			{Code: "k", Label: "Declination--southern limit"},
+			{Code: "m", Label: "Right ascension--eastern limit"},
+			{Code: "n", Label: "Right ascension--western limit"},
+			{Code: "p", Label: "Equinox"},
+			{Code: "r", Label: "Distance from earth"},
+			{Code: "s", Label: "G-ring latitude", Repeatable: true},
+			{Code: "t", Label: "G-ring longitude", Repeatable: true},
+			{Code: "x", Label: "Beginning date"},
+			{Code: "y", Label: "Ending date"},
+			{Code: "z", Label: "Name of extraterrestrial body"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "035", Label: "SYSTEM CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "System control number"},
+			{Code: "z", Label: "Canceled/invalid control number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "036", Label: "ORIGINAL STUDY NUMBER FOR COMPUTER DATA FILES", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Original study number"},
+			{Code: "b", Label: "Source agency assigning number"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "037", Label: "SOURCE OF ACQUISITION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Source of acquisition sequence", Values: []IndicatorValue{
+				{Code: " ", Label: "Not applicable/No information provided/Earliest"},
+				{Code: "2", Label: "Intervening"},
+				{Code: "3", Label: "Current/Latest"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Stock number"},
+			{Code: "b", Label: "Source of stock number/acquisition"},
+			{Code: "c", Label: "Terms of availability", Repeatable: true},
+			{Code: "f", Label: "Form of issue", Repeatable: true},
+			{Code: "g", Label: "Additional format characteristics", Repeatable: true},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "038", Label: "RECORD CONTENT LICENSOR", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Record content licensor"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "040", Label: "CATALOGING SOURCE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Original cataloging agency"},
+			{Code: "b", Label: "Language of cataloging"},
+			{Code: "c", Label: "Transcribing agency"},
+			{Code: "d", Label: "Modifying agency", Repeatable: true},
+			{Code: "e", Label: "Description conventions", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "041", Label: "LANGUAGE CODE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Translation indication", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Item not a translation/does not include a translation"},
+				{Code: "1", Label: "Item is or includes a translation"},
+			}},
+			{Label: "Source of code", Values: []IndicatorValue{
+				{Code: " ", Label: "MARC language code"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Language code of text/sound track or separate title", Repeatable: true},
+			{Code: "b", Label: "Language code of summary or abstract", Repeatable: true},
+			{Code: "d", Label: "Language code of sung or spoken text", Repeatable: true},
+			{Code: "e", Label: "Language code of librettos", Repeatable: true},
+			{Code: "f", Label: "Language code of table of contents", Repeatable: true},
+			{Code: "g", Label: "Language code of accompanying material other than librettos and transcripts", Repeatable: true},
+			{Code: "h", Label: "Language code of original", Repeatable: true},
+			{Code: "i", Label: "Language code of intertitles", Repeatable: true},
+			{Code: "j", Label: "Language code of subtitles", Repeatable: true},
+			{Code: "k", Label: "Language code of intermediate translations", Repeatable: true},
+			{Code: "m", Label: "Language code of original accompanying materials other than librettos", Repeatable: true},
+			{Code: "n", Label: "Language code of original libretto", Repeatable: true},
+			{Code: "p", Label: "Language code of captions", Repeatable: true},
+			{Code: "q", Label: "Language code of accessible audio", Repeatable: true},
+			{Code: "r", Label: "Language code of accessible visual language (non-textual)", Repeatable: true},
+			{Code: "t", Label: "Language code of accompanying transcripts for audiovisual materials", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of code", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "042", Label: "AUTHENTICATION CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Authentication code", Repeatable: true},
+		}},
+		{Tag: "043", Label: "GEOGRAPHIC AREA CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic area code", Repeatable: true},
+			{Code: "b", Label: "Local GAC code", Repeatable: true},
+			{Code: "c", Label: "ISO code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of local code", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "044", Label: "COUNTRY OF PUBLISHING/PRODUCING ENTITY CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "MARC country code", Repeatable: true},
+			{Code: "b", Label: "Local subentity code", Repeatable: true},
+			{Code: "c", Label: "ISO country code", Repeatable: true},
+			{Code: "2", Label: "Source of local subentity code", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "045", Label: "TIME PERIOD OF CONTENT", Indicators: [2]IndicatorDef{
+			{Label: "Type of time period in subfield $b or $c", Values: []IndicatorValue{
+				{Code: " ", Label: "Subfield $b or $c not present"},
+				{Code: "0", Label: "Single date/time"},
+				{Code: "1", Label: "Multiple single dates/times"},
+				{Code: "2", Label: "Range of dates/times"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Time period code", Repeatable: true},
+			{Code: "b", Label: "Formatted 9999 B.C. through C.E. time period", Repeatable: true},
+			{Code: "c", Label: "Formatted pre-9999 B.C. time period", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "046", Label: "SPECIAL CODED DATES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of entity", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "1", Label: "Work"},
+				{Code: "2", Label: "Expression"},
+				{Code: "3", Label: "Manifestation"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Type of date code"},
+			{Code: "b", Label: "Date 1, B.C.E. date"},
+			{Code: "c", Label: "Date 1, C.E. date"},
+			{Code: "d", Label: "Date 2, B.C.E. date"},
+			{Code: "e", Label: "Date 2, C.E. date"},
+			{Code: "j", Label: "Date resource modified"},
+			{Code: "k", Label: "Beginning or single date created"},
+			{Code: "l", Label: "Ending date created"},
+			{Code: "m", Label: "Beginning of date valid"},
+			{Code: "n", Label: "End of date valid"},
+			{Code: "o", Label: "Single or starting date for aggregated content"},
+			{Code: "p", Label: "Ending date for aggregated content"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Source of date"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "047", Label: "FORM OF MUSICAL COMPOSITION CODE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of code", Values: []IndicatorValue{
+				{Code: " ", Label: "MARC musical composition code"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Form of musical composition code", Repeatable: true},
+			{Code: "2", Label: "Source of code"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "048", Label: "NUMBER OF MUSICAL INSTRUMENTS OR VOICES CODES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of code", Values: []IndicatorValue{
+				{Code: " ", Label: "MARC code"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Performer or ensemble", Repeatable: true},
+			{Code: "b", Label: "Soloist", Repeatable: true},
+			{Code: "2", Label: "Source of code"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "050", Label: "LIBRARY OF CONGRESS CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Existence in LC collection", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Item is in LC"},
+				{Code: "1", Label: "Item is not in LC"},
+			}},
+			{Label: "Source of call number", Values: []IndicatorValue{
+				{Code: "0", Label: "Assigned by LC"},
+				{Code: "4", Label: "Assigned by agency other than LC"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "051", Label: "LIBRARY OF CONGRESS COPY, ISSUE, OFFPRINT STATEMENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number"},
+			{Code: "b", Label: "Item number"},
+			{Code: "c", Label: "Copy information"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "052", Label: "GEOGRAPHIC CLASSIFICATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Code source", Values: []IndicatorValue{
+				{Code: " ", Label: "Library of Congress Classification"},
+				{Code: "1", Label: "U.S. Dept. of Defense Classification"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic classification area code"},
+			{Code: "b", Label: "Geographic classification subarea code", Repeatable: true},
+			{Code: "d", Label: "Populated place name", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Code source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "055", Label: "CLASSIFICATION NUMBERS ASSIGNED IN CANADA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Existence in LAC collection", Values: []IndicatorValue{
+				{Code: " ", Label: "Information not provided"},
+				{Code: "0", Label: "Work held by LAC"},
+				{Code: "1", Label: "Work not held by LAC"},
+			}},
+			{Label: "Type, completeness, source of class/call number", Values: []IndicatorValue{
+				{Code: "0", Label: "LC-based call number assigned by LAC"},
+				{Code: "1", Label: "Complete LC class number assigned by LAC"},
+				{Code: "2", Label: "Incomplete LC class number assigned by LAC"},
+				{Code: "3", Label: "LC-based call number assigned by the contributing library"},
+				{Code: "4", Label: "Complete LC class number assigned by the contributing library"},
+				{Code: "5", Label: "Incomplete LC class number assigned by the contributing library"},
+				{Code: "6", Label: "Other call number assigned by LAC"},
+				{Code: "7", Label: "Other class number assigned by LAC"},
+				{Code: "8", Label: "Other call number assigned by the contributing library"},
+				{Code: "9", Label: "Other class number assigned by the contributing library"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number"},
+			{Code: "b", Label: "Item number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of call/class number"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "060", Label: "NATIONAL LIBRARY OF MEDICINE CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Existence in NLM collection", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Item is in NLM"},
+				{Code: "1", Label: "Item is not in NLM"},
+			}},
+			{Label: "Source of call number", Values: []IndicatorValue{
+				{Code: "0", Label: "Assigned by NLM"},
+				{Code: "4", Label: "Assigned by agency other than NLM"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "061", Label: "NATIONAL LIBRARY OF MEDICINE COPY STATEMENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "c", Label: "Copy information"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "066", Label: "CHARACTER SETS PRESENT", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Primary G0 character set"},
+			{Code: "b", Label: "Primary G1 character set"},
+			{Code: "c", Label: "Alternate G0 or G1 character set", Repeatable: true},
+		}},
+		{Tag: "070", Label: "NATIONAL AGRICULTURAL LIBRARY CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Existence in NAL collection", Values: []IndicatorValue{
+				{Code: "0", Label: "Item is in NAL"},
+				{Code: "1", Label: "Item is not in NAL"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "071", Label: "NATIONAL AGRICULTURAL LIBRARY COPY STATEMENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "c", Label: "Copy information", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "072", Label: "SUBJECT CATEGORY CODE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Code source", Values: []IndicatorValue{
+				{Code: "0", Label: "NAL subject category code list"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Subject category code"},
+			{Code: "x", Label: "Subject category code subdivision", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "074", Label: "GPO ITEM NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "GPO item number"},
+			{Code: "z", Label: "Canceled/invalid GPO item number", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "080", Label: "UNIVERSAL DECIMAL CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of edition", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Full"},
+				{Code: "1", Label: "Abridged"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Universal Decimal Classification number"},
+			{Code: "b", Label: "Item number"},
+			{Code: "x", Label: "Common auxiliary subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Edition identifier"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "082", Label: "DEWEY DECIMAL CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of edition", Values: []IndicatorValue{
+				{Code: "0", Label: "Full edition"},
+				{Code: "1", Label: "Abridged edition"},
+				{Code: "7", Label: "Other edition specified in subfield $2"},
+			}},
+			{Label: "Source of classification number", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Assigned by LC"},
+				{Code: "4", Label: "Assigned by agency other than LC"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "m", Label: "Standard or optional designation"},
+			{Code: "q", Label: "Assigning agency"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Edition information"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "083", Label: "ADDITIONAL DEWEY DECIMAL CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of edition", Values: []IndicatorValue{
+				{Code: "0", Label: "Full edition"},
+				{Code: "1", Label: "Abridged edition"},
+				{Code: "7", Label: "Other edition specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "c", Label: "Classification number--Ending number of span", Repeatable: true},
+			{Code: "m", Label: "Standard or optional designation"},
+			{Code: "q", Label: "Assigning agency"},
+			{Code: "y", Label: "Table sequence number for internal subarrangement or add table", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Edition information"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "084", Label: "OTHER CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of number", Values: []IndicatorValue{
+				{Code: " ", Label: "Not applicable"},
+				{Code: "0", Label: "Number source assigned"},
+				{Code: "1", Label: "Number source does not assign"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "q", Label: "Assigning agency"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Number source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "085", Label: "SYNTHESIZED CLASSIFICATION NUMBER COMPONENTS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Number where instructions are found-single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Base number", Repeatable: true},
+			{Code: "c", Label: "Classification number-ending number of span", Repeatable: true},
+			{Code: "f", Label: "Facet designator", Repeatable: true},
+			{Code: "r", Label: "Root number", Repeatable: true},
+			{Code: "s", Label: "Digits added from classification number in schedule or external table", Repeatable: true},
+			{Code: "t", Label: "Digits added from internal subarrangement or add table", Repeatable: true},
+			{Code: "u", Label: "Number being analyzed", Repeatable: true},
+			{Code: "v", Label: "Number in internal subarrangement or add table where instructions are found", Repeatable: true},
+			{Code: "w", Label: "Table identification-Internal subarrangement or add table", Repeatable: true},
+			{Code: "y", Label: "Table sequence number for internal subarrangement or add table", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "086", Label: "GOVERNMENT DOCUMENT CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Number source", Values: []IndicatorValue{
+				{Code: " ", Label: "Source specified in subfield $2"},
+				{Code: "0", Label: "Superintendent of Documents Classification System"},
+				{Code: "1", Label: "Government of Canada Publications: Outline of Classification"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number"},
+			{Code: "z", Label: "Canceled/invalid classification number", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Number source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "088", Label: "REPORT NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Report number"},
+			{Code: "z", Label: "Canceled/invalid report number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "09X", Label: "LOCAL CALL NUMBERS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "100", Label: "MAIN ENTRY--PERSONAL NAME", Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "j", Label: "Attribution qualifier", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "110", Label: "MAIN ENTRY--CORPORATE NAME", Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "111", Label: "MAIN ENTRY--MEETING NAME", Indicators: [2]IndicatorDef{
+			{Label: "Type of meeting name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "j", Label: "Relator term", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "130", Label: "MAIN ENTRY--UNIFORM TITLE", Indicators: [2]IndicatorDef{
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "210", Label: "ABBREVIATED TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Title added entry", Values: []IndicatorValue{
+				{Code: "0", Label: "No added entry"},
+				{Code: "1", Label: "Added entry"},
+			}},
+			{Label: "Type", Values: []IndicatorValue{
+				{Code: " ", Label: "Abbreviated key title"},
+				{Code: "0", Label: "Other abbreviated title"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Abbreviated title"},
+			{Code: "b", Label: "Qualifying information"},
+			{Code: "2", Label: "Source", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "211", Label: "ACRONYM OR SHORTENED TITLE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Title added entry", Values: []IndicatorValue{
+				{Code: "0", Label: "No added entry"},
+				{Code: "1", Label: "Added entry"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Acronym or shortened title"},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "212", Label: "VARIANT ACCESS TITLE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Title added entry", Values: []IndicatorValue{
+				{Code: "0", Label: "No added entry"},
+				{Code: "1", Label: "Added entry"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Variant access title"},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "214", Label: "AUGMENTED TITLE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Title added entry", Values: []IndicatorValue{
+				{Code: "0", Label: "No added entry"},
+				{Code: "1", Label: "Added entry"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Augmented title"},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "222", Label: "KEY TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Key title"},
+			{Code: "b", Label: "Qualifying information"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "240", Label: "UNIFORM TITLE", Indicators: [2]IndicatorDef{
+			{Label: "Uniform title printed or displayed", Values: []IndicatorValue{
+				{Code: "0", Label: "Not printed or displayed"},
+				{Code: "1", Label: "Printed or displayed"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "241", Label: "ROMANIZED TITLE", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Title added entry", Values: []IndicatorValue{
+				{Code: "0", Label: "No added entry"},
+				{Code: "1", Label: "Added entry"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Romanized title"},
+			{Code: "h", Label: "Medium"},
+		}},
+		{Tag: "242", Label: "TRANSLATION OF TITLE BY CATALOGING AGENCY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Title added entry", Values: []IndicatorValue{
+				{Code: "0", Label: "No added entry"},
+				{Code: "1", Label: "Added entry"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Title"},
+			{Code: "b", Label: "Remainder of title"},
+			{Code: "c", Label: "Statement of responsibility, etc."},
+			{Code: "d", Label: "Designation of section (BK AM MP MU VM SE)", Repeatable: true, Obsolete: true},
+			{Code: "e", Label: "Name of part/section (BK AM MP MU VM SE)", Repeatable: true, Obsolete: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "y", Label: "Language code of translated title"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "243", Label: "COLLECTIVE UNIFORM TITLE", Indicators: [2]IndicatorDef{
+			{Label: "Uniform title printed or displayed", Values: []IndicatorValue{
+				{Code: "0", Label: "Not printed or displayed"},
+				{Code: "1", Label: "Printed or displayed"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "245", Label: "TITLE STATEMENT", Indicators: [2]IndicatorDef{
+			{Label: "Title added entry", Values: []IndicatorValue{
+				{Code: "0", Label: "No added entry"},
+				{Code: "1", Label: "Added entry"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Title"},
+			{Code: "b", Label: "Remainder of title"},
+			{Code: "c", Label: "Statement of responsibility, etc."},
+			{Code: "d", Label: "Designation of section/part/series (SE)", Repeatable: true, Obsolete: true},
+			{Code: "e", Label: "Name of part/section/series (SE)", Repeatable: true, Obsolete: true},
+			{Code: "f", Label: "Inclusive dates"},
+			{Code: "g", Label: "Bulk dates"},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "s", Label: "Version"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "246", Label: "VARYING FORM OF TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note/added entry controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Note, no added entry"},
+				{Code: "1", Label: "Note, added entry"},
+				{Code: "2", Label: "No note, no added entry"},
+				{Code: "3", Label: "No note, added entry"},
+			}},
+			{Label: "Type of title", Values: []IndicatorValue{
+				{Code: " ", Label: "No type specified"},
+				{Code: "0", Label: "Portion of title"},
+				{Code: "1", Label: "Parallel title"},
+				{Code: "2", Label: "Distinctive title"},
+				{Code: "3", Label: "Other title"},
+				{Code: "4", Label: "Cover title"},
+				{Code: "5", Label: "Added title page title"},
+				{Code: "6", Label: "Caption title"},
+				{Code: "7", Label: "Running title"},
+				{Code: "8", Label: "Spine title"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Title proper/short title"},
+			{Code: "b", Label: "Remainder of title"},
+			{Code: "d", Label: "Designation of section/part/series (SE)", Repeatable: true, Obsolete: true},
+			{Code: "e", Label: "Name of part/section/series (SE)", Repeatable: true, Obsolete: true},
+			{Code: "f", Label: "Date or sequential designation"},
+			{Code: "g", Label: "Miscellaneous information"},
+			{Code: "h", Label: "Medium"},
+			{Code: "i", Label: "Display text"},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "247", Label: "FORMER TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Title added entry", Values: []IndicatorValue{
+				{Code: "0", Label: "No added entry"},
+				{Code: "1", Label: "Added entry"},
+			}},
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Title"},
+			{Code: "b", Label: "Remainder of title"},
+			{Code: "f", Label: "Date or sequential designation"},
+			{Code: "g", Label: "Miscellaneous information"},
+			{Code: "h", Label: "Medium"},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "250", Label: "EDITION STATEMENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Edition statement"},
+			{Code: "b", Label: "Remainder of edition statement"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "251", Label: "VERSION INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Version", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "254", Label: "MUSICAL PRESENTATION STATEMENT", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Musical presentation statement"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "255", Label: "CARTOGRAPHIC MATHEMATICAL DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Statement of scale"},
+			{Code: "b", Label: "Statement of projection"},
+			{Code: "c", Label: "Statement of coordinates"},
+			{Code: "d", Label: "Statement of zone"},
+			{Code: "e", Label: "Statement of equinox"},
+			{Code: "f", Label: "Outer G-ring coordinate pairs"},
+			{Code: "g", Label: "Exclusion G-ring coordinate pairs"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "256", Label: "COMPUTER FILE CHARACTERISTICS", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Computer file characteristics"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "257", Label: "COUNTRY OF PRODUCING ENTITY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Country of producing entity", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "258", Label: "PHILATELIC ISSUE DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Issuing jurisdiction"},
+			{Code: "b", Label: "Denomination"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "260", Label: "PUBLICATION, DISTRIBUTION, ETC. (IMPRINT)", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Sequence of publishing statements", Values: []IndicatorValue{
+				{Code: " ", Label: "Not applicable/No information provided/Earliest available publisher"},
+				{Code: "2", Label: "Intervening publisher"},
+				{Code: "3", Label: "Current/latest publisher"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Place of publication, distribution, etc.", Repeatable: true},
+			{Code: "b", Label: "Name of publisher, distributor, etc.", Repeatable: true},
+			{Code: "c", Label: "Date of publication, distribution, etc.", Repeatable: true},
+			{Code: "d", Label: "Plate or publisher's number for music (Pre-AACR 2)", Obsolete: true},
+			{Code: "e", Label: "Place of manufacture", Repeatable: true},
+			{Code: "f", Label: "Manufacturer", Repeatable: true},
+			{Code: "g", Label: "Date of manufacture", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "261", Label: "IMPRINT STATEMENT FOR FILMS (Pre-AACR 1 Revised)", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Producing company", Repeatable: true},
+			{Code: "b", Label: "Releasing company (primary distributor)", Repeatable: true},
+			{Code: "d", Label: "Date of production, release, etc.", Repeatable: true},
+			{Code: "e", Label: "Contractual producer", Repeatable: true},
+			{Code: "f", Label: "Place of production, release, etc.", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "262", Label: "IMPRINT STATEMENT FOR SOUND RECORDINGS (Pre-AACR 2)", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Place of production, release, etc."},
+			{Code: "b", Label: "Publisher or trade name"},
+			{Code: "c", Label: "Date of production, release, etc."},
+			{Code: "k", Label: "Serial identification"},
+			{Code: "l", Label: "Matrix and/or take number"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "263", Label: "PROJECTED PUBLICATION DATE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Projected publication date"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "264", Label: "PRODUCTION, PUBLICATION, DISTRIBUTION, MANUFACTURE, AND COPYRIGHT NOTICE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Sequence of statements", Values: []IndicatorValue{
+				{Code: " ", Label: "Not applicable/No information provided/Earliest"},
+				{Code: "2", Label: "Intervening"},
+				{Code: "3", Label: "Current/Latest"},
+			}},
+			{Label: "Function of entity", Values: []IndicatorValue{
+				{Code: "0", Label: "Production"},
+				{Code: "1", Label: "Publication"},
+				{Code: "2", Label: "Distribution"},
+				{Code: "3", Label: "Manufacture"},
+				{Code: "4", Label: "Copyright notice date"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Place of production, publication, distribution, manufacture", Repeatable: true},
+			{Code: "b", Label: "Name of producer, publisher, distributor, manufacturer", Repeatable: true},
+			{Code: "c", Label: "Date of production, publication, distribution, manufacture, or copyright notice", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "265", Label: "SOURCE FOR ACQUISITION/SUBSCRIPTION ADDRESS", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Source for acquisition/subscription address", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "270", Label: "ADDRESS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Level", Values: []IndicatorValue{
+				{Code: " ", Label: "No level specified"},
+				{Code: "1", Label: "Primary"},
+				{Code: "2", Label: "Secondary"},
+			}},
+			{Label: "Type of address", Values: []IndicatorValue{
+				{Code: " ", Label: "No type specified"},
+				{Code: "0", Label: "Mailing"},
+				{Code: "7", Label: "Type specified in subfield $i"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Address", Repeatable: true},
+			{Code: "b", Label: "City"},
+			{Code: "c", Label: "State or province"},
+			{Code: "d", Label: "Country"},
+			{Code: "e", Label: "Postal code"},
+			{Code: "f", Label: "Terms preceding attention name"},
+			{Code: "g", Label: "Attention name"},
+			{Code: "h", Label: "Attention position"},
+			{Code: "i", Label: "Type of address"},
+			{Code: "j", Label: "Specialized telephone number", Repeatable: true},
+			{Code: "k", Label: "Telephone number", Repeatable: true},
+			{Code: "l", Label: "Fax number", Repeatable: true},
+			{Code: "m", Label: "Electronic mail address", Repeatable: true},
+			{Code: "n", Label: "TDD or TTY number", Repeatable: true},
+			{Code: "p", Label: "Contact person", Repeatable: true},
+			{Code: "q", Label: "Title of contact person", Repeatable: true},
+			{Code: "r", Label: "Hours", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "300", Label: "PHYSICAL DESCRIPTION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Extent", Repeatable: true},
+			{Code: "b", Label: "Other physical details"},
+			{Code: "c", Label: "Dimensions", Repeatable: true},
+			{Code: "e", Label: "Accompanying material"},
+			{Code: "f", Label: "Type of unit", Repeatable: true},
+			{Code: "g", Label: "Size of unit", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "301", Label: "PHYSICAL DESCRIPTION FOR FILMS (Pre-AACR 2)", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Extent of item"},
+			{Code: "b", Label: "Sound characteristics"},
+			{Code: "c", Label: "Color characteristics"},
+			{Code: "d", Label: "Dimensions"},
+			{Code: "e", Label: "Sound characteristics"},
+			{Code: "f", Label: "Speed"},
+		}},
+		{Tag: "302", Label: "PAGE OR ITEM COUNT", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Page count"},
+		}},
+		{Tag: "303", Label: "UNIT COUNT", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Unit count"},
+		}},
+		{Tag: "304", Label: "LINEAR FOOTAGE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Linear footage"},
+		}},
+		{Tag: "305", Label: "PHYSICAL DESCRIPTION FOR SOUND RECORDINGS (Pre-AACR 2)", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Extent"},
+			{Code: "b", Label: "Other physical details"},
+			{Code: "c", Label: "Dimensions", Repeatable: true},
+			{Code: "d", Label: "Microgroove or standard"},
+			{Code: "e", Label: "Stereophonic, monaural"},
+			{Code: "f", Label: "Number of tracks"},
+			{Code: "m", Label: "Serial identification"},
+			{Code: "n", Label: "Matrix and/or take number"},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "306", Label: "PLAYING TIME", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Playing time", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "307", Label: "HOURS, ETC.", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Hours"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Hours"},
+			{Code: "b", Label: "Additional information"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "308", Label: "PHYSICAL DESCRIPTION FOR FILMS (ARCHIVAL)", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Number of reels"},
+			{Code: "b", Label: "Footage"},
+			{Code: "c", Label: "Sound characteristics"},
+			{Code: "d", Label: "Color characteristics"},
+			{Code: "e", Label: "Width"},
+			{Code: "f", Label: "Presentation format"},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "310", Label: "CURRENT PUBLICATION FREQUENCY", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Current publication frequency"},
+			{Code: "b", Label: "Date of current publication frequency"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "315", Label: "FREQUENCY", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Frequency", Repeatable: true},
+			{Code: "b", Label: "Dates of frequency", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "321", Label: "FORMER PUBLICATION FREQUENCY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Former publication frequency"},
+			{Code: "b", Label: "Dates of former publication frequency"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "334", Label: "MODE OF ISSUANCE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Mode of issuance term", Repeatable: true},
+			{Code: "b", Label: "Mode of issuance code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "335", Label: "EXTENSION PLAN", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Extension plan term"},
+			{Code: "b", Label: "Extension plan code"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "336", Label: "CONTENT TYPE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Content type term", Repeatable: true},
+			{Code: "b", Label: "Content type code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "337", Label: "MEDIA TYPE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Media type term", Repeatable: true},
+			{Code: "b", Label: "Media type code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "338", Label: "CARRIER TYPE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Carrier type term", Repeatable: true},
+			{Code: "b", Label: "Carrier type code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "340", Label: "PHYSICAL MEDIUM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Material base and configuration", Repeatable: true},
+			{Code: "b", Label: "Dimensions", Repeatable: true},
+			{Code: "c", Label: "Materials applied to surface", Repeatable: true},
+			{Code: "d", Label: "Information recording technique", Repeatable: true},
+			{Code: "e", Label: "Support", Repeatable: true},
+			{Code: "f", Label: "Reduction ratio value", Repeatable: true},
+			{Code: "g", Label: "Color content", Repeatable: true},
+			{Code: "h", Label: "Location within medium", Repeatable: true},
+			{Code: "i", Label: "Technical specifications of medium", Repeatable: true},
+			{Code: "j", Label: "Generation", Repeatable: true},
+			{Code: "k", Label: "Layout", Repeatable: true},
+			{Code: "l", Label: "Binding", Repeatable: true},
+			{Code: "m", Label: "Book format", Repeatable: true},
+			{Code: "n", Label: "Font size", Repeatable: true},
+			{Code: "o", Label: "Polarity", Repeatable: true},
+			{Code: "p", Label: "Illustrative content", Repeatable: true},
+			{Code: "q", Label: "Reduction ratio designator", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "341", Label: "ACCESSIBILITY CONTENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Application", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Adaptive features to access primary content"},
+				{Code: "1", Label: "Adaptive features to access secondary content"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Content access mode"},
+			{Code: "b", Label: "Textual assistive features", Repeatable: true},
+			{Code: "c", Label: "Visual assistive features", Repeatable: true},
+			{Code: "d", Label: "Auditory assistive features", Repeatable: true},
+			{Code: "e", Label: "Tactile assistive features", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "342", Label: "GEOSPATIAL REFERENCE DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Geospatial reference dimension", Values: []IndicatorValue{
+				{Code: "0", Label: "Horizontal coordinate system"},
+				{Code: "1", Label: "Vertical coordinate system"},
+			}},
+			{Label: "Geospatial reference method", Values: []IndicatorValue{
+				{Code: "0", Label: "Geographic"},
+				{Code: "1", Label: "Map projection"},
+				{Code: "2", Label: "Grid coordinate system"},
+				{Code: "3", Label: "Local planar"},
+				{Code: "4", Label: "Local"},
+				{Code: "5", Label: "Geodetic model"},
+				{Code: "6", Label: "Altitude"},
+				{Code: "7", Label: "Method specified in $2"},
+				{Code: "8", Label: "Depth"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Name"},
+			{Code: "b", Label: "Coordinate units or distance units"},
+			{Code: "c", Label: "Latitude resolution"},
+			{Code: "d", Label: "Longitude resolution"},
+			{Code: "e", Label: "Standard parallel or oblique line latitude", Repeatable: true},
+			{Code: "f", Label: "Oblique line longitude", Repeatable: true},
+			{Code: "g", Label: "Longitude of central meridian or projection center"},
+			{Code: "h", Label: "Latitude of projection center or projection origin"},
+			{Code: "i", Label: "False easting"},
+			{Code: "j", Label: "False northing"},
+			{Code: "k", Label: "Scale factor"},
+			{Code: "l", Label: "Height of perspective point above surface"},
+			{Code: "m", Label: "Azimuthal angle"},
+			{Code: "n", Label: "Azimuth measure point longitude or straight vertical longitude from pole"},
+			{Code: "o", Label: "Landsat number and path number"},
+			{Code: "p", Label: "Zone identifier"},
+			{Code: "q", Label: "Ellipsoid name"},
+			{Code: "r", Label: "Semi-major axis"},
+			{Code: "s", Label: "Denominator of flattening ratio"},
+			{Code: "t", Label: "Vertical resolution"},
+			{Code: "u", Label: "Vertical encoding method"},
+			{Code: "v", Label: "Local planar, local, or other projection or grid description"},
+			{Code: "w", Label: "Local planar or local georeference information"},
+			{Code: "2", Label: "Reference method used"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "343", Label: "PLANAR COORDINATE DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Planar coordinate encoding method"},
+			{Code: "b", Label: "Planar distance units"},
+			{Code: "c", Label: "Abscissa resolution"},
+			{Code: "d", Label: "Ordinate resolution"},
+			{Code: "e", Label: "Distance resolution"},
+			{Code: "f", Label: "Bearing resolution"},
+			{Code: "g", Label: "Bearing units"},
+			{Code: "h", Label: "Bearing reference direction"},
+			{Code: "i", Label: "Bearing reference meridian"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "344", Label: "SOUND CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Type of recording", Repeatable: true},
+			{Code: "b", Label: "Recording medium", Repeatable: true},
+			{Code: "c", Label: "Playing speed", Repeatable: true},
+			{Code: "d", Label: "Groove characteristic", Repeatable: true},
+			{Code: "e", Label: "Track configuration", Repeatable: true},
+			{Code: "f", Label: "Tape configuration", Repeatable: true},
+			{Code: "g", Label: "Configuration of playback channels", Repeatable: true},
+			{Code: "h", Label: "Special playback characteristics", Repeatable: true},
+			{Code: "i", Label: "Sound content", Repeatable: true},
+			{Code: "j", Label: "Original capture and storage technique", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "345", Label: "MOVING IMAGE CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Presentation format", Repeatable: true},
+			{Code: "b", Label: "Projection speed", Repeatable: true},
+			{Code: "c", Label: "Aspect ratio value", Repeatable: true},
+			{Code: "d", Label: "Aspect ratio designator", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "346", Label: "VIDEO CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Video format", Repeatable: true},
+			{Code: "b", Label: "Broadcast standard", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "347", Label: "DIGITAL FILE CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "File type", Repeatable: true},
+			{Code: "b", Label: "Encoding format", Repeatable: true},
+			{Code: "c", Label: "File size", Repeatable: true},
+			{Code: "d", Label: "Resolution", Repeatable: true},
+			{Code: "e", Label: "Regional encoding", Repeatable: true},
+			{Code: "f", Label: "Encoded bitrate", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "348", Label: "NOTATED MUSIC CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Format of notated music term", Repeatable: true},
+			{Code: "b", Label: "Format of notated music code", Repeatable: true},
+			{Code: "c", Label: "Form of musical notation term", Repeatable: true},
+			{Code: "d", Label: "Form of musical notation code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "350", Label: "PRICE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Price", Repeatable: true},
+			{Code: "b", Label: "Form of issue", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "351", Label: "ORGANIZATION AND ARRANGEMENT OF MATERIALS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Organization", Repeatable: true},
+			{Code: "b", Label: "Arrangement", Repeatable: true},
+			{Code: "c", Label: "Hierarchical level"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "352", Label: "DIGITAL GRAPHIC REPRESENTATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Direct reference method"},
+			{Code: "b", Label: "Object type", Repeatable: true},
+			{Code: "c", Label: "Object count", Repeatable: true},
+			{Code: "d", Label: "Row count"},
+			{Code: "e", Label: "Column count"},
+			{Code: "f", Label: "Vertical count"},
+			{Code: "g", Label: "VPF topology level"},
+			{Code: "i", Label: "Indirect reference description"},
+			{Code: "q", Label: "Format of the digital image", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "355", Label: "SECURITY CLASSIFICATION CONTROL", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Controlled element", Values: []IndicatorValue{
+				{Code: "0", Label: "Document"},
+				{Code: "1", Label: "Title"},
+				{Code: "2", Label: "Abstract"},
+				{Code: "3", Label: "Contents note"},
+				{Code: "4", Label: "Author"},
+				{Code: "5", Label: "Record"},
+				{Code: "8", Label: "None of the above"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Security classification"},
+			{Code: "b", Label: "Handling instructions", Repeatable: true},
+			{Code: "c", Label: "External dissemination information", Repeatable: true},
+			{Code: "d", Label: "Downgrading or declassification event"},
+			{Code: "e", Label: "Classification system"},
+			{Code: "f", Label: "Country of origin code"},
+			{Code: "g", Label: "Downgrading date"},
+			{Code: "h", Label: "Declassification date"},
+			{Code: "j", Label: "Authorization", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "357", Label: "ORIGINATOR DISSEMINATION CONTROL", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Originator control term"},
+			{Code: "b", Label: "Originating agency", Repeatable: true},
+			{Code: "c", Label: "Authorized recipients of material", Repeatable: true},
+			{Code: "g", Label: "Other restrictions", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "359", Label: "RENTAL PRICE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Rental price"},
+		}},
+		{Tag: "361", Label: "STRUCTURED OWNERSHIP AND CUSTODIAL HISTORY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Name"},
+			{Code: "f", Label: "Identifier", Repeatable: true},
+			{Code: "k", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "l", Label: "Date", Repeatable: true},
+			{Code: "o", Label: "Type of ownership and custodial history event"},
+			{Code: "s", Label: "Linked authority record URI", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "362", Label: "DATES OF PUBLICATION AND/OR SEQUENTIAL DESIGNATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Format of date", Values: []IndicatorValue{
+				{Code: "0", Label: "Formatted style"},
+				{Code: "1", Label: "Unformatted note"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Dates of publication and/or sequential designation"},
+			{Code: "z", Label: "Source of information"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "363", Label: "NORMALIZED DATE AND SEQUENTIAL DESIGNATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Start/End designator", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Starting information"},
+				{Code: "1", Label: "Ending information"},
+			}},
+			{Label: "State of issuance", Values: []IndicatorValue{
+				{Code: " ", Label: "Not specified"},
+				{Code: "0", Label: "Closed"},
+				{Code: "1", Label: "Open"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "First level of enumeration"},
+			{Code: "b", Label: "Second level of enumeration"},
+			{Code: "c", Label: "Third level of enumeration"},
+			{Code: "d", Label: "Fourth level of enumeration"},
+			{Code: "e", Label: "Fifth level of enumeration"},
+			{Code: "f", Label: "Sixth level of enumeration"},
+			{Code: "g", Label: "Alternative numbering scheme, first level of enumeration"},
+			{Code: "h", Label: "Alternative numbering scheme, second level of enumeration"},
+			{Code: "i", Label: "First level of chronology"},
+			{Code: "j", Label: "Second level of chronology"},
+			{Code: "k", Label: "Third level of chronology"},
+			{Code: "l", Label: "Fourth level of chronology"},
+			{Code: "m", Label: "Alternative numbering scheme, chronology"},
+			{Code: "u", Label: "First level textual designation"},
+			{Code: "v", Label: "First level of chronology, issuance"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "365", Label: "TRADE PRICE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Price type code"},
+			{Code: "b", Label: "Price amount"},
+			{Code: "c", Label: "Currency code"},
+			{Code: "d", Label: "Unit of pricing"},
+			{Code: "e", Label: "Price note"},
+			{Code: "f", Label: "Price effective from"},
+			{Code: "g", Label: "Price effective until"},
+			{Code: "h", Label: "Tax rate 1"},
+			{Code: "i", Label: "Tax rate 2"},
+			{Code: "j", Label: "ISO country code"},
+			{Code: "k", Label: "MARC country code"},
+			{Code: "m", Label: "Identification of pricing entity"},
+			{Code: "2", Label: "Source of price type code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "366", Label: "TRADE AVAILABILITY INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Publishers' compressed title identification"},
+			{Code: "b", Label: "Detailed date of publication"},
+			{Code: "c", Label: "Availability status code"},
+			{Code: "d", Label: "Expected next availability date"},
+			{Code: "e", Label: "Note"},
+			{Code: "f", Label: "Publisher's discount category"},
+			{Code: "g", Label: "Date made out of print"},
+			{Code: "j", Label: "ISO country code"},
+			{Code: "k", Label: "MARC country code"},
+			{Code: "m", Label: "Identification of agency"},
+			{Code: "2", Label: "Source of availability status code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "370", Label: "ASSOCIATED PLACE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "c", Label: "Associated country", Repeatable: true},
+			{Code: "f", Label: "Other associated place", Repeatable: true},
+			{Code: "g", Label: "Place of origin of work or expression", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "377", Label: "ASSOCIATED LANGUAGE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of code", Values: []IndicatorValue{
+				{Code: " ", Label: "MARC language code"},
+				{Code: "7", Label: "Source specified in $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Language code", Repeatable: true},
+			{Code: "l", Label: "Language term", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "380", Label: "FORM OF WORK", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Form of work", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "381", Label: "OTHER DISTINGUISHING CHARACTERISTICS OF WORK OR EXPRESSION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Other distinguishing characteristic", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "382", Label: "MEDIUM OF PERFORMANCE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Medium of performance"},
+				{Code: "1", Label: "Partial medium of performance"},
+				{Code: "2", Label: "Medium of performance of musical content of representative expression"},
+				{Code: "3", Label: "Partial medium of performance of musical content of representative expression"},
+			}},
+			{Label: "Access control", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Not intended for access"},
+				{Code: "1", Label: "Intended for access"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Medium of performance", Repeatable: true},
+			{Code: "b", Label: "Soloist", Repeatable: true},
+			{Code: "d", Label: "Doubling instrument", Repeatable: true},
+			{Code: "e", Label: "Number of ensembles of the same type", Repeatable: true},
+			{Code: "n", Label: "Number of performers of the same medium", Repeatable: true},
+			{Code: "p", Label: "Alternative medium of performance", Repeatable: true},
+			{Code: "r", Label: "Total number of individuals performing alongside ensembles"},
+			{Code: "s", Label: "Total number of performers"},
+			{Code: "t", Label: "Total number of ensembles"},
+			{Code: "v", Label: "Note", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "383", Label: "NUMERIC DESIGNATION OF MUSICAL WORK", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Serial number", Repeatable: true},
+			{Code: "b", Label: "Opus number", Repeatable: true},
+			{Code: "c", Label: "Thematic index number", Repeatable: true},
+			{Code: "d", Label: "Thematic index code"},
+			{Code: "e", Label: "Publisher associated with opus number"},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "384", Label: "KEY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Key type", Values: []IndicatorValue{
+				{Code: " ", Label: "Relationship to original unknown"},
+				{Code: "0", Label: "Original key"},
+				{Code: "1", Label: "Transposed key"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Key"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "385", Label: "AUDIENCE CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Audience term", Repeatable: true},
+			{Code: "b", Label: "Audience code", Repeatable: true},
+			{Code: "m", Label: "Demographic group term"},
+			{Code: "n", Label: "Demographic group code"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "386", Label: "CREATOR/CONTRIBUTOR CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Creator/contributor term", Repeatable: true},
+			{Code: "b", Label: "Creator/contributor code", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "m", Label: "Demographic group term"},
+			{Code: "n", Label: "Demographic group code"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "387", Label: "REPRESENTATIVE EXPRESSION CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Aspect ratio of representative expression", Repeatable: true},
+			{Code: "b", Label: "Color content of representative expression", Repeatable: true},
+			{Code: "c", Label: "Content type of representative expression", Repeatable: true},
+			{Code: "d", Label: "Date of capture of representative expression", Repeatable: true},
+			{Code: "e", Label: "Date of representative expression", Repeatable: true},
+			{Code: "f", Label: "Duration of representative expression", Repeatable: true},
+			{Code: "g", Label: "Intended audience of representative expression", Repeatable: true},
+			{Code: "h", Label: "Language of representative expression", Repeatable: true},
+			{Code: "i", Label: "Script of representative expression", Repeatable: true},
+			{Code: "j", Label: "Scale of representative expression", Repeatable: true},
+			{Code: "k", Label: "Sound content of representative expression", Repeatable: true},
+			{Code: "l", Label: "Projection of cartographic content of representative expression", Repeatable: true},
+			{Code: "m", Label: "Other details of representative expression", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "388", Label: "TIME PERIOD OF CREATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of time period", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "1", Label: "Creation of work"},
+				{Code: "2", Label: "Creation of aggregate work"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Time period of creation term", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "400", Label: "SERIES STATEMENT/ADDED ENTRY--PERSONAL NAME", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Pronoun represents main entry", Values: []IndicatorValue{
+				{Code: "0", Label: "Main entry not represented by pronoun"},
+				{Code: "1", Label: "Main entry represented by pronoun"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Volume number/sequential designation"},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "410", Label: "SERIES STATEMENT/ADDED ENTRY--CORPORATE NAME", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Pronoun represents main entry", Values: []IndicatorValue{
+				{Code: "0", Label: "Main entry not represented by pronoun"},
+				{Code: "1", Label: "Main entry represented by pronoun"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Volume number/sequential designation"},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "411", Label: "SERIES STATEMENT/ADDED ENTRY--MEETING NAME", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of meeting name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Pronoun represents main entry", Values: []IndicatorValue{
+				{Code: "0", Label: "Main entry not represented by pronoun"},
+				{Code: "1", Label: "Main entry represented by pronoun"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Volume number/sequential designation"},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "440", Label: "SERIES STATEMENT/ADDED ENTRY--TITLE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Title"},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "v", Label: "Volume number/sequential designation"},
+			{Code: "w", Label: "Bibliographic record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "490", Label: "SERIES STATEMENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Series tracing policy", Values: []IndicatorValue{
+				{Code: "0", Label: "Series not traced"},
+				{Code: "1", Label: "Series traced"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Series statement", Repeatable: true},
+			{Code: "l", Label: "Library of Congress call number"},
+			{Code: "v", Label: "Volume/sequential designation", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number", Repeatable: true},
+			{Code: "y", Label: "Incorrect ISSN", Repeatable: true},
+			{Code: "z", Label: "Canceled ISSN", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "500", Label: "GENERAL NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "General note"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "501", Label: "WITH NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "With note"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "502", Label: "DISSERTATION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Dissertation note"},
+			{Code: "b", Label: "Degree type"},
+			{Code: "c", Label: "Name of granting institution"},
+			{Code: "d", Label: "Year degree granted"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "o", Label: "Dissertation identifier", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "503", Label: "BIBLIOGRAPHIC HISTORY NOTE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Bibliographic history note"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "504", Label: "BIBLIOGRAPHY, ETC. NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Bibliography, etc. note"},
+			{Code: "b", Label: "Number of references"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "505", Label: "FORMATTED CONTENTS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Contents"},
+				{Code: "1", Label: "Incomplete contents"},
+				{Code: "2", Label: "Partial contents"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Level of content designation", Values: []IndicatorValue{
+				{Code: " ", Label: "Basic"},
+				{Code: "0", Label: "Enhanced"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Formatted contents note"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "r", Label: "Statement of responsibility", Repeatable: true},
+			{Code: "t", Label: "Title", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "506", Label: "RESTRICTIONS ON ACCESS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Restriction", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No restrictions"},
+				{Code: "1", Label: "Restrictions apply"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Terms governing access"},
+			{Code: "b", Label: "Jurisdiction", Repeatable: true},
+			{Code: "c", Label: "Physical access provisions", Repeatable: true},
+			{Code: "d", Label: "Authorized users", Repeatable: true},
+			{Code: "e", Label: "Authorization", Repeatable: true},
+			{Code: "f", Label: "Standardized terminology for access restriction", Repeatable: true},
+			{Code: "g", Label: "Availability date", Repeatable: true},
+			{Code: "q", Label: "Supplying agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "507", Label: "SCALE NOTE FOR VISUAL MATERIALS", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Representative fraction of scale note"},
+			{Code: "b", Label: "Remainder of scale note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "508", Label: "CREATION/PRODUCTION CREDITS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Creation/production credits note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "510", Label: "CITATION/REFERENCES NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Coverage/location in source", Values: []IndicatorValue{
+				{Code: "0", Label: "Coverage unknown"},
+				{Code: "1", Label: "Coverage complete"},
+				{Code: "2", Label: "Coverage is selective"},
+				{Code: "3", Label: "Location in source not given"},
+				{Code: "4", Label: "Location in source given"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Name of source"},
+			{Code: "b", Label: "Coverage of source"},
+			{Code: "c", Label: "Location within source"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "511", Label: "PARTICIPANT OR PERFORMER NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: "0", Label: "No display constant generated"},
+				{Code: "1", Label: "Cast"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Participant or performer note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "512", Label: "EARLIER OR LATER VOLUMES SEPARATELY CATALOGED NOTE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Earlier or later volumes separately cataloged note"},
+			{Code: "6", Label: "Linkage"},
+		}},
+		{Tag: "513", Label: "TYPE OF REPORT AND PERIOD COVERED NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Type of report"},
+			{Code: "b", Label: "Period covered"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "514", Label: "DATA QUALITY NOTE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Attribute accuracy report"},
+			{Code: "b", Label: "Attribute accuracy value", Repeatable: true},
+			{Code: "c", Label: "Attribute accuracy explanation", Repeatable: true},
+			{Code: "d", Label: "Logical consistency report"},
+			{Code: "e", Label: "Completeness report"},
+			{Code: "f", Label: "Horizontal position accuracy report"},
+			{Code: "g", Label: "Horizontal position accuracy value", Repeatable: true},
+			{Code: "h", Label: "Horizontal position accuracy explanation", Repeatable: true},
+			{Code: "i", Label: "Vertical positional accuracy report"},
+			{Code: "j", Label: "Vertical positional accuracy value", Repeatable: true},
+			{Code: "k", Label: "Vertical positional accuracy explanation", Repeatable: true},
+			{Code: "m", Label: "Cloud cover"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "z", Label: "Display note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "515", Label: "NUMBERING PECULIARITIES NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Numbering peculiarities note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "516", Label: "TYPE OF COMPUTER FILE OR DATA NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Type of file"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Type of computer file or data note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "517", Label: "CATEGORIES OF FILMS NOTE (ARCHIVAL)", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Fiction specification", Values: []IndicatorValue{
+				{Code: "0", Label: "Not fiction"},
+				{Code: "1", Label: "Fiction"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Different formats"},
+			{Code: "b", Label: "Categories", Repeatable: true},
+			{Code: "c", Label: "Production categories", Repeatable: true},
+		}},
+		{Tag: "518", Label: "DATE/TIME AND PLACE OF AN EVENT NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Date/time and place of an event note"},
+			{Code: "d", Label: "Date of event", Repeatable: true},
+			{Code: "o", Label: "Other event information", Repeatable: true},
+			{Code: "p", Label: "Place of event", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "520", Label: "SUMMARY, ETC.", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Summary"},
+				{Code: "0", Label: "Subject"},
+				{Code: "1", Label: "Review"},
+				{Code: "2", Label: "Scope and content"},
+				{Code: "3", Label: "Abstract"},
+				{Code: "4", Label: "Content advice"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Summary, etc."},
+			{Code: "b", Label: "Expansion of summary note"},
+			{Code: "c", Label: "Assigning source"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "521", Label: "TARGET AUDIENCE NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Audience"},
+				{Code: "0", Label: "Reading grade level"},
+				{Code: "1", Label: "Interest age level"},
+				{Code: "2", Label: "Interest grade level"},
+				{Code: "3", Label: "Special audience characteristics"},
+				{Code: "4", Label: "Motivation/interest level"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Target audience note", Repeatable: true},
+			{Code: "b", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "522", Label: "GEOGRAPHIC COVERAGE NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Geographic coverage"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic coverage note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "523", Label: "TIME PERIOD OF CONTENT NOTE", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Time period of content note"},
+			{Code: "b", Label: "Dates of data collection note"},
+		}},
+		{Tag: "524", Label: "PREFERRED CITATION OF DESCRIBED MATERIALS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Cite as"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Preferred citation of described materials note"},
+			{Code: "2", Label: "Source of schema used"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "525", Label: "SUPPLEMENT NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Supplement note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "526", Label: "STUDY PROGRAM INFORMATION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Reading program"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Program name"},
+			{Code: "b", Label: "Interest level"},
+			{Code: "c", Label: "Reading level"},
+			{Code: "d", Label: "Title point value"},
+			{Code: "i", Label: "Display text"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "527", Label: "CENSORSHIP NOTE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Censorship note"},
+		}},
+		{Tag: "530", Label: "ADDITIONAL PHYSICAL FORM AVAILABLE NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Additional physical form available note"},
+			{Code: "b", Label: "Availability source"},
+			{Code: "c", Label: "Availability conditions"},
+			{Code: "d", Label: "Order number"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "532", Label: "ACCESSIBILITY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Accessibility technical details"},
+				{Code: "1", Label: "Accessibility features"},
+				{Code: "2", Label: "Accessibility deficiencies"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Summary of accessibility"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "533", Label: "REPRODUCTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Type of reproduction"},
+			{Code: "b", Label: "Place of reproduction", Repeatable: true},
+			{Code: "c", Label: "Agency responsible for reproduction", Repeatable: true},
+			{Code: "d", Label: "Date of reproduction"},
+			{Code: "e", Label: "Physical description of reproduction"},
+			{Code: "f", Label: "Series statement of reproduction", Repeatable: true},
+			{Code: "m", Label: "Dates and/or sequential designation of issues reproduced", Repeatable: true},
+			{Code: "n", Label: "Note about reproduction", Repeatable: true},
+			{Code: "y", Label: "Data provenance", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Fixed-length data elements of reproduction"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "534", Label: "ORIGINAL VERSION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry of original"},
+			{Code: "b", Label: "Edition statement of original"},
+			{Code: "c", Label: "Publication, distribution, etc. of original"},
+			{Code: "e", Label: "Physical description, etc. of original"},
+			{Code: "f", Label: "Series statement of original", Repeatable: true},
+			{Code: "k", Label: "Key title of original", Repeatable: true},
+			{Code: "l", Label: "Location of original"},
+			{Code: "m", Label: "Material specific details"},
+			{Code: "n", Label: "Note about original", Repeatable: true},
+			{Code: "o", Label: "Other resource identifier", Repeatable: true},
+			{Code: "p", Label: "Introductory phrase"},
+			{Code: "t", Label: "Title statement of original"},
+			{Code: "x", Label: "International Standard Serial Number", Repeatable: true},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "535", Label: "LOCATION OF ORIGINALS/DUPLICATES NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Custodial role", Values: []IndicatorValue{
+				{Code: "1", Label: "Holder of originals"},
+				{Code: "2", Label: "Holder of duplicates"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Custodian"},
+			{Code: "b", Label: "Postal address", Repeatable: true},
+			{Code: "c", Label: "Country", Repeatable: true},
+			{Code: "d", Label: "Telecommunications address", Repeatable: true},
+			{Code: "g", Label: "Repository location code"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "536", Label: "FUNDING INFORMATION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Text of note"},
+			{Code: "b", Label: "Contract number", Repeatable: true},
+			{Code: "c", Label: "Grant number", Repeatable: true},
+			{Code: "d", Label: "Undifferentiated number", Repeatable: true},
+			{Code: "e", Label: "Program element number", Repeatable: true},
+			{Code: "f", Label: "Project number", Repeatable: true},
+			{Code: "g", Label: "Task number", Repeatable: true},
+			{Code: "h", Label: "Work unit number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "537", Label: "SOURCE OF DATA NOTE", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Source of data note"},
+		}},
+		{Tag: "538", Label: "SYSTEM DETAILS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "System details note"},
+			{Code: "i", Label: "Display text"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "540", Label: "TERMS GOVERNING USE AND REPRODUCTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Terms governing use and reproduction"},
+			{Code: "b", Label: "Jurisdiction"},
+			{Code: "c", Label: "Authorization"},
+			{Code: "d", Label: "Authorized users"},
+			{Code: "f", Label: "Use and reproduction rights", Repeatable: true},
+			{Code: "g", Label: "Availability date", Repeatable: true},
+			{Code: "q", Label: "Supplying agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "541", Label: "IMMEDIATE SOURCE OF ACQUISITION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Privacy", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Private"},
+				{Code: "1", Label: "Not private"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Source of acquisition"},
+			{Code: "b", Label: "Address"},
+			{Code: "c", Label: "Method of acquisition"},
+			{Code: "d", Label: "Date of acquisition"},
+			{Code: "e", Label: "Accession number"},
+			{Code: "f", Label: "Owner"},
+			{Code: "h", Label: "Purchase price"},
+			{Code: "n", Label: "Extent", Repeatable: true},
+			{Code: "o", Label: "Type of unit", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "542", Label: "INFORMATION RELATING TO COPYRIGHT STATUS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Privacy", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Private"},
+				{Code: "1", Label: "Not private"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal creator"},
+			{Code: "b", Label: "Personal creator death date"},
+			{Code: "c", Label: "Corporate creator"},
+			{Code: "d", Label: "Copyright holder", Repeatable: true},
+			{Code: "e", Label: "Copyright holder contact information", Repeatable: true},
+			{Code: "f", Label: "Copyright statement", Repeatable: true},
+			{Code: "g", Label: "Copyright date"},
+			{Code: "h", Label: "Copyright renewal date", Repeatable: true},
+			{Code: "i", Label: "Publication date"},
+			{Code: "j", Label: "Creation date"},
+			{Code: "k", Label: "Publisher", Repeatable: true},
+			{Code: "l", Label: "Copyright status"},
+			{Code: "m", Label: "Publication status"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Research date"},
+			{Code: "p", Label: "Country of publication or creation", Repeatable: true},
+			{Code: "q", Label: "Supplying agency"},
+			{Code: "r", Label: "Jurisdiction of copyright assessment"},
+			{Code: "s", Label: "Source of information"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "543", Label: "SOLICITATION INFORMATION NOTE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Solicitation information note"},
+		}},
+		{Tag: "544", Label: "LOCATION OF OTHER ARCHIVAL MATERIALS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Relationship", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Associated materials"},
+				{Code: "1", Label: "Related materials"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Custodian", Repeatable: true},
+			{Code: "b", Label: "Address", Repeatable: true},
+			{Code: "c", Label: "Country", Repeatable: true},
+			{Code: "d", Label: "Title", Repeatable: true},
+			{Code: "e", Label: "Provenance", Repeatable: true},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "545", Label: "BIOGRAPHICAL OR HISTORICAL DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of data", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Biographical sketch"},
+				{Code: "1", Label: "Administrative history"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Biographical or historical data"},
+			{Code: "b", Label: "Expansion"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "546", Label: "LANGUAGE NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Language note"},
+			{Code: "b", Label: "Information code or alphabet", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "547", Label: "FORMER TITLE COMPLEXITY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Former title complexity note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "550", Label: "ISSUING BODY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Issuing body note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "552", Label: "ENTITY AND ATTRIBUTE INFORMATION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Entity type label"},
+			{Code: "b", Label: "Entity type definition and source"},
+			{Code: "c", Label: "Attribute label"},
+			{Code: "d", Label: "Attribute definition and source"},
+			{Code: "e", Label: "Enumerated domain value", Repeatable: true},
+			{Code: "f", Label: "Enumerated domain value definition and source", Repeatable: true},
+			{Code: "g", Label: "Range domain minimum and maximum"},
+			{Code: "h", Label: "Codeset name and source"},
+			{Code: "i", Label: "Unrepresentable domain"},
+			{Code: "j", Label: "Attribute units of measurement and resolution"},
+			{Code: "k", Label: "Beginning and ending date of attribute values"},
+			{Code: "l", Label: "Attribute value accuracy"},
+			{Code: "m", Label: "Attribute value accuracy explanation"},
+			{Code: "n", Label: "Attribute measurement frequency"},
+			{Code: "o", Label: "Entity and attribute overview", Repeatable: true},
+			{Code: "p", Label: "Entity and attribute detail citation", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "z", Label: "Display note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "555", Label: "CUMULATIVE INDEX/FINDING AIDS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Indexes"},
+				{Code: "0", Label: "Finding aids"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Cumulative index/finding aids note"},
+			{Code: "b", Label: "Availability source", Repeatable: true},
+			{Code: "c", Label: "Degree of control"},
+			{Code: "d", Label: "Bibliographic reference"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "556", Label: "INFORMATION ABOUT DOCUMENTATION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Documentation"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Information about documentation note"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "561", Label: "OWNERSHIP AND CUSTODIAL HISTORY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Privacy", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Private"},
+				{Code: "1", Label: "Not private"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "History"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "562", Label: "COPY AND VERSION IDENTIFICATION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Identifying markings", Repeatable: true},
+			{Code: "b", Label: "Copy identification", Repeatable: true},
+			{Code: "c", Label: "Version identification", Repeatable: true},
+			{Code: "d", Label: "Presentation format", Repeatable: true},
+			{Code: "e", Label: "Number of copies", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "563", Label: "BINDING INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Binding note"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "565", Label: "CASE FILE CHARACTERISTICS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "File size"},
+				{Code: "0", Label: "Case file characteristics"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Number of cases/variables"},
+			{Code: "b", Label: "Name of variable", Repeatable: true},
+			{Code: "c", Label: "Unit of analysis", Repeatable: true},
+			{Code: "d", Label: "Universe of data", Repeatable: true},
+			{Code: "e", Label: "Filing scheme or code", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "567", Label: "METHODOLOGY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Methodology"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Methodology note"},
+			{Code: "b", Label: "Controlled term", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "570", Label: "EDITOR NOTE", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Editor note"},
+			{Code: "z", Label: "Source of note information"},
+		}},
+		{Tag: "580", Label: "LINKING ENTRY COMPLEXITY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Linking entry complexity note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "581", Label: "PUBLICATIONS ABOUT DESCRIBED MATERIALS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Publications"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Publications about described materials note"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "583", Label: "ACTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Privacy", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Private"},
+				{Code: "1", Label: "Not private"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Action"},
+			{Code: "b", Label: "Action identification", Repeatable: true},
+			{Code: "c", Label: "Time/date of action", Repeatable: true},
+			{Code: "d", Label: "Action interval", Repeatable: true},
+			{Code: "e", Label: "Contingency for action", Repeatable: true},
+			{Code: "f", Label: "Authorization", Repeatable: true},
+			{Code: "h", Label: "Jurisdiction", Repeatable: true},
+			{Code: "i", Label: "Method of action", Repeatable: true},
+			{Code: "j", Label: "Site of action", Repeatable: true},
+			{Code: "k", Label: "Action agent", Repeatable: true},
+			{Code: "l", Label: "Status", Repeatable: true},
+			{Code: "n", Label: "Extent", Repeatable: true},
+			{Code: "o", Label: "Type of unit", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "584", Label: "ACCUMULATION AND FREQUENCY OF USE NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Accumulation", Repeatable: true},
+			{Code: "b", Label: "Frequency of use", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "585", Label: "EXHIBITIONS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Exhibitions note"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "586", Label: "AWARDS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Awards"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Awards note"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "588", Label: "SOURCE OF DESCRIPTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Source of description"},
+				{Code: "1", Label: "Latest issue consulted"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Source of description note"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "59X", Label: "LOCAL NOTES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "600", Label: "SUBJECT ADDED ENTRY--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Attribution qualifier", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "610", Label: "SUBJECT ADDED ENTRY--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "611", Label: "SUBJECT ADDED ENTRY--MEETING NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of meeting name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Relator term", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "630", Label: "SUBJECT ADDED ENTRY--UNIFORM TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "647", Label: "SUBJECT ADDED ENTRY--NAMED EVENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Named event"},
+			{Code: "c", Label: "Location of named event", Repeatable: true},
+			{Code: "d", Label: "Date of named event"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "648", Label: "SUBJECT ADDED ENTRY--CHRONOLOGICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Chronological term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "650", Label: "SUBJECT ADDED ENTRY--TOPICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Level of subject", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No level specified"},
+				{Code: "1", Label: "Primary"},
+				{Code: "2", Label: "Secondary"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Topical term or geographic name entry element"},
+			{Code: "b", Label: "Topical term following geographic name entry element"},
+			{Code: "c", Label: "Location of event"},
+			{Code: "d", Label: "Active dates"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "651", Label: "SUBJECT ADDED ENTRY--GEOGRAPHIC NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "652", Label: "SUBJECT ADDED ENTRY--REVERSED GEOGRAPHIC", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name of place element"},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+		}},
+		{Tag: "653", Label: "INDEX TERM--UNCONTROLLED", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Level of index term", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No level specified"},
+				{Code: "1", Label: "Primary"},
+				{Code: "2", Label: "Secondary"},
+			}},
+			{Label: "Type of term or name", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Topical term"},
+				{Code: "1", Label: "Personal name"},
+				{Code: "2", Label: "Corporate name"},
+				{Code: "3", Label: "Meeting name"},
+				{Code: "4", Label: "Chronological term"},
+				{Code: "5", Label: "Geographic name"},
+				{Code: "6", Label: "Genre/form term"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uncontrolled term", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "654", Label: "SUBJECT ADDED ENTRY--FACETED TOPICAL TERMS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Level of subject", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No level specified"},
+				{Code: "1", Label: "Primary"},
+				{Code: "2", Label: "Secondary"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Focus term", Repeatable: true},
+			{Code: "b", Label: "Non-focus term", Repeatable: true},
+			{Code: "c", Label: "Facet/hierarchy designation", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "655", Label: "INDEX TERM--GENRE/FORM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of heading", Values: []IndicatorValue{
+				{Code: " ", Label: "Basic"},
+				{Code: "0", Label: "Faceted"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Genre/form data or focus term"},
+			{Code: "b", Label: "Non-focus term", Repeatable: true},
+			{Code: "c", Label: "Facet/hierarchy designation", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "656", Label: "INDEX TERM--OCCUPATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of term", Values: []IndicatorValue{
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Occupation"},
+			{Code: "k", Label: "Form"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "657", Label: "INDEX TERM--FUNCTION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of term", Values: []IndicatorValue{
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Function"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "658", Label: "INDEX TERM--CURRICULUM OBJECTIVE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main curriculum objective"},
+			{Code: "b", Label: "Subordinate curriculum objective", Repeatable: true},
+			{Code: "c", Label: "Curriculum code"},
+			{Code: "d", Label: "Correlation factor"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term or code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "662", Label: "SUBJECT ADDED ENTRY--HIERARCHICAL PLACE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Country or larger entity", Repeatable: true},
+			{Code: "b", Label: "First-order political jurisdiction"},
+			{Code: "c", Label: "Intermediate political jurisdiction", Repeatable: true},
+			{Code: "d", Label: "City"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "City subsection", Repeatable: true},
+			{Code: "g", Label: "Other nonjurisdictional geographic region and feature", Repeatable: true},
+			{Code: "h", Label: "Extraterrestrial area", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "688", Label: "SUBJECT ADDED ENTRY--TYPE OF ENTITY UNSPECIFIED", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of heading or term", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Name or subject term"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "69X", Label: "LOCAL SUBJECT ACCESS FIELDS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "700", Label: "ADDED ENTRY--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Type of added entry", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "2", Label: "Analytical entry"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "j", Label: "Attribution qualifier", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "710", Label: "ADDED ENTRY--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Type of added entry", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "2", Label: "Analytical entry"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "711", Label: "ADDED ENTRY--MEETING NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of meeting name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Type of added entry", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "2", Label: "Analytical entry"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "j", Label: "Relator term", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "720", Label: "ADDED ENTRY--UNCONTROLLED NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of name", Values: []IndicatorValue{
+				{Code: " ", Label: "Not specified"},
+				{Code: "1", Label: "Personal"},
+				{Code: "2", Label: "Other"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "730", Label: "ADDED ENTRY--UNIFORM TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+			{Label: "Type of added entry", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "2", Label: "Analytical entry"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "740", Label: "ADDED ENTRY--UNCONTROLLED RELATED/ANALYTICAL TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+			{Label: "Type of added entry", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "2", Label: "Analytical entry"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uncontrolled related/analytical title"},
+			{Code: "h", Label: "Medium"},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "751", Label: "ADDED ENTRY--GEOGRAPHIC NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "752", Label: "ADDED ENTRY--HIERARCHICAL PLACE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Country or larger entity", Repeatable: true},
+			{Code: "b", Label: "First-order political jurisdiction"},
+			{Code: "c", Label: "Intermediate political jurisdiction", Repeatable: true},
+			{Code: "d", Label: "City"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "City subsection", Repeatable: true},
+			{Code: "g", Label: "Other nonjurisdictional geographic region and feature", Repeatable: true},
+			{Code: "h", Label: "Extraterrestrial area", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "753", Label: "SYSTEM DETAILS ACCESS TO COMPUTER FILES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Make and model of machine"},
+			{Code: "b", Label: "Programming language"},
+			{Code: "c", Label: "Operating system"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "754", Label: "ADDED ENTRY--TAXONOMIC IDENTIFICATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Taxonomic name", Repeatable: true},
+			{Code: "c", Label: "Taxonomic category", Repeatable: true},
+			{Code: "d", Label: "Common or alternative name", Repeatable: true},
+			{Code: "x", Label: "Non-public note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of taxonomic identification"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "755", Label: "ADDED ENTRY--PHYSICAL CHARACTERISTICS", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Access term"},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "758", Label: "RESOURCE IDENTIFIER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Label"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "760", Label: "MAIN SERIES ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "762", Label: "SUBSERIES ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "765", Label: "ORIGINAL LANGUAGE ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "767", Label: "TRANSLATION ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "770", Label: "SUPPLEMENT/SPECIAL ISSUE ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "772", Label: "SUPPLEMENT PARENT ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Parent"},
+				{Code: "0", Label: "Parent"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "773", Label: "HOST ITEM ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+			{Code: "p", Label: "Abbreviated title"},
+			{Code: "q", Label: "Enumeration and first page"},
+			{Code: "3", Label: "Materials specified"},
+		}},
+		{Tag: "774", Label: "CONSTITUENT UNIT ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "775", Label: "OTHER EDITION ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "776", Label: "ADDITIONAL PHYSICAL FORM ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "777", Label: "ISSUED WITH ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "780", Label: "PRECEDING ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Type of relationship", Values: []IndicatorValue{
+				{Code: "0", Label: "Continues"},
+				{Code: "1", Label: "Continues in part"},
+				{Code: "2", Label: "Supersedes"},
+				{Code: "3", Label: "Supersedes in part"},
+				{Code: "4", Label: "Formed by the union of ... and ..."},
+				{Code: "5", Label: "Absorbed"},
+				{Code: "6", Label: "Absorbed in part"},
+				{Code: "7", Label: "Separated from"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "785", Label: "SUCCEEDING ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Type of relationship", Values: []IndicatorValue{
+				{Code: "0", Label: "Continued by"},
+				{Code: "1", Label: "Continued in part by"},
+				{Code: "2", Label: "Superseded by"},
+				{Code: "3", Label: "Superseded in part by"},
+				{Code: "4", Label: "Absorbed by"},
+				{Code: "5", Label: "Absorbed in part by"},
+				{Code: "6", Label: "Split into ... and ..."},
+				{Code: "7", Label: "Merged with ... to form ..."},
+				{Code: "8", Label: "Changed back to"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "786", Label: "DATA SOURCE ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+			{Code: "j", Label: "Period of content"},
+			{Code: "p", Label: "Abbreviated title"},
+			{Code: "v", Label: "Source Contribution"},
+		}},
+		{Tag: "787", Label: "OTHER RELATIONSHIP ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Related item"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "c", Label: "Qualifying information"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Country code"},
+			{Code: "g", Label: "Related parts", Repeatable: true},
+			{Code: "h", Label: "Physical description"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "k", Label: "Series data for related item", Repeatable: true},
+			{Code: "m", Label: "Material-specific details"},
+			{Code: "n", Label: "Note", Repeatable: true},
+			{Code: "o", Label: "Other item identifier", Repeatable: true},
+			{Code: "r", Label: "Report number", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "u", Label: "Standard Technical Report Number"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "y", Label: "CODEN designation"},
+			{Code: "z", Label: "International Standard Book Number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "788", Label: "PARALLEL DESCRIPTION IN ANOTHER LANGUAGE OF CATALOGING", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "b", Label: "Edition"},
+			{Code: "d", Label: "Place, publisher, and date of publication"},
+			{Code: "e", Label: "Language code"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "s", Label: "Uniform title"},
+			{Code: "t", Label: "Title"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "800", Label: "SERIES ADDED ENTRY--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Attribution qualifier", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Volume/sequential designation"},
+			{Code: "w", Label: "Bibliographic record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "810", Label: "SERIES ADDED ENTRY--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Volume/sequential designation"},
+			{Code: "w", Label: "Bibliographic record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "811", Label: "SERIES ADDED ENTRY--MEETING NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of meeting name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Relator term", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "u", Label: "Affiliation"},
+			{Code: "v", Label: "Volume/sequential designation"},
+			{Code: "w", Label: "Bibliographic record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "830", Label: "SERIES ADDED ENTRY--UNIFORM TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Volume/sequential designation"},
+			{Code: "w", Label: "Bibliographic record control number", Repeatable: true},
+			{Code: "x", Label: "International Standard Serial Number"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "850", Label: "HOLDING INSTITUTION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Holding institution", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "852", Label: "LOCATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Shelving scheme", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Library of Congress classification"},
+				{Code: "1", Label: "Dewey Decimal classification"},
+				{Code: "2", Label: "National Library of Medicine classification"},
+				{Code: "3", Label: "Superintendent of Documents classification"},
+				{Code: "4", Label: "Shelving control number"},
+				{Code: "5", Label: "Title"},
+				{Code: "6", Label: "Shelved separately"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+				{Code: "8", Label: "Other scheme"},
+			}},
+			{Label: "Shelving order", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Not enumeration"},
+				{Code: "1", Label: "Primary enumeration"},
+				{Code: "2", Label: "Alternative enumeration"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Location"},
+			{Code: "b", Label: "Sublocation or collection", Repeatable: true},
+			{Code: "c", Label: "Shelving location", Repeatable: true},
+			{Code: "d", Label: "Former shelving location", Repeatable: true},
+			{Code: "e", Label: "Address", Repeatable: true},
+			{Code: "f", Label: "Coded location qualifier", Repeatable: true},
+			{Code: "g", Label: "Non-coded location qualifier", Repeatable: true},
+			{Code: "h", Label: "Classification part"},
+			{Code: "i", Label: "Item part", Repeatable: true},
+			{Code: "j", Label: "Shelving control number"},
+			{Code: "k", Label: "Call number prefix", Repeatable: true},
+			{Code: "l", Label: "Shelving form of title"},
+			{Code: "m", Label: "Call number suffix", Repeatable: true},
+			{Code: "n", Label: "Country code"},
+			{Code: "p", Label: "Piece designation"},
+			{Code: "q", Label: "Piece physical condition"},
+			{Code: "s", Label: "Copyright article-fee code", Repeatable: true},
+			{Code: "t", Label: "Copy number"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Source of classification or shelving scheme"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "856", Label: "ELECTRONIC LOCATION AND ACCESS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Access method", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Email"},
+				{Code: "1", Label: "FTP"},
+				{Code: "2", Label: "Remote login (Telnet)"},
+				{Code: "3", Label: "Dial-up"},
+				{Code: "4", Label: "HTTP"},
+				{Code: "7", Label: "Method specified in subfield $2"},
+			}},
+			{Label: "Relationship", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Resource"},
+				{Code: "1", Label: "Version of resource"},
+				{Code: "2", Label: "Related resource"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Host name", Repeatable: true},
+			{Code: "b", Label: "Access number", Repeatable: true, Obsolete: true},
+			{Code: "c", Label: "Compression information", Repeatable: true},
+			{Code: "d", Label: "Path", Repeatable: true},
+			{Code: "f", Label: "Electronic name", Repeatable: true},
+			{Code: "h", Label: "Processor of request"},
+			{Code: "i", Label: "Instruction", Repeatable: true},
+			{Code: "j", Label: "Bits per second"},
+			{Code: "k", Label: "Password"},
+			{Code: "l", Label: "Logon"},
+			{Code: "m", Label: "Contact for access assistance", Repeatable: true},
+			{Code: "n", Label: "Name of location of host"},
+			{Code: "o", Label: "Operating system"},
+			{Code: "p", Label: "Port"},
+			{Code: "q", Label: "Electronic format type", Repeatable: true},
+			{Code: "r", Label: "Settings"},
+			{Code: "s", Label: "File size", Repeatable: true},
+			{Code: "t", Label: "Terminal emulation", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Hours access method available", Repeatable: true},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "y", Label: "Link text", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Access method"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Access status"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "880", Label: "ALTERNATE GRAPHIC REPRESENTATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Same as associated field"},
+			{Label: "Same as associated field"},
+		}, Subfields: []SubfieldDef{
+			{Code: "6", Label: "Linkage"},
+			{Code: "a", Label: "Same as associated field", Repeatable: true},
+			{Code: "b", Label: "Same as associated field", Repeatable: true},
+			{Code: "c", Label: "Same as associated field", Repeatable: true},
+			{Code: "d", Label: "Same as associated field", Repeatable: true},
+			{Code: "e", Label: "Same as associated field", Repeatable: true},
+			{Code: "f", Label: "Same as associated field", Repeatable: true},
+			{Code: "g", Label: "Same as associated field", Repeatable: true},
+			{Code: "h", Label: "Same as associated field", Repeatable: true},
+			{Code: "i", Label: "Same as associated field", Repeatable: true},
+			{Code: "j", Label: "Same as associated field", Repeatable: true},
+			{Code: "k", Label: "Same as associated field", Repeatable: true},
+			{Code: "l", Label: "Same as associated field", Repeatable: true},
+			{Code: "m", Label: "Same as associated field", Repeatable: true},
+			{Code: "n", Label: "Same as associated field", Repeatable: true},
+			{Code: "o", Label: "Same as associated field", Repeatable: true},
+			{Code: "p", Label: "Same as associated field", Repeatable: true},
+			{Code: "q", Label: "Same as associated field", Repeatable: true},
+			{Code: "r", Label: "Same as associated field", Repeatable: true},
+			{Code: "s", Label: "Same as associated field", Repeatable: true},
+			{Code: "t", Label: "Same as associated field", Repeatable: true},
+			{Code: "u", Label: "Same as associated field", Repeatable: true},
+			{Code: "v", Label: "Same as associated field", Repeatable: true},
+			{Code: "w", Label: "Same as associated field", Repeatable: true},
+			{Code: "x", Label: "Same as associated field", Repeatable: true},
+			{Code: "y", Label: "Same as associated field", Repeatable: true},
+			{Code: "z", Label: "Same as associated field", Repeatable: true},
+			{Code: "0", Label: "Same as associated field", Repeatable: true},
+			{Code: "1", Label: "Same as associated field", Repeatable: true},
+			{Code: "2", Label: "Same as associated field", Repeatable: true},
+			{Code: "3", Label: "Same as associated field", Repeatable: true},
+			{Code: "4", Label: "Same as associated field", Repeatable: true},
+			{Code: "5", Label: "Same as associated field", Repeatable: true},
+			{Code: "7", Label: "Same as associated field", Repeatable: true},
+			{Code: "8", Label: "Same as associated field", Repeatable: true},
+			{Code: "9", Label: "Same as associated field", Repeatable: true},
+		}},
+		{Tag: "881", Label: "MANIFESTATION STATEMENTS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Manifestation statement, high-level/general", Repeatable: true},
+			{Code: "b", Label: "Manifestation identifier statement", Repeatable: true},
+			{Code: "c", Label: "Manifestation title and responsibility statement", Repeatable: true},
+			{Code: "d", Label: "Manifestation edition statement", Repeatable: true},
+			{Code: "e", Label: "Manifestation details of cartographic content statement", Repeatable: true},
+			{Code: "f", Label: "Manifestation part designation statement", Repeatable: true},
+			{Code: "g", Label: "Manifestation frequency statement", Repeatable: true},
+			{Code: "h", Label: "Manifestation production statement", Repeatable: true},
+			{Code: "i", Label: "Manifestation publication statement", Repeatable: true},
+			{Code: "j", Label: "Manifestation distribution statement", Repeatable: true},
+			{Code: "k", Label: "Manifestation manufacture statement", Repeatable: true},
+			{Code: "l", Label: "Manifestation copyright statement", Repeatable: true},
+			{Code: "m", Label: "Manifestation series statement", Repeatable: true},
+			{Code: "n", Label: "Manifestation dissertation statement", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "882", Label: "REPLACEMENT RECORD INFORMATION", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Replacement title", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "w", Label: "Replacement bibliographic record control number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "883", Label: "METADATA PROVENANCE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Method of assignment", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided/not applicable"},
+				{Code: "0", Label: "Fully machine-generated"},
+				{Code: "1", Label: "Partially machine-generated"},
+				{Code: "2", Label: "Not machine-generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Creation process"},
+			{Code: "c", Label: "Confidence value"},
+			{Code: "d", Label: "Creation date"},
+			{Code: "q", Label: "Assigning or generating agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "w", Label: "Bibliographic record control number", Repeatable: true},
+			{Code: "x", Label: "Validity end date"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "884", Label: "DESCRIPTION CONVERSION INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Conversion process"},
+			{Code: "g", Label: "Conversion date"},
+			{Code: "k", Label: "Identifier of source metadata"},
+			{Code: "q", Label: "Conversion agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+		}},
+		{Tag: "885", Label: "MATCHING INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Matching information"},
+			{Code: "b", Label: "Status of matching and its checking"},
+			{Code: "c", Label: "Confidence value"},
+			{Code: "d", Label: "Generation date"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "5", Label: "Institution to which field applies"},
+		}},
+		{Tag: "886", Label: "FOREIGN MARC INFORMATION FIELD", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of field", Values: []IndicatorValue{
+				{Code: "0", Label: "Leader"},
+				{Code: "1", Label: "Variable control fields (002-009)"},
+				{Code: "2", Label: "Variable data fields (010-999)"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Tag of the foreign MARC field"},
+			{Code: "b", Label: "Content of the foreign MARC field"},
+			{Code: "2", Label: "Source of data"},
+			{Code: "c", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "d", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "e", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "f", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "g", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "h", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "i", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "j", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "k", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "l", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "m", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "n", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "o", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "p", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "q", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "r", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "s", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "t", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "u", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "v", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "w", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "x", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "y", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "z", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "0", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "1", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "3", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "4", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "5", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "6", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "7", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "8", Label: "Foreign MARC subfield", Repeatable: true},
+			{Code: "9", Label: "Foreign MARC subfield", Repeatable: true},
+		}},
+		{Tag: "887", Label: "NON-MARC INFORMATION FIELD", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Content of non-MARC field"},
+			{Code: "2", Label: "Source of data"},
+		}},
+		{Tag: "9XX", Label: "LOCAL FIELDS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+	},
+	Holdings: {
+		{Tag: "010", Label: "LIBRARY OF CONGRESS CONTROL NUMBER", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "LC control number"},
+			{Code: "z", Label: "Canceled/invalid LC control number", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "014", Label: "LINKAGE NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Linkage number"},
+			{Code: "b", Label: "Source of number"},
+			{Code: "z", Label: "Canceled/invalid linkage number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "016", Label: "NATIONAL BIBLIOGRAPHIC AGENCY CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "National bibliographic agency", Values: []IndicatorValue{
+				{Code: " ", Label: "Library and Archives Canada"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Record control number"},
+			{Code: "z", Label: "Canceled/invalid record control number", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "017", Label: "COPYRIGHT OR LEGAL DEPOSIT NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Copyright or legal deposit number"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Copyright or legal deposit number", Repeatable: true},
+			{Code: "b", Label: "Assigning agency"},
+			{Code: "d", Label: "Date"},
+			{Code: "i", Label: "Display text"},
+			{Code: "z", Label: "Canceled/invalid copyright or legal deposit number", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "020", Label: "INTERNATIONAL STANDARD BOOK NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "International Standard Book Number"},
+			{Code: "c", Label: "Terms of availability"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid ISBN", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "022", Label: "INTERNATIONAL STANDARD SERIAL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "International Standard Serial Number"},
+			{Code: "l", Label: "ISSN-L"},
+			{Code: "m", Label: "Canceled ISSN-L", Repeatable: true},
+			{Code: "y", Label: "Incorrect ISSN", Repeatable: true},
+			{Code: "z", Label: "Canceled ISSN", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "024", Label: "OTHER STANDARD IDENTIFIER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of standard number or code", Values: []IndicatorValue{
+				{Code: "0", Label: "International Standard Recording Code"},
+				{Code: "1", Label: "Universal Product Code"},
+				{Code: "2", Label: "International Standard Music Number"},
+				{Code: "3", Label: "International Article Number"},
+				{Code: "4", Label: "Serial Item and Contribution Identifier"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+				{Code: "8", Label: "Unspecified type of standard number or code"},
+			}},
+			{Label: "Difference indicator", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No difference"},
+				{Code: "1", Label: "Difference"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Standard number or code"},
+			{Code: "c", Label: "Terms of availability"},
+			{Code: "d", Label: "Additional codes following the standard number or code"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid standard number or code", Repeatable: true},
+			{Code: "2", Label: "Source of number or code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "027", Label: "STANDARD TECHNICAL REPORT NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Standard technical report number"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "030", Label: "CODEN DESIGNATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "CODEN"},
+			{Code: "z", Label: "Canceled/invalid CODEN", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "035", Label: "SYSTEM CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "System control number"},
+			{Code: "z", Label: "Canceled/invalid control number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "040", Label: "CATALOGING SOURCE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Original cataloging agency"},
+			{Code: "b", Label: "Language of cataloging"},
+			{Code: "c", Label: "Transcribing agency"},
+			{Code: "d", Label: "Modifying agency", Repeatable: true},
+			{Code: "e", Label: "Description conventions", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "066", Label: "CHARACTER SETS PRESENT", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Primary G0 character set"},
+			{Code: "b", Label: "Primary G1 character set"},
+			{Code: "c", Label: "Alternate G0 or G1 character set", Repeatable: true},
+		}},
+		{Tag: "07X", Label: "LOCAL CALL NUMBERS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "09X", Label: "LOCAL CALL NUMBERS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "307", Label: "HOURS, ETC.", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Hours"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Hours"},
+			{Code: "b", Label: "Additional information"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "337", Label: "MEDIA TYPE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Media type term", Repeatable: true},
+			{Code: "b", Label: "Media type code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "338", Label: "CARRIER TYPE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Carrier type term", Repeatable: true},
+			{Code: "b", Label: "Carrier type code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "347", Label: "DIGITAL FILE CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "File type", Repeatable: true},
+			{Code: "b", Label: "Encoding format", Repeatable: true},
+			{Code: "c", Label: "File size", Repeatable: true},
+			{Code: "d", Label: "Resolution", Repeatable: true},
+			{Code: "e", Label: "Regional encoding", Repeatable: true},
+			{Code: "f", Label: "Encoded bitrate", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "506", Label: "RESTRICTIONS ON ACCESS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Restriction", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No restrictions"},
+				{Code: "1", Label: "Restrictions apply"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Terms governing access"},
+			{Code: "b", Label: "Jurisdiction", Repeatable: true},
+			{Code: "c", Label: "Physical access provisions", Repeatable: true},
+			{Code: "d", Label: "Authorized users", Repeatable: true},
+			{Code: "e", Label: "Authorization", Repeatable: true},
+			{Code: "f", Label: "Standardized terminology for access restriction", Repeatable: true},
+			{Code: "g", Label: "Availability date", Repeatable: true},
+			{Code: "q", Label: "Supplying agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "538", Label: "SYSTEM DETAILS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "System details note"},
+			{Code: "i", Label: "Display text"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "540", Label: "TERMS GOVERNING USE AND REPRODUCTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Terms governing use and reproduction"},
+			{Code: "b", Label: "Jurisdiction"},
+			{Code: "c", Label: "Authorization"},
+			{Code: "d", Label: "Authorized users"},
+			{Code: "f", Label: "Use and reproduction rights", Repeatable: true},
+			{Code: "g", Label: "Availability date", Repeatable: true},
+			{Code: "q", Label: "Supplying agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "541", Label: "IMMEDIATE SOURCE OF ACQUISITION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Privacy", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Private"},
+				{Code: "1", Label: "Not private"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Source of acquisition"},
+			{Code: "b", Label: "Address"},
+			{Code: "c", Label: "Method of acquisition"},
+			{Code: "d", Label: "Date of acquisition"},
+			{Code: "e", Label: "Accession number"},
+			{Code: "f", Label: "Owner"},
+			{Code: "h", Label: "Purchase price"},
+			{Code: "n", Label: "Extent", Repeatable: true},
+			{Code: "o", Label: "Type of unit", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "561", Label: "OWNERSHIP AND CUSTODIAL HISTORY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Privacy", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Private"},
+				{Code: "1", Label: "Not private"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "History"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "562", Label: "COPY AND VERSION IDENTIFICATION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Identifying markings", Repeatable: true},
+			{Code: "b", Label: "Copy identification", Repeatable: true},
+			{Code: "c", Label: "Version identification", Repeatable: true},
+			{Code: "d", Label: "Presentation format", Repeatable: true},
+			{Code: "e", Label: "Number of copies", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "563", Label: "BINDING INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Binding note"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "583", Label: "ACTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Privacy", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Private"},
+				{Code: "1", Label: "Not private"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Action"},
+			{Code: "b", Label: "Action identification", Repeatable: true},
+			{Code: "c", Label: "Time/date of action", Repeatable: true},
+			{Code: "d", Label: "Action interval", Repeatable: true},
+			{Code: "e", Label: "Contingency for action", Repeatable: true},
+			{Code: "f", Label: "Authorization", Repeatable: true},
+			{Code: "h", Label: "Jurisdiction", Repeatable: true},
+			{Code: "i", Label: "Method of action", Repeatable: true},
+			{Code: "j", Label: "Site of action", Repeatable: true},
+			{Code: "k", Label: "Action agent", Repeatable: true},
+			{Code: "l", Label: "Status", Repeatable: true},
+			{Code: "n", Label: "Extent", Repeatable: true},
+			{Code: "o", Label: "Type of unit", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "59X", Label: "LOCAL NOTES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "842", Label: "TEXTUAL PHYSICAL FORM DESIGNATOR", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Textual physical form designator"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "843", Label: "REPRODUCTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Type of reproduction"},
+			{Code: "b", Label: "Place of reproduction", Repeatable: true},
+			{Code: "c", Label: "Agency responsible for reproduction", Repeatable: true},
+			{Code: "d", Label: "Date of reproduction"},
+			{Code: "e", Label: "Physical description of reproduction"},
+			{Code: "f", Label: "Series statement of reproduction", Repeatable: true},
+			{Code: "m", Label: "Dates of publication and/or sequential designation of issues reproduced", Repeatable: true},
+			{Code: "n", Label: "Note about reproduction", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Fixed-length data elements of reproduction"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "844", Label: "NAME OF UNIT", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Name of unit"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "845", Label: "TERMS GOVERNING USE AND REPRODUCTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Terms governing use and reproduction"},
+			{Code: "b", Label: "Jurisdiction"},
+			{Code: "c", Label: "Authorization"},
+			{Code: "d", Label: "Authorized users"},
+			{Code: "f", Label: "Use and reproduction rights", Repeatable: true},
+			{Code: "g", Label: "Availability date", Repeatable: true},
+			{Code: "q", Label: "Supplying agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "852", Label: "LOCATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Shelving scheme", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Library of Congress classification"},
+				{Code: "1", Label: "Dewey Decimal classification"},
+				{Code: "2", Label: "National Library of Medicine classification"},
+				{Code: "3", Label: "Superintendent of Documents classification"},
+				{Code: "4", Label: "Shelving control number"},
+				{Code: "5", Label: "Title"},
+				{Code: "6", Label: "Shelved separately"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+				{Code: "8", Label: "Other scheme"},
+			}},
+			{Label: "Shelving order", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Not enumeration"},
+				{Code: "1", Label: "Primary enumeration"},
+				{Code: "2", Label: "Alternative enumeration"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Location"},
+			{Code: "b", Label: "Sublocation or collection", Repeatable: true},
+			{Code: "c", Label: "Shelving location", Repeatable: true},
+			{Code: "d", Label: "Former shelving location", Repeatable: true},
+			{Code: "e", Label: "Address", Repeatable: true},
+			{Code: "f", Label: "Coded location qualifier", Repeatable: true},
+			{Code: "g", Label: "Non-coded location qualifier", Repeatable: true},
+			{Code: "h", Label: "Classification part"},
+			{Code: "i", Label: "Item part", Repeatable: true},
+			{Code: "j", Label: "Shelving control number"},
+			{Code: "k", Label: "Call number prefix", Repeatable: true},
+			{Code: "l", Label: "Shelving form of title"},
+			{Code: "m", Label: "Call number suffix", Repeatable: true},
+			{Code: "n", Label: "Country code"},
+			{Code: "p", Label: "Piece designation"},
+			{Code: "q", Label: "Piece physical condition"},
+			{Code: "s", Label: "Copyright article-fee code", Repeatable: true},
+			{Code: "t", Label: "Copy number"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Source of classification or shelving scheme"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "853", Label: "CAPTIONS AND PATTERN--BASIC BIBLIOGRAPHIC UNIT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Compressibility and expandability", Values: []IndicatorValue{
+				{Code: "0", Label: "Cannot compress or expand"},
+				{Code: "1", Label: "Can compress but cannot expand"},
+				{Code: "2", Label: "Can compress or expand"},
+				{Code: "3", Label: "Unknown"},
+			}},
+			{Label: "Caption evaluation", Values: []IndicatorValue{
+				{Code: "0", Label: "Captions verified; all levels present"},
+				{Code: "1", Label: "Captions verified; all levels may not be present"},
+				{Code: "2", Label: "Captions unverified; all levels present"},
+				{Code: "3", Label: "Captions unverified; all levels may not be present"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "First level of enumeration"},
+			{Code: "b", Label: "Second level of enumeration"},
+			{Code: "c", Label: "Third level of enumeration"},
+			{Code: "d", Label: "Fourth level of enumeration"},
+			{Code: "e", Label: "Fifth level of enumeration"},
+			{Code: "f", Label: "Sixth level of enumeration"},
+			{Code: "g", Label: "Alternative numbering scheme, first level of enumeration"},
+			{Code: "h", Label: "Alternative numbering scheme, second level of enumeration"},
+			{Code: "i", Label: "First level of chronology"},
+			{Code: "j", Label: "Second level of chronology"},
+			{Code: "k", Label: "Third level of chronology"},
+			{Code: "l", Label: "Fourth level of chronology"},
+			{Code: "m", Label: "Alternative numbering scheme, chronology"},
+			{Code: "n", Label: "Pattern note"},
+			{Code: "p", Label: "Number of pieces per issuance"},
+			{Code: "t", Label: "Copy"},
+			{Code: "u", Label: "Bibliographic units per next higher level", Repeatable: true},
+			{Code: "v", Label: "Numbering continuity", Repeatable: true},
+			{Code: "w", Label: "Frequency"},
+			{Code: "x", Label: "Calendar change"},
+			{Code: "y", Label: "Regularity pattern", Repeatable: true},
+			{Code: "z", Label: "Numbering scheme", Repeatable: true},
+			{Code: "2", Label: "Source of caption abbreviation", Obsolete: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "854", Label: "CAPTIONS AND PATTERN--SUPPLEMENTARY MATERIAL", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Compressibility and expandability", Values: []IndicatorValue{
+				{Code: "0", Label: "Cannot compress or expand"},
+				{Code: "1", Label: "Can compress but cannot expand"},
+				{Code: "2", Label: "Can compress or expand"},
+				{Code: "3", Label: "Unknown"},
+			}},
+			{Label: "Caption evaluation", Values: []IndicatorValue{
+				{Code: "0", Label: "Captions verified; all levels present"},
+				{Code: "1", Label: "Captions verified; all levels may not be present"},
+				{Code: "2", Label: "Captions unverified; all levels present"},
+				{Code: "3", Label: "Captions unverified; all levels may not be present"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "First level of enumeration"},
+			{Code: "b", Label: "Second level of enumeration"},
+			{Code: "c", Label: "Third level of enumeration"},
+			{Code: "d", Label: "Fourth level of enumeration"},
+			{Code: "e", Label: "Fifth level of enumeration"},
+			{Code: "f", Label: "Sixth level of enumeration"},
+			{Code: "g", Label: "Alternative numbering scheme, first level of enumeration"},
+			{Code: "h", Label: "Alternative numbering scheme, second level of enumeration"},
+			{Code: "i", Label: "First level of chronology"},
+			{Code: "j", Label: "Second level of chronology"},
+			{Code: "k", Label: "Third level of chronology"},
+			{Code: "l", Label: "Fourth level of chronology"},
+			{Code: "m", Label: "Alternative numbering scheme, chronology"},
+			{Code: "n", Label: "Pattern note"},
+			{Code: "p", Label: "Number of pieces per issuance"},
+			{Code: "t", Label: "Copy"},
+			{Code: "u", Label: "Bibliographic units per next higher level", Repeatable: true},
+			{Code: "v", Label: "Numbering continuity", Repeatable: true},
+			{Code: "w", Label: "Frequency"},
+			{Code: "x", Label: "Calendar change"},
+			{Code: "y", Label: "Regularity pattern", Repeatable: true},
+			{Code: "z", Label: "Numbering scheme", Repeatable: true},
+			{Code: "2", Label: "Source of caption abbreviation", Obsolete: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "855", Label: "CAPTIONS AND PATTERN--INDEXES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Compressibility and expandability", Values: []IndicatorValue{
+				{Code: "0", Label: "Cannot compress or expand"},
+				{Code: "1", Label: "Can compress but cannot expand"},
+				{Code: "2", Label: "Can compress or expand"},
+				{Code: "3", Label: "Unknown"},
+			}},
+			{Label: "Caption evaluation", Values: []IndicatorValue{
+				{Code: "0", Label: "Captions verified; all levels present"},
+				{Code: "1", Label: "Captions verified; all levels may not be present"},
+				{Code: "2", Label: "Captions unverified; all levels present"},
+				{Code: "3", Label: "Captions unverified; all levels may not be present"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "First level of enumeration"},
+			{Code: "b", Label: "Second level of enumeration"},
+			{Code: "c", Label: "Third level of enumeration"},
+			{Code: "d", Label: "Fourth level of enumeration"},
+			{Code: "e", Label: "Fifth level of enumeration"},
+			{Code: "f", Label: "Sixth level of enumeration"},
+			{Code: "g", Label: "Alternative numbering scheme, first level of enumeration"},
+			{Code: "h", Label: "Alternative numbering scheme, second level of enumeration"},
+			{Code: "i", Label: "First level of chronology"},
+			{Code: "j", Label: "Second level of chronology"},
+			{Code: "k", Label: "Third level of chronology"},
+			{Code: "l", Label: "Fourth level of chronology"},
+			{Code: "m", Label: "Alternative numbering scheme, chronology"},
+			{Code: "n", Label: "Pattern note"},
+			{Code: "p", Label: "Number of pieces per issuance"},
+			{Code: "t", Label: "Copy"},
+			{Code: "u", Label: "Bibliographic units per next higher level", Repeatable: true},
+			{Code: "v", Label: "Numbering continuity", Repeatable: true},
+			{Code: "w", Label: "Frequency"},
+			{Code: "x", Label: "Calendar change"},
+			{Code: "y", Label: "Regularity pattern", Repeatable: true},
+			{Code: "z", Label: "Numbering scheme", Repeatable: true},
+			{Code: "2", Label: "Source of caption abbreviation", Obsolete: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "856", Label: "ELECTRONIC LOCATION AND ACCESS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Access method", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Email"},
+				{Code: "1", Label: "FTP"},
+				{Code: "2", Label: "Remote login (Telnet)"},
+				{Code: "3", Label: "Dial-up"},
+				{Code: "4", Label: "HTTP"},
+				{Code: "7", Label: "Method specified in subfield $2"},
+			}},
+			{Label: "Relationship", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Resource"},
+				{Code: "1", Label: "Version of resource"},
+				{Code: "2", Label: "Related resource"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Host name", Repeatable: true},
+			{Code: "c", Label: "Compression information", Repeatable: true},
+			{Code: "d", Label: "Path", Repeatable: true},
+			{Code: "f", Label: "Electronic name", Repeatable: true},
+			{Code: "h", Label: "Processor of request"},
+			{Code: "i", Label: "Instruction", Repeatable: true},
+			{Code: "j", Label: "Bits per second"},
+			{Code: "k", Label: "Password"},
+			{Code: "l", Label: "Logon"},
+			{Code: "m", Label: "Contact for access assistance", Repeatable: true},
+			{Code: "n", Label: "Name of location of host"},
+			{Code: "o", Label: "Operating system"},
+			{Code: "p", Label: "Port"},
+			{Code: "q", Label: "Electronic format type", Repeatable: true},
+			{Code: "r", Label: "Settings"},
+			{Code: "s", Label: "File size", Repeatable: true},
+			{Code: "t", Label: "Terminal emulation", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Hours access method available", Repeatable: true},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "y", Label: "Link text", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Access method"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Access status"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "863", Label: "ENUMERATION AND CHRONOLOGY--BASIC BIBLIOGRAPHIC UNIT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Field encoding level", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "3", Label: "Holdings level 3"},
+				{Code: "4", Label: "Holdings level 4"},
+				{Code: "5", Label: "Holdings level 4 with piece designation"},
+			}},
+			{Label: "Form of holdings", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Compressed"},
+				{Code: "1", Label: "Uncompressed"},
+				{Code: "2", Label: "Compressed, use textual display"},
+				{Code: "3", Label: "Uncompressed, use textual display"},
+				{Code: "4", Label: "Item(s) not published"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "First level of enumeration"},
+			{Code: "b", Label: "Second level of enumeration"},
+			{Code: "c", Label: "Third level of enumeration"},
+			{Code: "d", Label: "Fourth level of enumeration"},
+			{Code: "e", Label: "Fifth level of enumeration"},
+			{Code: "f", Label: "Sixth level of enumeration"},
+			{Code: "g", Label: "Alternative numbering scheme, first level of enumeration"},
+			{Code: "h", Label: "Alternative numbering scheme, second level of enumeration"},
+			{Code: "i", Label: "First level of chronology"},
+			{Code: "j", Label: "Second level of chronology"},
+			{Code: "k", Label: "Third level of chronology"},
+			{Code: "l", Label: "Fourth level of chronology"},
+			{Code: "m", Label: "Alternative numbering scheme, chronology"},
+			{Code: "n", Label: "Converted Gregorian year"},
+			{Code: "o", Label: "Title of unit", Repeatable: true},
+			{Code: "p", Label: "Piece designation"},
+			{Code: "q", Label: "Piece physical condition"},
+			{Code: "s", Label: "Copyright article-fee code", Repeatable: true},
+			{Code: "t", Label: "Copy number"},
+			{Code: "v", Label: "Issuing date", Repeatable: true},
+			{Code: "w", Label: "Break indicator"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "864", Label: "ENUMERATION AND CHRONOLOGY--SUPPLEMENTARY MATERIAL", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Field encoding level", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "3", Label: "Holdings level 3"},
+				{Code: "4", Label: "Holdings level 4"},
+				{Code: "5", Label: "Holdings level 4 with piece designation"},
+			}},
+			{Label: "Form of holdings", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Compressed"},
+				{Code: "1", Label: "Uncompressed"},
+				{Code: "2", Label: "Compressed, use textual display"},
+				{Code: "3", Label: "Uncompressed, use textual display"},
+				{Code: "4", Label: "Item(s) not published"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "First level of enumeration"},
+			{Code: "b", Label: "Second level of enumeration"},
+			{Code: "c", Label: "Third level of enumeration"},
+			{Code: "d", Label: "Fourth level of enumeration"},
+			{Code: "e", Label: "Fifth level of enumeration"},
+			{Code: "f", Label: "Sixth level of enumeration"},
+			{Code: "g", Label: "Alternative numbering scheme, first level of enumeration"},
+			{Code: "h", Label: "Alternative numbering scheme, second level of enumeration"},
+			{Code: "i", Label: "First level of chronology"},
+			{Code: "j", Label: "Second level of chronology"},
+			{Code: "k", Label: "Third level of chronology"},
+			{Code: "l", Label: "Fourth level of chronology"},
+			{Code: "m", Label: "Alternative numbering scheme, chronology"},
+			{Code: "n", Label: "Converted Gregorian year"},
+			{Code: "o", Label: "Title of unit", Repeatable: true},
+			{Code: "p", Label: "Piece designation"},
+			{Code: "q", Label: "Piece physical condition"},
+			{Code: "s", Label: "Copyright article-fee code", Repeatable: true},
+			{Code: "t", Label: "Copy number"},
+			{Code: "v", Label: "Issuing date", Repeatable: true},
+			{Code: "w", Label: "Break indicator"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "865", Label: "ENUMERATION AND CHRONOLOGY--INDEXES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Field encoding level", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "3", Label: "Holdings level 3"},
+				{Code: "4", Label: "Holdings level 4"},
+				{Code: "5", Label: "Holdings level 4 with piece designation"},
+			}},
+			{Label: "Form of holdings", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Compressed"},
+				{Code: "1", Label: "Uncompressed"},
+				{Code: "2", Label: "Compressed, use textual display"},
+				{Code: "3", Label: "Uncompressed, use textual display"},
+				{Code: "4", Label: "Item(s) not published"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "First level of enumeration"},
+			{Code: "b", Label: "Second level of enumeration"},
+			{Code: "c", Label: "Third level of enumeration"},
+			{Code: "d", Label: "Fourth level of enumeration"},
+			{Code: "e", Label: "Fifth level of enumeration"},
+			{Code: "f", Label: "Sixth level of enumeration"},
+			{Code: "g", Label: "Alternative numbering scheme, first level of enumeration"},
+			{Code: "h", Label: "Alternative numbering scheme, second level of enumeration"},
+			{Code: "i", Label: "First level of chronology"},
+			{Code: "j", Label: "Second level of chronology"},
+			{Code: "k", Label: "Third level of chronology"},
+			{Code: "l", Label: "Fourth level of chronology"},
+			{Code: "m", Label: "Alternative numbering scheme, chronology"},
+			{Code: "n", Label: "Converted Gregorian year"},
+			{Code: "o", Label: "Title of unit", Repeatable: true},
+			{Code: "p", Label: "Piece designation"},
+			{Code: "q", Label: "Piece physical condition"},
+			{Code: "s", Label: "Copyright article-fee code", Repeatable: true},
+			{Code: "t", Label: "Copy number"},
+			{Code: "v", Label: "Issuing date", Repeatable: true},
+			{Code: "w", Label: "Break indicator"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "866", Label: "TEXTUAL HOLDINGS--BASIC BIBLIOGRAPHIC UNIT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Field encoding level", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "3", Label: "Holdings level 3"},
+				{Code: "4", Label: "Holdings level 4"},
+				{Code: "5", Label: "Holdings level 4 with piece designation"},
+			}},
+			{Label: "Type of notation", Values: []IndicatorValue{
+				{Code: "0", Label: "Non-standard"},
+				{Code: "1", Label: "ANSI/NISO Z39.71 or ISO 10324"},
+				{Code: "2", Label: "ANSI Z39.42"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Textual holdings"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "867", Label: "TEXTUAL HOLDINGS--SUPPLEMENTARY MATERIAL", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Field encoding level", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "3", Label: "Holdings level 3"},
+				{Code: "4", Label: "Holdings level 4"},
+				{Code: "5", Label: "Holdings level 4 with piece designation"},
+			}},
+			{Label: "Type of notation", Values: []IndicatorValue{
+				{Code: "0", Label: "Non-standard"},
+				{Code: "1", Label: "ANSI/NISO Z39.71 or ISO 10324"},
+				{Code: "2", Label: "ANSI Z39.42"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Textual holdings"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "868", Label: "TEXTUAL HOLDINGS--INDEXES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Field encoding level", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "3", Label: "Holdings level 3"},
+				{Code: "4", Label: "Holdings level 4"},
+				{Code: "5", Label: "Holdings level 4 with piece designation"},
+			}},
+			{Label: "Type of notation", Values: []IndicatorValue{
+				{Code: "0", Label: "Non-standard"},
+				{Code: "1", Label: "ANSI/NISO Z39.71 or ISO 10324"},
+				{Code: "2", Label: "ANSI Z39.42"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Textual holdings"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "876", Label: "ITEM INFORMATION--BASIC BIBLIOGRAPHIC UNIT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Internal item number", Repeatable: true},
+			{Code: "b", Label: "Invalid or canceled internal item number", Repeatable: true},
+			{Code: "c", Label: "Cost", Repeatable: true},
+			{Code: "d", Label: "Date acquired", Repeatable: true},
+			{Code: "e", Label: "Source of acquisition", Repeatable: true},
+			{Code: "h", Label: "Use restrictions", Repeatable: true},
+			{Code: "j", Label: "Item status", Repeatable: true},
+			{Code: "l", Label: "Temporary location", Repeatable: true},
+			{Code: "p", Label: "Piece designation", Repeatable: true},
+			{Code: "r", Label: "Invalid or canceled piece designation", Repeatable: true},
+			{Code: "t", Label: "Copy number"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "877", Label: "ITEM INFORMATION--SUPPLEMENTARY MATERIAL", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Internal item number", Repeatable: true},
+			{Code: "b", Label: "Invalid or canceled internal item number", Repeatable: true},
+			{Code: "c", Label: "Cost", Repeatable: true},
+			{Code: "d", Label: "Date acquired", Repeatable: true},
+			{Code: "e", Label: "Source of acquisition", Repeatable: true},
+			{Code: "h", Label: "Use restrictions", Repeatable: true},
+			{Code: "j", Label: "Item status", Repeatable: true},
+			{Code: "l", Label: "Temporary location", Repeatable: true},
+			{Code: "p", Label: "Piece designation", Repeatable: true},
+			{Code: "r", Label: "Invalid or canceled piece designation", Repeatable: true},
+			{Code: "t", Label: "Copy number"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "878", Label: "ITEM INFORMATION--INDEXES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Internal item number", Repeatable: true},
+			{Code: "b", Label: "Invalid or canceled internal item number", Repeatable: true},
+			{Code: "c", Label: "Cost", Repeatable: true},
+			{Code: "d", Label: "Date acquired", Repeatable: true},
+			{Code: "e", Label: "Source of acquisition", Repeatable: true},
+			{Code: "h", Label: "Use restrictions", Repeatable: true},
+			{Code: "j", Label: "Item status", Repeatable: true},
+			{Code: "l", Label: "Temporary location", Repeatable: true},
+			{Code: "p", Label: "Piece designation", Repeatable: true},
+			{Code: "r", Label: "Invalid or canceled piece designation", Repeatable: true},
+			{Code: "t", Label: "Copy number"},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "880", Label: "ALTERNATE GRAPHIC REPRESENTATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Same as associated field"},
+			{Label: "Same as associated field"},
+		}, Subfields: []SubfieldDef{
+			{Code: "6", Label: "Linkage"},
+			{Code: "a", Label: "Same as associated field", Repeatable: true},
+			{Code: "b", Label: "Same as associated field", Repeatable: true},
+			{Code: "c", Label: "Same as associated field", Repeatable: true},
+			{Code: "d", Label: "Same as associated field", Repeatable: true},
+			{Code: "e", Label: "Same as associated field", Repeatable: true},
+			{Code: "f", Label: "Same as associated field", Repeatable: true},
+			{Code: "g", Label: "Same as associated field", Repeatable: true},
+			{Code: "h", Label: "Same as associated field", Repeatable: true},
+			{Code: "i", Label: "Same as associated field", Repeatable: true},
+			{Code: "j", Label: "Same as associated field", Repeatable: true},
+			{Code: "k", Label: "Same as associated field", Repeatable: true},
+			{Code: "l", Label: "Same as associated field", Repeatable: true},
+			{Code: "m", Label: "Same as associated field", Repeatable: true},
+			{Code: "n", Label: "Same as associated field", Repeatable: true},
+			{Code: "o", Label: "Same as associated field", Repeatable: true},
+			{Code: "p", Label: "Same as associated field", Repeatable: true},
+			{Code: "q", Label: "Same as associated field", Repeatable: true},
+			{Code: "r", Label: "Same as associated field", Repeatable: true},
+			{Code: "s", Label: "Same as associated field", Repeatable: true},
+			{Code: "t", Label: "Same as associated field", Repeatable: true},
+			{Code: "u", Label: "Same as associated field", Repeatable: true},
+			{Code: "v", Label: "Same as associated field", Repeatable: true},
+			{Code: "w", Label: "Same as associated field", Repeatable: true},
+			{Code: "x", Label: "Same as associated field", Repeatable: true},
+			{Code: "y", Label: "Same as associated field", Repeatable: true},
+			{Code: "z", Label: "Same as associated field", Repeatable: true},
+			{Code: "0", Label: "Same as associated field", Repeatable: true},
+			{Code: "1", Label: "Same as associated field", Repeatable: true},
+			{Code: "2", Label: "Same as associated field", Repeatable: true},
+			{Code: "3", Label: "Same as associated field", Repeatable: true},
+			{Code: "4", Label: "Same as associated field", Repeatable: true},
+			{Code: "5", Label: "Same as associated field", Repeatable: true},
+			{Code: "7", Label: "Same as associated field", Repeatable: true},
+			{Code: "8", Label: "Same as associated field", Repeatable: true},
+			{Code: "9", Label: "Same as associated field", Repeatable: true},
+		}},
+		{Tag: "883", Label: "METADATA PROVENANCE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Method of assignment", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided/not applicable"},
+				{Code: "0", Label: "Fully machine-generated"},
+				{Code: "1", Label: "Partially machine-generated"},
+				{Code: "2", Label: "Not machine-generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Creation process"},
+			{Code: "c", Label: "Confidence value"},
+			{Code: "d", Label: "Creation date"},
+			{Code: "q", Label: "Assigning or generating agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "w", Label: "Bibliographic record control number", Repeatable: true},
+			{Code: "x", Label: "Validity end date"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "9XX", Label: "LOCAL FIELDS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+	},
+	Authority: {
+		{Tag: "010", Label: "LIBRARY OF CONGRESS CONTROL NUMBER", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "LC control number"},
+			{Code: "z", Label: "Canceled/invalid LC control number", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "014", Label: "LINK TO BIBLIOGRAPHIC RECORD FOR SERIAL OR MULTIPART ITEM", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Control number of related bibliographic record"},
+		}},
+		{Tag: "016", Label: "NATIONAL BIBLIOGRAPHIC AGENCY CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "National bibliographic agency", Values: []IndicatorValue{
+				{Code: " ", Label: "Library and Archives Canada"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Record control number"},
+			{Code: "z", Label: "Canceled or invalid record control number", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "020", Label: "INTERNATIONAL STANDARD BOOK NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "International Standard Book Number"},
+			{Code: "c", Label: "Terms of availability"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid ISBN", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "022", Label: "INTERNATIONAL STANDARD SERIAL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "International Standard Serial Number"},
+			{Code: "l", Label: "ISSN-L"},
+			{Code: "m", Label: "Canceled ISSN-L", Repeatable: true},
+			{Code: "y", Label: "Incorrect ISSN", Repeatable: true},
+			{Code: "z", Label: "Canceled ISSN", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "024", Label: "OTHER STANDARD IDENTIFIER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of standard number or code", Values: []IndicatorValue{
+				{Code: "0", Label: "International Standard Recording Code"},
+				{Code: "1", Label: "Universal Product Code"},
+				{Code: "2", Label: "International Standard Music Number"},
+				{Code: "3", Label: "International Article Number"},
+				{Code: "4", Label: "Serial Item and Contribution Identifier"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+				{Code: "8", Label: "Unspecified type of standard number or code"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Standard number or code"},
+			{Code: "c", Label: "Terms of availability"},
+			{Code: "d", Label: "Additional codes following the standard number or code"},
+			{Code: "q", Label: "Qualifying information", Repeatable: true},
+			{Code: "z", Label: "Canceled/invalid standard number or code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of number or code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "031", Label: "MUSICAL INCIPITS INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Number of work"},
+			{Code: "b", Label: "Number of movement"},
+			{Code: "c", Label: "Number of excerpt"},
+			{Code: "d", Label: "Caption or heading", Repeatable: true},
+			{Code: "e", Label: "Role"},
+			{Code: "g", Label: "Clef"},
+			{Code: "m", Label: "Voice/instrument"},
+			{Code: "n", Label: "Key signature"},
+			{Code: "o", Label: "Time signature"},
+			{Code: "p", Label: "Musical notation"},
+			{Code: "q", Label: "General note", Repeatable: true},
+			{Code: "r", Label: "Key or mode"},
+			{Code: "s", Label: "Coded validity note", Repeatable: true},
+			{Code: "t", Label: "Text incipit", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "y", Label: "Link text", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "System code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "034", Label: "CODED CARTOGRAPHIC MATHEMATICAL DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Type of ring", Values: []IndicatorValue{
+				{Code: " ", Label: "Not applicable"},
+				{Code: "0", Label: "Outer ring"},
+				{Code: "1", Label: "Exclusion ring"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "d", Label: "Coordinates--westernmost longitude"},
+			{Code: "e", Label: "Coordinates--easternmost longitude"},
+			{Code: "f", Label: "Coordinates--northernmost latitude"},
+			{Code: "g", Label: "Coordinates--southernmost latitude"},
+			{Code: "j", Label: "Declination--northern limit"},
+			{Code: "k", Label: "Declination--southern limit"},
+			{Code: "m", Label: "Right ascension--eastern limit"},
+			{Code: "n", Label: "Right ascension--western limit"},
+			{Code: "p", Label: "Equinox"},
+			{Code: "r", Label: "Distance from earth"},
+			{Code: "s", Label: "G-ring latitude", Repeatable: true},
+			{Code: "t", Label: "G-ring longitude", Repeatable: true},
+			{Code: "x", Label: "Beginning date"},
+			{Code: "y", Label: "Ending date"},
+			{Code: "z", Label: "Name of extraterrestrial body"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "035", Label: "SYSTEM CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "System control number"},
+			{Code: "z", Label: "Canceled/invalid control number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "040", Label: "CATALOGING SOURCE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Original cataloging agency"},
+			{Code: "b", Label: "Language of cataloging"},
+			{Code: "c", Label: "Transcribing agency"},
+			{Code: "d", Label: "Modifying agency", Repeatable: true},
+			{Code: "e", Label: "Description conventions", Repeatable: true},
+			{Code: "f", Label: "Subject heading or thesaurus conventions"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "042", Label: "AUTHENTICATION CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Authentication code", Repeatable: true},
+		}},
+		{Tag: "043", Label: "GEOGRAPHIC AREA CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic area code", Repeatable: true},
+			{Code: "b", Label: "Local GAC code", Repeatable: true},
+			{Code: "c", Label: "ISO code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of local code", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "045", Label: "TIME PERIOD OF HEADING", Indicators: [2]IndicatorDef{
+			{Label: "Type of time period in subfield $b or $c", Values: []IndicatorValue{
+				{Code: " ", Label: "Subfield $b or $c not present"},
+				{Code: "0", Label: "Single date/time"},
+				{Code: "1", Label: "Multiple single dates/times"},
+				{Code: "2", Label: "Range of dates/times"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Time period code", Repeatable: true},
+			{Code: "b", Label: "Formatted 9999 B.C. through C.E. time period", Repeatable: true},
+			{Code: "c", Label: "Formatted pre-9999 B.C. time period", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "046", Label: "SPECIAL CODED DATES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "f", Label: "Birth date"},
+			{Code: "g", Label: "Death date"},
+			{Code: "k", Label: "Beginning or single date created"},
+			{Code: "l", Label: "Ending date created"},
+			{Code: "o", Label: "Single or starting date for aggregated content"},
+			{Code: "p", Label: "Ending date for aggregated content"},
+			{Code: "q", Label: "Establishment date"},
+			{Code: "r", Label: "Termination date"},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Source of date scheme"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "050", Label: "LIBRARY OF CONGRESS CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of call number", Values: []IndicatorValue{
+				{Code: "0", Label: "Assigned by LC"},
+				{Code: "4", Label: "Assigned by agency other than LC"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "d", Label: "Volumes/dates to which call number applies"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "052", Label: "GEOGRAPHIC CLASSIFICATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Code source", Values: []IndicatorValue{
+				{Code: " ", Label: "Library of Congress Classification"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic classification area code"},
+			{Code: "b", Label: "Geographic classification subarea code", Repeatable: true},
+			{Code: "d", Label: "Populated place name", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Code source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "053", Label: "LC CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of classification number", Values: []IndicatorValue{
+				{Code: "0", Label: "Assigned by LC"},
+				{Code: "4", Label: "Assigned by agency other than LC"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number element--single number or beginning number of span"},
+			{Code: "b", Label: "Classification number element--ending number of span"},
+			{Code: "c", Label: "Explanatory term"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "055", Label: "LIBRARY AND ARCHIVES CANADA CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of call number", Values: []IndicatorValue{
+				{Code: "0", Label: "Assigned by LAC"},
+				{Code: "4", Label: "Assigned by agency other than LAC"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number"},
+			{Code: "b", Label: "Item number"},
+			{Code: "d", Label: "Volumes/dates to which call number applies"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "060", Label: "NATIONAL LIBRARY OF MEDICINE CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of call number", Values: []IndicatorValue{
+				{Code: "0", Label: "Assigned by NLM"},
+				{Code: "4", Label: "Assigned by agency other than NLM"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number", Repeatable: true},
+			{Code: "b", Label: "Item number"},
+			{Code: "d", Label: "Volumes/dates to which call number applies"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "065", Label: "OTHER CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number element--single number or beginning of span"},
+			{Code: "b", Label: "Classification number element--ending number of span"},
+			{Code: "c", Label: "Explanatory term"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Number source"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "066", Label: "CHARACTER SETS PRESENT", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Primary G0 character set"},
+			{Code: "b", Label: "Primary G1 character set"},
+			{Code: "c", Label: "Alternate G0 or G1 character set", Repeatable: true},
+		}},
+		{Tag: "070", Label: "NATIONAL AGRICULTURAL LIBRARY CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number"},
+			{Code: "b", Label: "Item number"},
+			{Code: "d", Label: "Volumes/dates to which call number applies"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "072", Label: "SUBJECT CATEGORY CODE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Code source", Values: []IndicatorValue{
+				{Code: "0", Label: "NAL subject category code list"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Subject category code"},
+			{Code: "x", Label: "Subject category code subdivision", Repeatable: true},
+			{Code: "2", Label: "Code source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "073", Label: "SUBDIVISION USAGE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Subdivision usage", Repeatable: true},
+			{Code: "z", Label: "Code source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "080", Label: "UNIVERSAL DECIMAL CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of edition", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Full"},
+				{Code: "1", Label: "Abridged"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Universal Decimal Classification number"},
+			{Code: "b", Label: "Item number"},
+			{Code: "x", Label: "Common auxiliary subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Edition identifier"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "082", Label: "DEWEY DECIMAL CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of edition", Values: []IndicatorValue{
+				{Code: "0", Label: "Full edition"},
+				{Code: "1", Label: "Abridged edition"},
+				{Code: "7", Label: "Other edition specified in subfield $2"},
+			}},
+			{Label: "Source of call number", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Assigned by LC"},
+				{Code: "4", Label: "Assigned by agency other than LC"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number"},
+			{Code: "b", Label: "Item number"},
+			{Code: "d", Label: "Volumes/dates to which call number applies"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Edition number"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "083", Label: "DEWEY DECIMAL CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of edition", Values: []IndicatorValue{
+				{Code: "0", Label: "Full edition"},
+				{Code: "1", Label: "Abridged edition"},
+				{Code: "7", Label: "Other edition specified in subfield $2"},
+			}},
+			{Label: "Source of classification number", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Assigned by LC"},
+				{Code: "4", Label: "Assigned by agency other than LC"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number element--single number or beginning number of span"},
+			{Code: "b", Label: "Classification number element--ending number of span"},
+			{Code: "c", Label: "Explanatory term"},
+			{Code: "z", Label: "Table identification"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Edition number"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "086", Label: "GOVERNMENT DOCUMENT CALL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Number source", Values: []IndicatorValue{
+				{Code: " ", Label: "Source specified in subfield $2"},
+				{Code: "0", Label: "Superintendent of Documents Classification System"},
+				{Code: "1", Label: "Government of Canada Publications: Outline of Classification"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Call number"},
+			{Code: "d", Label: "Volumes/dates to which call number applies"},
+			{Code: "z", Label: "Canceled/invalid call number", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Number source"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "087", Label: "GOVERNMENT DOCUMENT CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Number source", Values: []IndicatorValue{
+				{Code: " ", Label: "Source specified in subfield $2"},
+				{Code: "0", Label: "Superintendent of Documents Classification System"},
+				{Code: "1", Label: "Government of Canada Publications: Outline of Classification"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number element--Single number or beginning number of span"},
+			{Code: "b", Label: "Classification number element--Ending number of span"},
+			{Code: "c", Label: "Explanatory information"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Number source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "09X", Label: "LOCAL CALL NUMBERS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "100", Label: "HEADING--PERSONAL NAME", Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Attribution qualifier", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "110", Label: "HEADING--CORPORATE NAME", Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "111", Label: "HEADING--MEETING NAME", Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Relator term", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "130", Label: "HEADING--UNIFORM TITLE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "147", Label: "HEADING--NAMED EVENT", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Named event"},
+			{Code: "c", Label: "Location of named event", Repeatable: true},
+			{Code: "d", Label: "Date of named event"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "148", Label: "HEADING--CHRONOLOGICAL TERM", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Chronological term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "150", Label: "HEADING--TOPICAL TERM", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Topical term or geographic name entry element"},
+			{Code: "b", Label: "Topical term following geographic name entry element", Obsolete: true},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "151", Label: "HEADING--GEOGRAPHIC NAME", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "155", Label: "HEADING--GENRE/FORM TERM", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Genre/form term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "162", Label: "HEADING--MEDIUM OF PERFORMANCE TERM", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Medium of performance term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "180", Label: "HEADING--GENERAL SUBDIVISION", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "181", Label: "HEADING--GEOGRAPHIC SUBDIVISION", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "182", Label: "HEADING--CHRONOLOGICAL SUBDIVISION", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "185", Label: "HEADING--FORM SUBDIVISION", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "260", Label: "COMPLEX SEE REFERENCE--SUBJECT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Heading referred to", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "360", Label: "COMPLEX SEE ALSO REFERENCE--SUBJECT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Heading referred to", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "336", Label: "CONTENT TYPE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Content type term", Repeatable: true},
+			{Code: "b", Label: "Content type code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "368", Label: "OTHER ATTRIBUTES OF PERSON OR CORPORATE BODY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Type of corporate body", Repeatable: true},
+			{Code: "b", Label: "Type of jurisdiction", Repeatable: true},
+			{Code: "c", Label: "Other designation", Repeatable: true},
+			{Code: "d", Label: "Title of person", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "370", Label: "ASSOCIATED PLACE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Place of birth"},
+			{Code: "b", Label: "Place of death"},
+			{Code: "c", Label: "Associated country", Repeatable: true},
+			{Code: "e", Label: "Place of residence/headquarters", Repeatable: true},
+			{Code: "f", Label: "Other associated place", Repeatable: true},
+			{Code: "g", Label: "Place of origin of work or expression", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "371", Label: "ADDRESS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Address", Repeatable: true},
+			{Code: "b", Label: "City"},
+			{Code: "c", Label: "Intermediate jurisdiction"},
+			{Code: "d", Label: "Country"},
+			{Code: "e", Label: "Postal code"},
+			{Code: "m", Label: "Electronic mail address", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "372", Label: "FIELD OF ACTIVITY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Field of activity", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "373", Label: "ASSOCIATED GROUP", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Associated group", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "374", Label: "OCCUPATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Occupation", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "375", Label: "GENDER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Gender", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "376", Label: "FAMILY INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Type of family", Repeatable: true},
+			{Code: "b", Label: "Name of prominent member", Repeatable: true},
+			{Code: "c", Label: "Hereditary title", Repeatable: true},
+			{Code: "s", Label: "Start period"},
+			{Code: "t", Label: "End period"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "377", Label: "ASSOCIATED LANGUAGE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Source of code", Values: []IndicatorValue{
+				{Code: " ", Label: "MARC language code"},
+				{Code: "7", Label: "Source specified in $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Language code", Repeatable: true},
+			{Code: "l", Label: "Language term", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "378", Label: "FULLER FORM OF PERSONAL NAME", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "q", Label: "Fuller form of personal name"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "380", Label: "FORM OF WORK", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Form of work", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "381", Label: "OTHER DISTINGUISHING CHARACTERISTICS OF WORK OR EXPRESSION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Other distinguishing characteristic", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of information", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "382", Label: "MEDIUM OF PERFORMANCE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Medium of performance"},
+				{Code: "1", Label: "Partial medium of performance"},
+			}},
+			{Label: "Access control", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Not intended for access"},
+				{Code: "1", Label: "Intended for access"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Medium of performance", Repeatable: true},
+			{Code: "b", Label: "Soloist", Repeatable: true},
+			{Code: "d", Label: "Doubling instrument", Repeatable: true},
+			{Code: "e", Label: "Number of ensembles of the same type", Repeatable: true},
+			{Code: "n", Label: "Number of performers of the same medium", Repeatable: true},
+			{Code: "p", Label: "Alternative medium of performance", Repeatable: true},
+			{Code: "r", Label: "Total number of individuals performing alongside ensembles"},
+			{Code: "s", Label: "Total number of performers"},
+			{Code: "t", Label: "Total number of ensembles"},
+			{Code: "v", Label: "Note", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "383", Label: "NUMERIC DESIGNATION OF MUSICAL WORK", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Serial number", Repeatable: true},
+			{Code: "b", Label: "Opus number", Repeatable: true},
+			{Code: "c", Label: "Thematic index number", Repeatable: true},
+			{Code: "d", Label: "Thematic index code"},
+			{Code: "e", Label: "Publisher associated with opus number"},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "384", Label: "KEY", Indicators: [2]IndicatorDef{
+			{Label: "Key type", Values: []IndicatorValue{
+				{Code: " ", Label: "Relationship to original unknown"},
+				{Code: "0", Label: "Original key"},
+				{Code: "1", Label: "Transposed key"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Key"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "385", Label: "AUDIENCE CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Audience term", Repeatable: true},
+			{Code: "b", Label: "Audience code", Repeatable: true},
+			{Code: "m", Label: "Demographic group term"},
+			{Code: "n", Label: "Demographic group code"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "386", Label: "CREATOR/CONTRIBUTOR CHARACTERISTICS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Creator/contributor term", Repeatable: true},
+			{Code: "b", Label: "Creator/contributor code", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "m", Label: "Demographic group term"},
+			{Code: "n", Label: "Demographic group code"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "388", Label: "TIME PERIOD OF CREATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of time period", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "1", Label: "Creation of work"},
+				{Code: "2", Label: "Creation of aggregate work"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Time period of creation term", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of term"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "400", Label: "SEE FROM TRACING--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Attribution qualifier", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "410", Label: "SEE FROM TRACING--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "411", Label: "SEE FROM TRACING--MEETING NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Relator term", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "430", Label: "SEE FROM TRACING--UNIFORM TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "447", Label: "SEE FROM TRACING--NAMED EVENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Named event"},
+			{Code: "c", Label: "Location of named event", Repeatable: true},
+			{Code: "d", Label: "Date of named event"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "448", Label: "SEE FROM TRACING--CHRONOLOGICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Chronological term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "450", Label: "SEE FROM TRACING--TOPICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Topical term or geographic name entry element"},
+			{Code: "b", Label: "Topical term following geographic name entry element", Obsolete: true},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "451", Label: "SEE FROM TRACING--GEOGRAPHIC NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "455", Label: "SEE FROM TRACING--GENRE/FORM TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Genre/form term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "462", Label: "SEE FROM TRACING--MEDIUM OF PERFORMANCE TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Medium of performance term"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "480", Label: "SEE FROM TRACING--GENERAL SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "481", Label: "SEE FROM TRACING--GEOGRAPHIC SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "482", Label: "SEE FROM TRACING--CHRONOLOGICAL SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "485", Label: "SEE FROM TRACING--FORM SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "500", Label: "SEE ALSO FROM TRACING--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Attribution qualifier", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "510", Label: "SEE ALSO FROM TRACING--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "511", Label: "SEE ALSO FROM TRACING--MEETING NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Relator term", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "530", Label: "SEE ALSO FROM TRACING--UNIFORM TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "547", Label: "SEE ALSO FROM TRACING--NAMED EVENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Named event"},
+			{Code: "c", Label: "Location of named event", Repeatable: true},
+			{Code: "d", Label: "Date of named event"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "548", Label: "SEE ALSO FROM TRACING--CHRONOLOGICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Chronological term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "550", Label: "SEE ALSO FROM TRACING--TOPICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Topical term or geographic name entry element"},
+			{Code: "b", Label: "Topical term following geographic name entry element", Obsolete: true},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "551", Label: "SEE ALSO FROM TRACING--GEOGRAPHIC NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "555", Label: "SEE ALSO FROM TRACING--GENRE/FORM TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Genre/form term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "562", Label: "SEE ALSO FROM TRACING--MEDIUM OF PERFORMANCE TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Medium of performance term"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "580", Label: "SEE ALSO FROM TRACING--GENERAL SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "581", Label: "SEE ALSO FROM TRACING--GEOGRAPHIC SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "582", Label: "SEE ALSO FROM TRACING--CHRONOLOGICAL SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "585", Label: "SEE ALSO FROM TRACING--FORM SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "640", Label: "SERIES DATES OF PUBLICATION AND/OR SEQUENTIAL DESIGNATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Format of date", Values: []IndicatorValue{
+				{Code: "0", Label: "Formatted style"},
+				{Code: "1", Label: "Unformatted style"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Dates of publication and/or sequential designation"},
+			{Code: "z", Label: "Source of information"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "641", Label: "SERIES NUMBERING PECULIARITIES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Numbering peculiarities note"},
+			{Code: "z", Label: "Source of information"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "642", Label: "SERIES NUMBERING EXAMPLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Series numbering example"},
+			{Code: "d", Label: "Volumes/dates to which series numbering example applies"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "643", Label: "SERIES PLACE AND PUBLISHER/ISSUING BODY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Place", Repeatable: true},
+			{Code: "b", Label: "Publisher/issuing body", Repeatable: true},
+			{Code: "d", Label: "Volumes/dates to which place and publisher/issuing body apply"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "644", Label: "SERIES ANALYSIS PRACTICE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Series analysis practice"},
+			{Code: "b", Label: "Exceptions to analysis practice", Repeatable: true},
+			{Code: "d", Label: "Volumes/dates to which analysis practice applies"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "645", Label: "SERIES TRACING PRACTICE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Series tracing practice"},
+			{Code: "d", Label: "Volumes/dates to which tracing practice applies"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "646", Label: "SERIES CLASSIFICATION PRACTICE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Series classification practice"},
+			{Code: "d", Label: "Volumes/dates to which classification practice applies"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "663", Label: "COMPLEX SEE ALSO REFERENCE--NAME", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Explanatory text", Repeatable: true},
+			{Code: "b", Label: "Heading referred to", Repeatable: true},
+			{Code: "t", Label: "Title referred to", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "664", Label: "COMPLEX SEE REFERENCE--NAME", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Explanatory text", Repeatable: true},
+			{Code: "b", Label: "Heading referred to", Repeatable: true},
+			{Code: "t", Label: "Title referred to", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "665", Label: "HISTORY REFERENCE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "History reference", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "666", Label: "GENERAL EXPLANATORY REFERENCE--NAME", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "General explanatory reference", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "667", Label: "NONPUBLIC GENERAL NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Nonpublic general note"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "670", Label: "SOURCE DATA FOUND", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Source citation"},
+			{Code: "b", Label: "Information found"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "675", Label: "SOURCE DATA NOT FOUND", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Source citation", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "677", Label: "DEFINITION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Definition"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Source of definition", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "678", Label: "BIOGRAPHICAL OR HISTORICAL DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of data", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Biographical sketch"},
+				{Code: "1", Label: "Administrative history"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Biographical or historical data", Repeatable: true},
+			{Code: "b", Label: "Expansion"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "680", Label: "PUBLIC GENERAL NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Heading or subdivision term", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "681", Label: "SUBJECT EXAMPLE TRACING NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Heading or subdivision term", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "682", Label: "DELETED HEADING INFORMATION", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Replacement heading", Repeatable: true},
+			{Code: "i", Label: "Explanatory text"},
+			{Code: "0", Label: "Replacement authority record control number", Repeatable: true},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "688", Label: "APPLICATION HISTORY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Application history note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "700", Label: "ESTABLISHED HEADING LINKING ENTRY--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Attribution qualifier", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "710", Label: "ESTABLISHED HEADING LINKING ENTRY--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "711", Label: "ESTABLISHED HEADING LINKING ENTRY--MEETING NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting", Repeatable: true},
+			{Code: "d", Label: "Date of meeting or treaty signing"},
+			{Code: "e", Label: "Subordinate unit", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "j", Label: "Relator term", Repeatable: true},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "730", Label: "ESTABLISHED HEADING LINKING ENTRY--UNIFORM TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Nonfiling characters", Values: []IndicatorValue{
+				{Code: "0", Label: "Number of nonfiling characters"},
+				{Code: "1", Label: "Number of nonfiling characters"},
+				{Code: "2", Label: "Number of nonfiling characters"},
+				{Code: "3", Label: "Number of nonfiling characters"},
+				{Code: "4", Label: "Number of nonfiling characters"},
+				{Code: "5", Label: "Number of nonfiling characters"},
+				{Code: "6", Label: "Number of nonfiling characters"},
+				{Code: "7", Label: "Number of nonfiling characters"},
+				{Code: "8", Label: "Number of nonfiling characters"},
+				{Code: "9", Label: "Number of nonfiling characters"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "h", Label: "Medium"},
+			{Code: "k", Label: "Form subheading", Repeatable: true},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "m", Label: "Medium of performance for music", Repeatable: true},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "o", Label: "Arranged statement for music"},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "r", Label: "Key for music"},
+			{Code: "s", Label: "Version", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "747", Label: "ESTABLISHED HEADING LINKING ENTRY--NAMED EVENT", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Named event"},
+			{Code: "c", Label: "Location of named event", Repeatable: true},
+			{Code: "d", Label: "Date of named event"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "748", Label: "ESTABLISHED HEADING LINKING ENTRY--CHRONOLOGICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Chronological term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "750", Label: "ESTABLISHED HEADING LINKING ENTRY--TOPICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Topical term or geographic name entry element"},
+			{Code: "b", Label: "Topical term following geographic name entry element", Obsolete: true},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "751", Label: "ESTABLISHED HEADING LINKING ENTRY--GEOGRAPHIC NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name"},
+			{Code: "g", Label: "Miscellaneous information", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "755", Label: "ESTABLISHED HEADING LINKING ENTRY--GENRE/FORM TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Genre/form term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "762", Label: "ESTABLISHED HEADING LINKING ENTRY--MEDIUM OF PERFORMANCE TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Medium of performance term"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "780", Label: "SUBDIVISION LINKING ENTRY--GENERAL SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "781", Label: "SUBDIVISION LINKING ENTRY--GEOGRAPHIC SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "782", Label: "SUBDIVISION LINKING ENTRY--CHRONOLOGICAL SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "785", Label: "SUBDIVISION LINKING ENTRY--FORM SUBDIVISION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings/Name authority file"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings/NLM name authority file"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings/Library and Archives Canada name authority file"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "0", Label: "Record control number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "788", Label: "COMPLEX LINKING ENTRY DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Heading referred to", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "856", Label: "ELECTRONIC LOCATION AND ACCESS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Access method", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Email"},
+				{Code: "1", Label: "FTP"},
+				{Code: "2", Label: "Remote login (Telnet)"},
+				{Code: "3", Label: "Dial-up"},
+				{Code: "4", Label: "HTTP"},
+				{Code: "7", Label: "Method specified in subfield $2"},
+			}},
+			{Label: "Relationship", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Resource"},
+				{Code: "1", Label: "Version of resource"},
+				{Code: "2", Label: "Related resource"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Host name", Repeatable: true},
+			{Code: "c", Label: "Compression information", Repeatable: true},
+			{Code: "d", Label: "Path", Repeatable: true},
+			{Code: "f", Label: "Electronic name", Repeatable: true},
+			{Code: "h", Label: "Processor of request"},
+			{Code: "i", Label: "Instruction", Repeatable: true},
+			{Code: "j", Label: "Bits per second"},
+			{Code: "k", Label: "Password"},
+			{Code: "l", Label: "Logon"},
+			{Code: "m", Label: "Contact for access assistance", Repeatable: true},
+			{Code: "n", Label: "Name of location of host"},
+			{Code: "o", Label: "Operating system"},
+			{Code: "p", Label: "Port"},
+			{Code: "q", Label: "Electronic format type", Repeatable: true},
+			{Code: "r", Label: "Settings"},
+			{Code: "s", Label: "File size", Repeatable: true},
+			{Code: "t", Label: "Terminal emulation", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "v", Label: "Hours access method available", Repeatable: true},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "y", Label: "Link text", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Access method"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Access status"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "880", Label: "ALTERNATE GRAPHIC REPRESENTATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Same as associated field"},
+			{Label: "Same as associated field"},
+		}, Subfields: []SubfieldDef{
+			{Code: "6", Label: "Linkage"},
+			{Code: "a", Label: "Same as associated field", Repeatable: true},
+			{Code: "b", Label: "Same as associated field", Repeatable: true},
+			{Code: "c", Label: "Same as associated field", Repeatable: true},
+			{Code: "d", Label: "Same as associated field", Repeatable: true},
+			{Code: "e", Label: "Same as associated field", Repeatable: true},
+			{Code: "f", Label: "Same as associated field", Repeatable: true},
+			{Code: "g", Label: "Same as associated field", Repeatable: true},
+			{Code: "h", Label: "Same as associated field", Repeatable: true},
+			{Code: "i", Label: "Same as associated field", Repeatable: true},
+			{Code: "j", Label: "Same as associated field", Repeatable: true},
+			{Code: "k", Label: "Same as associated field", Repeatable: true},
+			{Code: "l", Label: "Same as associated field", Repeatable: true},
+			{Code: "m", Label: "Same as associated field", Repeatable: true},
+			{Code: "n", Label: "Same as associated field", Repeatable: true},
+			{Code: "o", Label: "Same as associated field", Repeatable: true},
+			{Code: "p", Label: "Same as associated field", Repeatable: true},
+			{Code: "q", Label: "Same as associated field", Repeatable: true},
+			{Code: "r", Label: "Same as associated field", Repeatable: true},
+			{Code: "s", Label: "Same as associated field", Repeatable: true},
+			{Code: "t", Label: "Same as associated field", Repeatable: true},
+			{Code: "u", Label: "Same as associated field", Repeatable: true},
+			{Code: "v", Label: "Same as associated field", Repeatable: true},
+			{Code: "w", Label: "Same as associated field", Repeatable: true},
+			{Code: "x", Label: "Same as associated field", Repeatable: true},
+			{Code: "y", Label: "Same as associated field", Repeatable: true},
+			{Code: "z", Label: "Same as associated field", Repeatable: true},
+			{Code: "0", Label: "Same as associated field", Repeatable: true},
+			{Code: "1", Label: "Same as associated field", Repeatable: true},
+			{Code: "2", Label: "Same as associated field", Repeatable: true},
+			{Code: "3", Label: "Same as associated field", Repeatable: true},
+			{Code: "4", Label: "Same as associated field", Repeatable: true},
+			{Code: "5", Label: "Same as associated field", Repeatable: true},
+			{Code: "7", Label: "Same as associated field", Repeatable: true},
+			{Code: "8", Label: "Same as associated field", Repeatable: true},
+			{Code: "9", Label: "Same as associated field", Repeatable: true},
+		}},
+		{Tag: "883", Label: "METADATA PROVENANCE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Method of assignment", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided/not applicable"},
+				{Code: "0", Label: "Fully machine-generated"},
+				{Code: "1", Label: "Partially machine-generated"},
+				{Code: "2", Label: "Not machine-generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Creation process"},
+			{Code: "c", Label: "Confidence value"},
+			{Code: "d", Label: "Creation date"},
+			{Code: "q", Label: "Assigning or generating agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "w", Label: "Bibliographic record control number", Repeatable: true},
+			{Code: "x", Label: "Validity end date"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "884", Label: "DESCRIPTION CONVERSION INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Conversion process"},
+			{Code: "g", Label: "Conversion date"},
+			{Code: "k", Label: "Identifier of source metadata"},
+			{Code: "q", Label: "Conversion agency"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+		}},
+		{Tag: "885", Label: "MATCHING INFORMATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Matching information"},
+			{Code: "b", Label: "Status of matching and its checking"},
+			{Code: "c", Label: "Confidence value"},
+			{Code: "d", Label: "Generation date"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "5", Label: "Institution to which field applies"},
+		}},
+		{Tag: "9XX", Label: "LOCAL FIELDS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+	},
+	Classification: {
+		{Tag: "010", Label: "LIBRARY OF CONGRESS CONTROL NUMBER", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "LC control number"},
+			{Code: "z", Label: "Canceled/invalid LC control number", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "016", Label: "NATIONAL BIBLIOGRAPHIC AGENCY CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "National bibliographic agency", Values: []IndicatorValue{
+				{Code: " ", Label: "Library and Archives Canada"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Record control number"},
+			{Code: "z", Label: "Canceled/invalid record control number", Repeatable: true},
+			{Code: "2", Label: "Source"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "024", Label: "OTHER STANDARD IDENTIFIER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of standard number or code", Values: []IndicatorValue{
+				{Code: "7", Label: "Source specified in subfield $2"},
+				{Code: "8", Label: "Unspecified type of standard number or code"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Standard number or code"},
+			{Code: "c", Label: "Terms of availability"},
+			{Code: "d", Label: "Additional codes following the standard number or code"},
+			{Code: "z", Label: "Canceled/invalid standard number or code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of number or code"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "035", Label: "SYSTEM CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "System control number"},
+			{Code: "z", Label: "Canceled/invalid control number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "040", Label: "CATALOGING SOURCE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Original cataloging agency"},
+			{Code: "b", Label: "Language of cataloging"},
+			{Code: "c", Label: "Transcribing agency"},
+			{Code: "d", Label: "Modifying agency", Repeatable: true},
+			{Code: "e", Label: "Description conventions", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "042", Label: "AUTHENTICATION CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Authentication code", Repeatable: true},
+		}},
+		{Tag: "043", Label: "GEOGRAPHIC AREA CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic area code", Repeatable: true},
+			{Code: "b", Label: "Local GAC code", Repeatable: true},
+			{Code: "c", Label: "ISO code", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of local code", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "045", Label: "TIME PERIOD OF CONTENT", Indicators: [2]IndicatorDef{
+			{Label: "Type of time period in subfield $b or $c", Values: []IndicatorValue{
+				{Code: " ", Label: "Subfield $b or $c not present"},
+				{Code: "0", Label: "Single date/time"},
+				{Code: "1", Label: "Multiple single dates/times"},
+				{Code: "2", Label: "Range of dates/times"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Time period code", Repeatable: true},
+			{Code: "b", Label: "Formatted 9999 B.C. through C.E. time period", Repeatable: true},
+			{Code: "c", Label: "Formatted pre-9999 B.C. time period", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "052", Label: "GEOGRAPHIC CLASSIFICATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Code source", Values: []IndicatorValue{
+				{Code: " ", Label: "Library of Congress Classification"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic classification area code"},
+			{Code: "b", Label: "Geographic classification subarea code", Repeatable: true},
+			{Code: "d", Label: "Populated place name", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Code source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "053", Label: "LC CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span"},
+			{Code: "b", Label: "Classification number--ending number of span"},
+			{Code: "c", Label: "Explanatory term"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "083", Label: "DEWEY DECIMAL CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span"},
+			{Code: "b", Label: "Classification number--ending number of span"},
+			{Code: "c", Label: "Explanatory term"},
+			{Code: "z", Label: "Table identification"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Edition number"},
+			{Code: "5", Label: "Institution to which field applies"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "084", Label: "CLASSIFICATION SCHEME AND EDITION", Indicators: [2]IndicatorDef{
+			{Label: "Type of edition", Values: []IndicatorValue{
+				{Code: "0", Label: "Full"},
+				{Code: "1", Label: "Abridged"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification scheme code"},
+			{Code: "b", Label: "Edition title"},
+			{Code: "c", Label: "Edition identifier"},
+			{Code: "d", Label: "Source edition"},
+			{Code: "e", Label: "Language code"},
+			{Code: "f", Label: "Authorization"},
+			{Code: "n", Label: "Variations"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "086", Label: "GOVERNMENT DOCUMENT CLASSIFICATION NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Number source", Values: []IndicatorValue{
+				{Code: " ", Label: "Source specified in subfield $2"},
+				{Code: "0", Label: "Superintendent of Documents Classification System"},
+				{Code: "1", Label: "Government of Canada Publications: Outline of Classification"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span"},
+			{Code: "b", Label: "Classification number--ending number of span"},
+			{Code: "c", Label: "Explanatory term"},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Number source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "09X", Label: "LOCAL CALL NUMBERS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "153", Label: "CLASSIFICATION NUMBER", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span"},
+			{Code: "b", Label: "Classification number--ending number of span"},
+			{Code: "c", Label: "Internal subarrangement or add table"},
+			{Code: "h", Label: "Caption hierarchy", Repeatable: true},
+			{Code: "j", Label: "Caption"},
+			{Code: "k", Label: "Summary number span"},
+			{Code: "t", Label: "Table identification--schedule"},
+			{Code: "z", Label: "Table identification"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "154", Label: "GENERAL EXPLANATORY INDEX TERM", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "General explanatory index term"},
+			{Code: "b", Label: "General explanatory index term--succeeding level", Repeatable: true},
+			{Code: "f", Label: "Title referred to", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "253", Label: "COMPLEX SEE REFERENCE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of complex see reference", Values: []IndicatorValue{
+				{Code: "0", Label: "Explanatory see reference"},
+				{Code: "1", Label: "Explanatory classification see reference"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number referred to--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number referred to--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "y", Label: "Table sequence number", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "353", Label: "COMPLEX SEE ALSO REFERENCE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number referred to--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number referred to--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "y", Label: "Table sequence number", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "453", Label: "INVALID NUMBER TRACING", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Internal subarrangement or add table", Repeatable: true},
+			{Code: "h", Label: "Caption hierarchy", Repeatable: true},
+			{Code: "i", Label: "Explanatory text"},
+			{Code: "j", Label: "Caption"},
+			{Code: "k", Label: "Summary number span"},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "553", Label: "VALID NUMBER TRACING", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Internal subarrangement or add table", Repeatable: true},
+			{Code: "h", Label: "Caption hierarchy", Repeatable: true},
+			{Code: "i", Label: "Explanatory text"},
+			{Code: "j", Label: "Caption"},
+			{Code: "k", Label: "Summary number span"},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Data provenance", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "680", Label: "SCOPE NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "683", Label: "APPLICATION INSTRUCTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of note", Values: []IndicatorValue{
+				{Code: "0", Label: "Add note"},
+				{Code: "1", Label: "General note"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Classification number--internal subarrangement or add table", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "684", Label: "AUXILIARY INSTRUCTION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Classification number--internal subarrangement or add table", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "j", Label: "Caption", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "685", Label: "HISTORY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Type of history note", Values: []IndicatorValue{
+				{Code: "0", Label: "Main number"},
+				{Code: "1", Label: "Secondary number"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "d", Label: "Date"},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "w", Label: "Control subfield"},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "2", Label: "Number source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "686", Label: "TABLE IDENTIFICATION--SCHEDULE", Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Table identification"},
+		}},
+		{Tag: "700", Label: "INDEX TERM--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "710", Label: "INDEX TERM--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "c", Label: "Location of meeting"},
+			{Code: "d", Label: "Date of meeting or treaty signing", Repeatable: true},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "711", Label: "INDEX TERM--MEETING NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of meeting name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Meeting name or jurisdiction name as entry element"},
+			{Code: "c", Label: "Location of meeting"},
+			{Code: "d", Label: "Date of meeting"},
+			{Code: "n", Label: "Number of part/section/meeting", Repeatable: true},
+			{Code: "q", Label: "Name of meeting following jurisdiction name entry element"},
+			{Code: "e", Label: "Relator term", Repeatable: true},
+			{Code: "t", Label: "Title of a work"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "730", Label: "INDEX TERM--UNIFORM TITLE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uniform title"},
+			{Code: "d", Label: "Date of treaty signing", Repeatable: true},
+			{Code: "f", Label: "Date of a work"},
+			{Code: "l", Label: "Language of a work"},
+			{Code: "n", Label: "Number of part/section of a work", Repeatable: true},
+			{Code: "p", Label: "Name of part/section of a work", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "748", Label: "INDEX TERM--CHRONOLOGICAL", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Chronological term"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "750", Label: "INDEX TERM--TOPICAL", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Level of index term", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No level specified"},
+				{Code: "1", Label: "Primary"},
+				{Code: "2", Label: "Secondary"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Topical term or geographic name as entry element"},
+			{Code: "b", Label: "Topical term following geographic name as entry element"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "751", Label: "INDEX TERM--GEOGRAPHIC NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "753", Label: "INDEX TERM--UNCONTROLLED", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Level of index term", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "No level specified"},
+				{Code: "1", Label: "Primary"},
+				{Code: "2", Label: "Secondary"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uncontrolled term", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "754", Label: "INDEX TERM--SYNTHESIZED NUMBER COMPONENTS", Repeatable: true, Obsolete: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Component", Repeatable: true},
+		}},
+		{Tag: "761", Label: "ADD OR DIVIDE LIKE INSTRUCTIONS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of add or divide like instruction", Values: []IndicatorValue{
+				{Code: "0", Label: "Add or divide like"},
+				{Code: "1", Label: "Divide like"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Classification number--internal subarrangement or add table", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "762", Label: "TABLE IDENTIFICATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Classification number--internal subarrangement or add table", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "763", Label: "INTERNAL SUBARRANGEMENT OR ADD TABLE ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Classification number--internal subarrangement or add table", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "765", Label: "SYNTHESIZED NUMBER COMPONENTS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of number", Values: []IndicatorValue{
+				{Code: "0", Label: "Schedule number"},
+				{Code: "1", Label: "External table number"},
+				{Code: "2", Label: "Internal table number"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Number where instructions are found--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Base number", Repeatable: true},
+			{Code: "c", Label: "Number where instructions are found--ending number of span", Repeatable: true},
+			{Code: "f", Label: "Facet designator", Repeatable: true},
+			{Code: "r", Label: "Root number", Repeatable: true},
+			{Code: "s", Label: "Digits added from classification number in schedule or external table", Repeatable: true},
+			{Code: "t", Label: "Digits added from internal subarrangement or add table", Repeatable: true},
+			{Code: "u", Label: "Number being analyzed", Repeatable: true},
+			{Code: "v", Label: "Number in internal subarrangement or add table where instructions are found", Repeatable: true},
+			{Code: "w", Label: "Table identification--Internal subarrangement or add table", Repeatable: true},
+			{Code: "y", Label: "Table sequence number for internal subarrangement or add table", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "0", Label: "Authority record control number or standard number", Repeatable: true},
+			{Code: "1", Label: "Real World Object URI", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "766", Label: "SECONDARY TABLE INFORMATION", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Secondary table identification"},
+			{Code: "b", Label: "Table sequence number"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "768", Label: "CITATION AND PRECEDENCE ORDER INSTRUCTIONS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Classification number--single number or beginning number of span", Repeatable: true},
+			{Code: "b", Label: "Classification number--ending number of span", Repeatable: true},
+			{Code: "c", Label: "Classification number--internal subarrangement or add table", Repeatable: true},
+			{Code: "i", Label: "Explanatory text", Repeatable: true},
+			{Code: "t", Label: "Table identification--schedule", Repeatable: true},
+			{Code: "z", Label: "Table identification", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "856", Label: "ELECTRONIC LOCATION AND ACCESS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Access method", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Email"},
+				{Code: "1", Label: "FTP"},
+				{Code: "2", Label: "Remote login (Telnet)"},
+				{Code: "3", Label: "Dial-up"},
+				{Code: "4", Label: "HTTP"},
+				{Code: "7", Label: "Method specified in subfield $2"},
+			}},
+			{Label: "Relationship", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Resource"},
+				{Code: "1", Label: "Version of resource"},
+				{Code: "2", Label: "Related resource"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Host name", Repeatable: true},
+			{Code: "d", Label: "Path", Repeatable: true},
+			{Code: "f", Label: "Electronic name", Repeatable: true},
+			{Code: "q", Label: "Electronic format type", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "y", Label: "Link text", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Access method"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Access status"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "880", Label: "ALTERNATE GRAPHIC REPRESENTATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Same as associated field"},
+			{Label: "Same as associated field"},
+		}, Subfields: []SubfieldDef{
+			{Code: "6", Label: "Linkage"},
+			{Code: "a", Label: "Same as associated field", Repeatable: true},
+			{Code: "b", Label: "Same as associated field", Repeatable: true},
+			{Code: "c", Label: "Same as associated field", Repeatable: true},
+			{Code: "d", Label: "Same as associated field", Repeatable: true},
+			{Code: "e", Label: "Same as associated field", Repeatable: true},
+			{Code: "f", Label: "Same as associated field", Repeatable: true},
+			{Code: "g", Label: "Same as associated field", Repeatable: true},
+			{Code: "h", Label: "Same as associated field", Repeatable: true},
+			{Code: "i", Label: "Same as associated field", Repeatable: true},
+			{Code: "j", Label: "Same as associated field", Repeatable: true},
+			{Code: "k", Label: "Same as associated field", Repeatable: true},
+			{Code: "l", Label: "Same as associated field", Repeatable: true},
+			{Code: "m", Label: "Same as associated field", Repeatable: true},
+			{Code: "n", Label: "Same as associated field", Repeatable: true},
+			{Code: "o", Label: "Same as associated field", Repeatable: true},
+			{Code: "p", Label: "Same as associated field", Repeatable: true},
+			{Code: "q", Label: "Same as associated field", Repeatable: true},
+			{Code: "r", Label: "Same as associated field", Repeatable: true},
+			{Code: "s", Label: "Same as associated field", Repeatable: true},
+			{Code: "t", Label: "Same as associated field", Repeatable: true},
+			{Code: "u", Label: "Same as associated field", Repeatable: true},
+			{Code: "v", Label: "Same as associated field", Repeatable: true},
+			{Code: "w", Label: "Same as associated field", Repeatable: true},
+			{Code: "x", Label: "Same as associated field", Repeatable: true},
+			{Code: "y", Label: "Same as associated field", Repeatable: true},
+			{Code: "z", Label: "Same as associated field", Repeatable: true},
+			{Code: "0", Label: "Same as associated field", Repeatable: true},
+			{Code: "1", Label: "Same as associated field", Repeatable: true},
+			{Code: "2", Label: "Same as associated field", Repeatable: true},
+			{Code: "3", Label: "Same as associated field", Repeatable: true},
+			{Code: "4", Label: "Same as associated field", Repeatable: true},
+			{Code: "5", Label: "Same as associated field", Repeatable: true},
+			{Code: "7", Label: "Same as associated field", Repeatable: true},
+			{Code: "8", Label: "Same as associated field", Repeatable: true},
+			{Code: "9", Label: "Same as associated field", Repeatable: true},
+		}},
+		{Tag: "9XX", Label: "LOCAL FIELDS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+	},
+	Community: {
+		{Tag: "010", Label: "LIBRARY OF CONGRESS CONTROL NUMBER", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "LC control number"},
+			{Code: "z", Label: "Canceled/invalid LC control number", Repeatable: true},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "035", Label: "SYSTEM CONTROL NUMBER", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "System control number"},
+			{Code: "z", Label: "Canceled/invalid control number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "040", Label: "CATALOGING SOURCE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Original cataloging agency"},
+			{Code: "b", Label: "Language of cataloging"},
+			{Code: "c", Label: "Transcribing agency"},
+			{Code: "d", Label: "Modifying agency", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "043", Label: "GEOGRAPHIC AREA CODE", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic area code", Repeatable: true},
+			{Code: "b", Label: "Local GAC code", Repeatable: true},
+			{Code: "c", Label: "ISO code", Repeatable: true},
+			{Code: "2", Label: "Source of local code", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "052", Label: "GEOGRAPHIC CLASSIFICATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Code source", Values: []IndicatorValue{
+				{Code: " ", Label: "Library of Congress Classification"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic classification area code"},
+			{Code: "b", Label: "Geographic classification subarea code", Repeatable: true},
+			{Code: "d", Label: "Populated place name", Repeatable: true},
+			{Code: "2", Label: "Code source"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "09X", Label: "LOCAL CALL NUMBERS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "245", Label: "TITLE STATEMENT", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Title"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "270", Label: "ADDRESS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Level", Values: []IndicatorValue{
+				{Code: " ", Label: "No level specified"},
+				{Code: "1", Label: "Primary"},
+				{Code: "2", Label: "Secondary"},
+			}},
+			{Label: "Type of address", Values: []IndicatorValue{
+				{Code: " ", Label: "No type specified"},
+				{Code: "0", Label: "Mailing"},
+				{Code: "7", Label: "Type specified in subfield $i"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Address", Repeatable: true},
+			{Code: "b", Label: "City"},
+			{Code: "c", Label: "State or province"},
+			{Code: "d", Label: "Country"},
+			{Code: "e", Label: "Postal code"},
+			{Code: "f", Label: "Terms preceding attention name"},
+			{Code: "g", Label: "Attention name"},
+			{Code: "h", Label: "Attention position"},
+			{Code: "i", Label: "Type of address"},
+			{Code: "j", Label: "Specialized telephone number", Repeatable: true},
+			{Code: "k", Label: "Telephone number", Repeatable: true},
+			{Code: "l", Label: "Fax number", Repeatable: true},
+			{Code: "m", Label: "Electronic mail address", Repeatable: true},
+			{Code: "n", Label: "TDD or TTY number", Repeatable: true},
+			{Code: "p", Label: "Contact person", Repeatable: true},
+			{Code: "q", Label: "Title of contact person", Repeatable: true},
+			{Code: "r", Label: "Hours", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "300", Label: "PHYSICAL DESCRIPTION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Extent", Repeatable: true},
+			{Code: "b", Label: "Other physical details"},
+			{Code: "c", Label: "Dimensions", Repeatable: true},
+			{Code: "e", Label: "Accompanying material"},
+			{Code: "f", Label: "Type of unit", Repeatable: true},
+			{Code: "g", Label: "Size of unit", Repeatable: true},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "307", Label: "HOURS, ETC.", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Display constant controller", Values: []IndicatorValue{
+				{Code: " ", Label: "Hours"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Hours"},
+			{Code: "b", Label: "Additional information"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "310", Label: "PROGRAM OR SERVICE FREQUENCY", Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Program or service frequency"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "500", Label: "GENERAL NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "General note"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "501", Label: "LANGUAGE NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Language note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "505", Label: "CONTENTS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Contents note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "506", Label: "RESTRICTIONS ON ACCESS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Restrictions on access"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "510", Label: "BIBLIOGRAPHIC INFORMATION NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Bibliographic information"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "520", Label: "SUMMARY, ETC. NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Summary, etc. note"},
+			{Code: "b", Label: "Expansion of summary note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "521", Label: "TARGET AUDIENCE NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Target audience note", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "531", Label: "ELIGIBILITY, FEES, AND PROCEDURES NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Eligibility, fees, and procedures note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "545", Label: "BIOGRAPHICAL OR HISTORICAL DATA", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of data", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Biographical sketch"},
+				{Code: "1", Label: "Administrative history"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Biographical or historical data"},
+			{Code: "b", Label: "Expansion"},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "562", Label: "IDENTIFICATION OF COPY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Identification of copy note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "565", Label: "CASE FILE CHARACTERISTICS NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Case file characteristics"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "567", Label: "METHODOLOGY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Methodology note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "580", Label: "LINKING ENTRY COMPLEXITY NOTE", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Linking entry complexity note"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "59X", Label: "LOCAL NOTES", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "600", Label: "SUBJECT ADDED ENTRY--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "610", Label: "SUBJECT ADDED ENTRY--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "650", Label: "SUBJECT ADDED ENTRY--TOPICAL TERM", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Topical term or geographic name as entry element"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "651", Label: "SUBJECT ADDED ENTRY--GEOGRAPHIC NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Thesaurus", Values: []IndicatorValue{
+				{Code: "0", Label: "Library of Congress Subject Headings"},
+				{Code: "1", Label: "Library of Congress Children's and Young Adults' Subject Headings"},
+				{Code: "2", Label: "Medical Subject Headings"},
+				{Code: "3", Label: "National Agricultural Library subject authority file"},
+				{Code: "4", Label: "Source not specified"},
+				{Code: "5", Label: "Canadian Subject Headings"},
+				{Code: "6", Label: "Répertoire de vedettes-matière"},
+				{Code: "7", Label: "Source specified in subfield $2"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Geographic name"},
+			{Code: "v", Label: "Form subdivision", Repeatable: true},
+			{Code: "x", Label: "General subdivision", Repeatable: true},
+			{Code: "y", Label: "Chronological subdivision", Repeatable: true},
+			{Code: "z", Label: "Geographic subdivision", Repeatable: true},
+			{Code: "2", Label: "Source of heading or term"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "653", Label: "INDEX TERM--UNCONTROLLED", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Uncontrolled term", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "69X", Label: "LOCAL SUBJECT ACCESS FIELDS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+		{Tag: "700", Label: "ADDED ENTRY--PERSONAL NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of personal name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Forename"},
+				{Code: "1", Label: "Surname"},
+				{Code: "3", Label: "Family name"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Personal name"},
+			{Code: "b", Label: "Numeration"},
+			{Code: "c", Label: "Titles and other words associated with a name", Repeatable: true},
+			{Code: "d", Label: "Dates associated with a name"},
+			{Code: "q", Label: "Fuller form of name"},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "710", Label: "ADDED ENTRY--CORPORATE NAME", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Type of corporate name entry element", Values: []IndicatorValue{
+				{Code: "0", Label: "Inverted name"},
+				{Code: "1", Label: "Jurisdiction name"},
+				{Code: "2", Label: "Name in direct order"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Corporate name or jurisdiction name as entry element"},
+			{Code: "b", Label: "Subordinate unit", Repeatable: true},
+			{Code: "4", Label: "Relationship", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "773", Label: "HOST ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "t", Label: "Title"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "787", Label: "NONSPECIFIC RELATIONSHIP ENTRY", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Note controller", Values: []IndicatorValue{
+				{Code: "0", Label: "Display note"},
+				{Code: "1", Label: "Do not display note"},
+			}},
+			{Label: "Undefined", Values: []IndicatorValue{
+				{Code: " ", Label: "Undefined"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Main entry heading"},
+			{Code: "i", Label: "Relationship information", Repeatable: true},
+			{Code: "t", Label: "Title"},
+			{Code: "w", Label: "Record control number", Repeatable: true},
+			{Code: "6", Label: "Linkage"},
+			{Code: "7", Label: "Control subfield"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "856", Label: "ELECTRONIC LOCATION AND ACCESS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Access method", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Email"},
+				{Code: "1", Label: "FTP"},
+				{Code: "2", Label: "Remote login (Telnet)"},
+				{Code: "3", Label: "Dial-up"},
+				{Code: "4", Label: "HTTP"},
+				{Code: "7", Label: "Method specified in subfield $2"},
+			}},
+			{Label: "Relationship", Values: []IndicatorValue{
+				{Code: " ", Label: "No information provided"},
+				{Code: "0", Label: "Resource"},
+				{Code: "1", Label: "Version of resource"},
+				{Code: "2", Label: "Related resource"},
+				{Code: "8", Label: "No display constant generated"},
+			}},
+		}, Subfields: []SubfieldDef{
+			{Code: "a", Label: "Host name", Repeatable: true},
+			{Code: "d", Label: "Path", Repeatable: true},
+			{Code: "f", Label: "Electronic name", Repeatable: true},
+			{Code: "q", Label: "Electronic format type", Repeatable: true},
+			{Code: "u", Label: "Uniform Resource Identifier", Repeatable: true},
+			{Code: "x", Label: "Nonpublic note", Repeatable: true},
+			{Code: "y", Label: "Link text", Repeatable: true},
+			{Code: "z", Label: "Public note", Repeatable: true},
+			{Code: "2", Label: "Access method"},
+			{Code: "3", Label: "Materials specified"},
+			{Code: "6", Label: "Linkage"},
+			{Code: "8", Label: "Field link and sequence number", Repeatable: true},
+		}},
+		{Tag: "880", Label: "ALTERNATE GRAPHIC REPRESENTATION", Repeatable: true, Indicators: [2]IndicatorDef{
+			{Label: "Same as associated field"},
+			{Label: "Same as associated field"},
+		}, Subfields: []SubfieldDef{
+			{Code: "6", Label: "Linkage"},
+			{Code: "a", Label: "Same as associated field", Repeatable: true},
+			{Code: "b", Label: "Same as associated field", Repeatable: true},
+			{Code: "c", Label: "Same as associated field", Repeatable: true},
+			{Code: "d", Label: "Same as associated field", Repeatable: true},
+			{Code: "e", Label: "Same as associated field", Repeatable: true},
+			{Code: "f", Label: "Same as associated field", Repeatable: true},
+			{Code: "g", Label: "Same as associated field", Repeatable: true},
+			{Code: "h", Label: "Same as associated field", Repeatable: true},
+			{Code: "i", Label: "Same as associated field", Repeatable: true},
+			{Code: "j", Label: "Same as associated field", Repeatable: true},
+			{Code: "k", Label: "Same as associated field", Repeatable: true},
+			{Code: "l", Label: "Same as associated field", Repeatable: true},
+			{Code: "m", Label: "Same as associated field", Repeatable: true},
+			{Code: "n", Label: "Same as associated field", Repeatable: true},
+			{Code: "o", Label: "Same as associated field", Repeatable: true},
+			{Code: "p", Label: "Same as associated field", Repeatable: true},
+			{Code: "q", Label: "Same as associated field", Repeatable: true},
+			{Code: "r", Label: "Same as associated field", Repeatable: true},
+			{Code: "s", Label: "Same as associated field", Repeatable: true},
+			{Code: "t", Label: "Same as associated field", Repeatable: true},
+			{Code: "u", Label: "Same as associated field", Repeatable: true},
+			{Code: "v", Label: "Same as associated field", Repeatable: true},
+			{Code: "w", Label: "Same as associated field", Repeatable: true},
+			{Code: "x", Label: "Same as associated field", Repeatable: true},
+			{Code: "y", Label: "Same as associated field", Repeatable: true},
+			{Code: "z", Label: "Same as associated field", Repeatable: true},
+			{Code: "0", Label: "Same as associated field", Repeatable: true},
+			{Code: "1", Label: "Same as associated field", Repeatable: true},
+			{Code: "2", Label: "Same as associated field", Repeatable: true},
+			{Code: "3", Label: "Same as associated field", Repeatable: true},
+			{Code: "4", Label: "Same as associated field", Repeatable: true},
+			{Code: "5", Label: "Same as associated field", Repeatable: true},
+			{Code: "7", Label: "Same as associated field", Repeatable: true},
+			{Code: "8", Label: "Same as associated field", Repeatable: true},
+			{Code: "9", Label: "Same as associated field", Repeatable: true},
+		}},
+		{Tag: "9XX", Label: "LOCAL FIELDS", Repeatable: true, Indicators: [2]IndicatorDef{
+			{},
+			{},
+		}},
+	},
+}
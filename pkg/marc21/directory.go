@@ -65,23 +65,54 @@ http://www.loc.gov/marc/bibliographic/bddirectory.html
 */
 
 // parseDirectory extracts the directory from the raw MARC record bytes
-func parseDirectory(r []byte) (dir []*directoryEntry, err error) {
+func parseDirectory(r []byte, ps *parseState) (dir []*directoryEntry, err error) {
+
+	for i := leaderLen; i < len(r) && r[i] != fieldTerminator; i += 12 {
+
+		if i+12 >= len(r) {
+			if !ps.lenient() {
+				return nil, newParseError(i, "", ErrBadDirectory)
+			}
+			ps.warn(ErrBadDirectory, "", len(dir), i, "directory is not terminated")
+			return dir, nil
+		}
 
-	for i := leaderLen; r[i] != fieldTerminator; i += 12 {
 		var de directoryEntry
 
 		de.tag = string(r[i : i+3])
 		de.fieldLength, err = toInt(r[i+3 : i+7])
 		if err != nil {
-			return nil, err
+			err = newParseError(i+3, de.tag, err)
+		} else {
+			de.startingPos, err = toInt(r[i+7 : i+12])
+			if err != nil {
+				err = newParseError(i+7, de.tag, err)
+			}
 		}
 
-		de.startingPos, err = toInt(r[i+7 : i+12])
 		if err != nil {
-			return nil, err
+			if !ps.lenient() {
+				return nil, err
+			}
+			ps.warn(ErrNotInteger, de.tag, len(dir), i, "invalid directory entry %q, field skipped", r[i:i+12])
+			de.invalid = true
 		}
 
 		dir = append(dir, &de)
 	}
+
 	return dir, nil
 }
+
+// fieldBytes returns the bytes for the field identified by the
+// directory entry, ensuring that the field lies within the record
+func fieldBytes(rawRec []byte, baseAddress int, de *directoryEntry) (b []byte, ok bool) {
+
+	start := baseAddress + de.startingPos
+	end := start + de.fieldLength
+	if end > len(rawRec) {
+		return nil, false
+	}
+
+	return rawRec[start:end], true
+}
@@ -0,0 +1,24 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+/*
+https://www.loc.gov/marc/specifications/speccharcjk.html
+https://lcweb2.loc.gov/diglib/codetables/eacc2uni.txt
+
+    The East Asian Character Code (EACC) set contains some 16,000
+    ideographs, kana, hangul and punctuation. Each character is three
+    bytes and is designated with ESC $ 1.
+
+    The lookup table is too large to maintain by hand so it is generated
+    from the LoC code tables (saved locally, see codegen/README.md).
+*/
+
+//go:generate go run ../../codegen/gen-eacc.go -i ../../codegen/input/codetables.xml -o eacctable.go
+
+// eaccToUCS maps the three byte EACC codes to their Unicode code
+// points. It is populated by the generated eacctable.go; without it
+// any EACC characters are decoded as U+FFFD and the EACC tests fail.
+var eaccToUCS = map[int]rune{}
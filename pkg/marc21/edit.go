@@ -0,0 +1,289 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"strings"
+)
+
+// MatchTag indicates whether a tag matches a tag pattern. A pattern is
+// a comma separated list of tags in which an "X" (or "x") matches any
+// character, so "9XX" matches all of the 9XX (local) fields and
+// "1XX,7XX" matches all of the main and added entry fields.
+func MatchTag(pattern, tag string) bool {
+	for _, p := range strings.Split(pattern, ",") {
+		if matchTag(p, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchTag indicates whether a tag matches a single tag pattern
+func matchTag(p, tag string) bool {
+	if len(p) != len(tag) {
+		return false
+	}
+	for i := 0; i < len(p); i++ {
+		if p[i] != 'X' && p[i] != 'x' && p[i] != tag[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchControlfields returns the controlfields for the record that
+// match the specified tag pattern (see MatchTag)
+func (rec Record) MatchControlfields(pattern string) (cfs []*Controlfield) {
+	for _, cf := range rec.Controlfields {
+		if MatchTag(pattern, cf.Tag) {
+			cfs = append(cfs, cf)
+		}
+	}
+	return cfs
+}
+
+// MatchDatafields returns the datafields for the record that match the
+// specified tag pattern (see MatchTag)
+func (rec Record) MatchDatafields(pattern string) (dfs []*Datafield) {
+	for _, df := range rec.Datafields {
+		if MatchTag(pattern, df.Tag) {
+			dfs = append(dfs, df)
+		}
+	}
+	return dfs
+}
+
+// AddControlfield appends a controlfield to the record
+func (rec *Record) AddControlfield(cf *Controlfield) {
+	rec.Controlfields = append(rec.Controlfields, cf)
+}
+
+// InsertControlfield inserts a controlfield into the record in tag
+// order, following any controlfields having the same tag
+func (rec *Record) InsertControlfield(cf *Controlfield) {
+	i := len(rec.Controlfields)
+	for i > 0 && rec.Controlfields[i-1].Tag > cf.Tag {
+		i--
+	}
+	rec.Controlfields = append(rec.Controlfields, nil)
+	copy(rec.Controlfields[i+1:], rec.Controlfields[i:])
+	rec.Controlfields[i] = cf
+}
+
+// SetControlfield sets the text of the first controlfield having the
+// specified tag. If there is no such controlfield then one is inserted
+// in tag order.
+func (rec *Record) SetControlfield(tag, text string) {
+	for _, cf := range rec.Controlfields {
+		if cf.Tag == tag {
+			cf.Text = text
+			return
+		}
+	}
+	rec.InsertControlfield(&Controlfield{Tag: tag, Text: text})
+}
+
+// ReplaceControlfield replaces a controlfield of the record with
+// another, in the same position. It returns false if the record does
+// not contain the field being replaced.
+func (rec *Record) ReplaceControlfield(old, cf *Controlfield) bool {
+	for i, c := range rec.Controlfields {
+		if c == old {
+			cf.seq = old.seq
+			rec.Controlfields[i] = cf
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteControlfield removes a controlfield from the record. It returns
+// false if the record does not contain the field.
+func (rec *Record) DeleteControlfield(cf *Controlfield) bool {
+	for i, c := range rec.Controlfields {
+		if c == cf {
+			rec.Controlfields = append(rec.Controlfields[:i], rec.Controlfields[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteControlfields removes the controlfields that match the
+// specified tag pattern (see MatchTag) and returns the number removed
+func (rec *Record) DeleteControlfields(pattern string) int {
+	var cfs []*Controlfield
+	for _, cf := range rec.Controlfields {
+		if !MatchTag(pattern, cf.Tag) {
+			cfs = append(cfs, cf)
+		}
+	}
+	n := len(rec.Controlfields) - len(cfs)
+	rec.Controlfields = cfs
+	return n
+}
+
+// MoveControlfield moves a controlfield to the specified position
+// among the controlfields of the record. It returns false if the record
+// does not contain the field or the position is out of range.
+func (rec *Record) MoveControlfield(cf *Controlfield, pos int) bool {
+	if pos < 0 || pos >= len(rec.Controlfields) || !rec.DeleteControlfield(cf) {
+		return false
+	}
+	// The field now follows a different field
+	cf.seq = 0
+	rec.Controlfields = append(rec.Controlfields, nil)
+	copy(rec.Controlfields[pos+1:], rec.Controlfields[pos:])
+	rec.Controlfields[pos] = cf
+	return true
+}
+
+// AddDatafield appends a datafield to the record
+func (rec *Record) AddDatafield(df *Datafield) {
+	rec.Datafields = append(rec.Datafields, df)
+}
+
+// InsertDatafield inserts a datafield into the record in tag order,
+// following any datafields having the same tag
+func (rec *Record) InsertDatafield(df *Datafield) {
+	i := len(rec.Datafields)
+	for i > 0 && rec.Datafields[i-1].Tag > df.Tag {
+		i--
+	}
+	rec.Datafields = append(rec.Datafields, nil)
+	copy(rec.Datafields[i+1:], rec.Datafields[i:])
+	rec.Datafields[i] = df
+}
+
+// ReplaceDatafield replaces a datafield of the record with another, in
+// the same position. It returns false if the record does not contain
+// the field being replaced.
+func (rec *Record) ReplaceDatafield(old, df *Datafield) bool {
+	for i, d := range rec.Datafields {
+		if d == old {
+			df.seq = old.seq
+			rec.Datafields[i] = df
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteDatafield removes a datafield from the record. It returns false
+// if the record does not contain the field.
+func (rec *Record) DeleteDatafield(df *Datafield) bool {
+	for i, d := range rec.Datafields {
+		if d == df {
+			rec.Datafields = append(rec.Datafields[:i], rec.Datafields[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteDatafields removes the datafields that match the specified tag
+// pattern (see MatchTag) and returns the number removed
+func (rec *Record) DeleteDatafields(pattern string) int {
+	var dfs []*Datafield
+	for _, df := range rec.Datafields {
+		if !MatchTag(pattern, df.Tag) {
+			dfs = append(dfs, df)
+		}
+	}
+	n := len(rec.Datafields) - len(dfs)
+	rec.Datafields = dfs
+	return n
+}
+
+// MoveDatafield moves a datafield to the specified position among the
+// datafields of the record. It returns false if the record does not
+// contain the field or the position is out of range.
+func (rec *Record) MoveDatafield(df *Datafield, pos int) bool {
+	if pos < 0 || pos >= len(rec.Datafields) || !rec.DeleteDatafield(df) {
+		return false
+	}
+	// The field now follows a different field
+	df.seq = 0
+	rec.Datafields = append(rec.Datafields, nil)
+	copy(rec.Datafields[pos+1:], rec.Datafields[pos:])
+	rec.Datafields[pos] = df
+	return true
+}
+
+// SetIndicators sets both indicators of the datafield. Each indicator
+// must be a single byte.
+func (df *Datafield) SetIndicators(ind1, ind2 string) error {
+	if len(ind1) != 1 || len(ind2) != 1 {
+		return &ValidationError{Record: -1, Tag: df.Tag, Err: ErrBadIndicator}
+	}
+	df.Ind1 = ind1
+	df.Ind2 = ind2
+	return nil
+}
+
+// AddSubfield appends a subfield to the datafield and returns it
+func (df *Datafield) AddSubfield(code, text string) *Subfield {
+	sf := &Subfield{Code: code, Text: text}
+	df.Subfields = append(df.Subfields, sf)
+	return sf
+}
+
+// InsertSubfield inserts a subfield into the datafield at the specified
+// position. Positions beyond the last subfield append the subfield.
+func (df *Datafield) InsertSubfield(pos int, sf *Subfield) {
+	if pos < 0 {
+		pos = 0
+	}
+	if pos >= len(df.Subfields) {
+		df.Subfields = append(df.Subfields, sf)
+		return
+	}
+	df.Subfields = append(df.Subfields, nil)
+	copy(df.Subfields[pos+1:], df.Subfields[pos:])
+	df.Subfields[pos] = sf
+}
+
+// ReplaceSubfield replaces a subfield of the datafield with another, in
+// the same position. It returns false if the datafield does not contain
+// the subfield being replaced.
+func (df *Datafield) ReplaceSubfield(old, sf *Subfield) bool {
+	for i, s := range df.Subfields {
+		if s == old {
+			df.Subfields[i] = sf
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteSubfield removes a subfield from the datafield. It returns
+// false if the datafield does not contain the subfield.
+func (df *Datafield) DeleteSubfield(sf *Subfield) bool {
+	for i, s := range df.Subfields {
+		if s == sf {
+			df.Subfields = append(df.Subfields[:i], df.Subfields[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteSubfields removes the subfields that match the specified codes
+// and returns the number removed. Each code is a single character, as
+// for GetSubfields. If no codes are specified (empty string) then all
+// subfields are removed.
+func (df *Datafield) DeleteSubfields(codes string) int {
+	var sfs []*Subfield
+	for _, sf := range df.Subfields {
+		matched := len(sf.Code) == 1 && strings.IndexByte(codes, sf.Code[0]) >= 0
+		if codes != "" && !matched {
+			sfs = append(sfs, sf)
+		}
+	}
+	n := len(df.Subfields) - len(sfs)
+	df.Subfields = sfs
+	return n
+}
@@ -0,0 +1,150 @@
+package marc21
+
+import (
+	"strings"
+	"testing"
+)
+
+// fieldTags returns the tags of a record in the order they are written
+func fieldTags(rec *Record) string {
+	var tags []string
+	for _, p := range rec.fieldOrder() {
+		if p.control {
+			tags = append(tags, rec.Controlfields[p.index].Tag)
+		} else {
+			tags = append(tags, rec.Datafields[p.index].Tag)
+		}
+	}
+	return strings.Join(tags, " ")
+}
+
+func TestMatchTag(t *testing.T) {
+
+	tests := []struct {
+		pattern string
+		tag     string
+		match   bool
+	}{
+		{"245", "245", true},
+		{"9XX", "949", true},
+		{"9xx", "949", true},
+		{"9XX", "849", false},
+		{"1XX,7XX", "700", true},
+		{"1XX,7XX", "600", false},
+		{"X0X", "100", true},
+		{"XX", "100", false},
+	}
+
+	for _, test := range tests {
+		if MatchTag(test.pattern, test.tag) != test.match {
+			t.Errorf("MatchTag(%q, %q) != %v", test.pattern, test.tag, test.match)
+		}
+	}
+}
+
+func TestEditFields(t *testing.T) {
+
+	rec := newTestRecord("1")
+
+	rec.InsertControlfield(&Controlfield{Tag: "003", Text: "DLC"})
+	rec.SetControlfield("001", "2")
+	rec.SetControlfield("005", "20180115120000.0")
+	rec.AddDatafield(&Datafield{Tag: "949", Ind1: " ", Ind2: " "})
+	rec.AddDatafield(&Datafield{Tag: "999", Ind1: " ", Ind2: " "})
+	rec.InsertDatafield(&Datafield{Tag: "020", Ind1: " ", Ind2: " "})
+	rec.InsertDatafield(&Datafield{Tag: "500", Ind1: " ", Ind2: " "})
+
+	if got := fieldTags(rec); got != "001 003 005 008 020 100 245 500 949 999" {
+		t.Errorf("fields are %q", got)
+	}
+	if rec.GetControlfield("001") != "2" {
+		t.Errorf("SetControlfield() did not replace the 001")
+	}
+
+	if n := rec.DeleteDatafields("9XX"); n != 2 {
+		t.Errorf("DeleteDatafields() removed %d fields", n)
+	}
+	if n := rec.DeleteControlfields("00X"); n != 4 {
+		t.Errorf("DeleteControlfields() removed %d fields", n)
+	}
+
+	f245 := rec.GetDatafields("245")[0]
+	f246 := &Datafield{Tag: "246", Ind1: "3", Ind2: " "}
+	if !rec.ReplaceDatafield(f245, f246) || rec.ReplaceDatafield(f245, f246) {
+		t.Errorf("ReplaceDatafield() failed")
+	}
+	if !rec.MoveDatafield(f246, 0) || rec.MoveDatafield(f245, 0) {
+		t.Errorf("MoveDatafield() failed")
+	}
+	if !rec.DeleteDatafield(rec.MatchDatafields("5XX")[0]) {
+		t.Errorf("DeleteDatafield() failed")
+	}
+
+	if got := fieldTags(rec); got != "246 020 100" {
+		t.Errorf("fields are %q", got)
+	}
+}
+
+func TestEditParsedFieldOrder(t *testing.T) {
+
+	raw := newTestMARC(t, "1")
+	rec, err := ParseRecord(raw)
+	if err != nil {
+		t.Fatalf("ParseRecord() failed: %q", err)
+	}
+
+	// Fields inserted before the first field of their slice are written
+	// before it, not before all of the other fields
+	rec.InsertDatafield(&Datafield{Tag: "010", Ind1: " ", Ind2: " "})
+	rec.InsertControlfield(&Controlfield{Tag: "000", Text: "x"})
+	rec.MoveDatafield(rec.GetDatafields("245")[0], 0)
+
+	if got := fieldTags(rec); got != "000 001 008 245 010 100" {
+		t.Errorf("fields are %q", got)
+	}
+}
+
+func TestEditSubfields(t *testing.T) {
+
+	df := &Datafield{Tag: "650", Ind1: " ", Ind2: "0"}
+
+	if err := df.SetIndicators("", "7"); err == nil {
+		t.Errorf("SetIndicators() accepted an empty indicator")
+	}
+	if err := df.SetIndicators(" ", "7"); err != nil || df.Ind2 != "7" {
+		t.Errorf("SetIndicators() failed: %v", err)
+	}
+
+	a := df.AddSubfield("a", "Cats")
+	df.AddSubfield("2", "local")
+	df.InsertSubfield(1, &Subfield{Code: "x", Text: "Behavior"})
+	df.InsertSubfield(9, &Subfield{Code: "0", Text: "id"})
+	df.ReplaceSubfield(a, &Subfield{Code: "a", Text: "Dogs"})
+
+	var s []string
+	for _, sf := range df.Subfields {
+		s = append(s, sf.Code+sf.Text)
+	}
+	if got := strings.Join(s, " "); got != "aDogs xBehavior 2local 0id" {
+		t.Errorf("subfields are %q", got)
+	}
+
+	if n := df.DeleteSubfields("20"); n != 2 || len(df.Subfields) != 2 {
+		t.Errorf("DeleteSubfields() removed %d subfields", n)
+	}
+
+	// Subfields having an empty (or a multi-character) code only match
+	// when all subfields are removed
+	df.AddSubfield("", "no code")
+	df.AddSubfield("9z", "bad code")
+	if n := df.DeleteSubfields("9z"); n != 0 || len(df.Subfields) != 4 {
+		t.Errorf("DeleteSubfields() removed %d subfields", n)
+	}
+	df.Subfields = df.Subfields[:2]
+	if !df.DeleteSubfield(df.Subfields[0]) || df.DeleteSubfield(a) {
+		t.Errorf("DeleteSubfield() failed")
+	}
+	if n := df.DeleteSubfields(""); n != 1 || len(df.Subfields) != 0 {
+		t.Errorf("DeleteSubfields() removed %d subfields", n)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The causes of a ParseError. Use errors.Is to test for them.
+var (
+	// ErrNotInteger indicates that a length or position in the leader
+	// or directory is not numeric
+	ErrNotInteger = errors.New("not an integer")
+	// ErrRecordTooShort indicates that the record length is too short
+	// to contain a leader
+	ErrRecordTooShort = errors.New("MARC record is too short")
+	// ErrRecordTooLong indicates that the record length exceeds the
+	// maximum allowed
+	ErrRecordTooLong = errors.New("MARC record is too long")
+	// ErrNoRecordTerminator indicates that the record does not end
+	// with a record terminator
+	ErrNoRecordTerminator = errors.New("record terminator not found at end of record")
+	// ErrNoFieldTerminator indicates that a field does not end with a
+	// field terminator
+	ErrNoFieldTerminator = errors.New("field terminator not found at end of field")
+	// ErrFieldTooShort indicates that a datafield is too short to
+	// contain the indicators
+	ErrFieldTooShort = errors.New("field is too short to contain indicators")
+	// ErrBadDirectory indicates that the directory is not terminated or
+	// has a partial entry
+	ErrBadDirectory = errors.New("directory is not properly terminated")
+	// ErrBadBaseAddress indicates that the base address of data is not
+	// within the record
+	ErrBadBaseAddress = errors.New("base address of data is outside of the record")
+	// ErrFieldOutOfBounds indicates that a directory entry refers to
+	// data outside of the record
+	ErrFieldOutOfBounds = errors.New("field extends outside of the record")
+)
+
+// ErrRepeatedControlNumber is the kind of the ParseWarning for a record
+// that has more than one 001 (control number) field. It is only found
+// in warnings, the other kinds of warning are the causes of a
+// ParseError.
+var ErrRepeatedControlNumber = errors.New("repeated control number field")
+
+// The causes of a ValidationError. Use errors.Is to test for them.
+var (
+	// ErrBadLeader indicates that the leader is not 24 bytes long
+	ErrBadLeader = errors.New("leader is not 24 bytes long")
+	// ErrBadTag indicates that a tag is not 3 bytes long
+	ErrBadTag = errors.New("tag is not 3 bytes long")
+	// ErrBadIndicator indicates that an indicator is not a single byte
+	ErrBadIndicator = errors.New("indicator is not a single byte")
+	// ErrFieldTooLong indicates that a field exceeds the 9999 bytes
+	// that can be recorded in a directory entry
+	ErrFieldTooLong = errors.New("field is too long")
+)
+
+// ParseError is the error returned when a MARC record cannot be read
+// or parsed. It identifies where in the input the problem was found.
+type ParseError struct {
+	// Record is the zero-based index of the record in the input, or -1
+	// when it is not known (as when calling ParseRecord directly)
+	Record int
+	// Offset is the byte offset of the problem. It is relative to the
+	// start of the input when the record is read with a Reader,
+	// otherwise it is relative to the start of the record.
+	Offset int64
+	// Tag is the tag of the field having the problem, if any
+	Tag string
+	// Err is the underlying cause of the error
+	Err error
+}
+
+// newParseError returns a ParseError for an unknown record
+func newParseError(offset int, tag string, err error) *ParseError {
+	return &ParseError{Record: -1, Offset: int64(offset), Tag: tag, Err: err}
+}
+
+func (e *ParseError) Error() string {
+	var s []string
+	if e.Record >= 0 {
+		s = append(s, fmt.Sprintf("record %d", e.Record))
+	}
+	s = append(s, fmt.Sprintf("offset %d", e.Offset))
+	if e.Tag != "" {
+		s = append(s, fmt.Sprintf("tag %s", e.Tag))
+	}
+	return fmt.Sprintf("marc21: %s: %v", strings.Join(s, ", "), e.Err)
+}
+
+// Unwrap returns the underlying cause of the error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// locateError sets the record index and adjusts the offset of a
+// ParseError to be relative to the start of the input
+func locateError(err error, record int, offset int64) error {
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		pe.Record = record
+		pe.Offset += offset
+		return pe
+	}
+	return err
+}
+
+// ValidationError is the error returned when a record cannot be written
+// as a valid MARC record. ErrRecordTooLong is also used as a cause.
+type ValidationError struct {
+	// Record is the zero-based index of the record in the output, or -1
+	// when it is not known (as when calling RecordAsMARC directly)
+	Record int
+	// Tag is the tag of the field having the problem, if any
+	Tag string
+	// Err is the underlying cause of the error
+	Err error
+}
+
+func (e *ValidationError) Error() string {
+	var s []string
+	if e.Record >= 0 {
+		s = append(s, fmt.Sprintf("record %d", e.Record))
+	}
+	if e.Tag != "" {
+		s = append(s, fmt.Sprintf("tag %s", e.Tag))
+	}
+	if len(s) == 0 {
+		return fmt.Sprintf("marc21: %v", e.Err)
+	}
+	return fmt.Sprintf("marc21: %s: %v", strings.Join(s, ", "), e.Err)
+}
+
+// Unwrap returns the underlying cause of the error
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
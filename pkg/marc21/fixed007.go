@@ -0,0 +1,104 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+/*
+http://www.loc.gov/marc/bibliographic/bd006.html
+
+    006 - Fixed-Length Data Elements-Additional Material Characteristics
+
+    Field 006 has 18 character positions (00-17). Position 00 contains
+    a code that identifies the form of material. The codes in the
+    remaining positions, 01-17, are defined the same as the
+    corresponding 008/18-34 positions of the 008 for that form of
+    material.
+*/
+
+/*
+http://www.loc.gov/marc/bibliographic/bd007.html
+
+    007 - Physical Description Fixed Field-General Information
+
+    Sixteen-character positions (00-15) that contain special coded
+    information about the physical characteristics of an item. [...]
+    Character position 00 contains a code that identifies the category
+    of material. The meaning of the remaining positions depends on the
+    category of material.
+*/
+
+// materialForm maps the 006/00 form of material codes to the 008
+// material types
+var materialForm = map[string]string{
+	"a": "BK",
+	"t": "BK",
+	"c": "MU",
+	"d": "MU",
+	"i": "MU",
+	"j": "MU",
+	"e": "MP",
+	"f": "MP",
+	"g": "VM",
+	"k": "VM",
+	"o": "VM",
+	"r": "VM",
+	"m": "CF",
+	"p": "MX",
+	"s": "CR",
+}
+
+// f006Offset is the difference between the positions of the 006 and
+// those of the 008 for the same form of material
+const f006Offset = 17
+
+// f006Elements returns the 006 elements for a form of material, which
+// are those of the 008/18-34 for the material type
+func f006Elements(form string) []fixedElement {
+
+	elements := append([]fixedElement{}, controlfieldElements["006"]["All Materials"]...)
+
+	for _, e := range controlfieldElements["008"][materialForm[form]] {
+		e.Offset -= f006Offset
+		elements = append(elements, e)
+	}
+
+	return elements
+}
+
+// Decode006 returns the values of the elements of a 006 (additional
+// material characteristics) according to its form of material (006/00)
+func Decode006(text string) []FixedValue {
+	return decodeElements(f006Elements(pluckByte(text, 0)), text)
+}
+
+// Decode007 returns the values of the elements of a 007 (physical
+// description) according to its category of material (007/00). For
+// unknown categories only the category of material is returned.
+func Decode007(text string) []FixedValue {
+
+	elements, ok := controlfieldElements["007"][pluckByte(text, 0)]
+	if !ok {
+		return []FixedValue{{Name: "Category of material", Width: 1, Code: pluckByte(text, 0)}}
+	}
+
+	return decodeElements(elements, text)
+}
+
+// Fixed006 returns the decoded values of each of the 006 fields of the
+// record
+func (rec Record) Fixed006() (values [][]FixedValue) {
+	for _, cf := range rec.MatchControlfields("006") {
+		values = append(values, Decode006(cf.Text))
+	}
+	return values
+}
+
+// Fixed007 returns the decoded values of each of the 007 fields of the
+// record
+func (rec Record) Fixed007() (values [][]FixedValue) {
+	for _, cf := range rec.MatchControlfields("007") {
+		values = append(values, Decode007(cf.Text))
+	}
+	return values
+}
@@ -0,0 +1,92 @@
+package marc21
+
+import (
+	"testing"
+)
+
+func TestFixed007Tables(t *testing.T) {
+
+	for category, elements := range controlfieldElements["007"] {
+		length := 0
+		for _, e := range elements {
+			if e.Offset+e.Width > length {
+				length = e.Offset + e.Width
+			}
+		}
+		checkElements(t, "007 "+category, elements, length)
+
+		if _, ok := elements[0].Codes[category]; !ok || elements[0].Offset != 0 {
+			t.Errorf("007 %s: first element is %q", category, elements[0].Name)
+		}
+	}
+
+	for form := range materialForm {
+		checkElements(t, "006 "+form, f006Elements(form), 18)
+	}
+}
+
+func TestDecode006(t *testing.T) {
+
+	rec := newTestRecord("1")
+	rec.InsertControlfield(&Controlfield{Tag: "006", Text: "m     o  d        "})
+	rec.InsertControlfield(&Controlfield{Tag: "006", Text: "m     o  d        "})
+
+	values := rec.Fixed006()
+	if len(values) != 2 {
+		t.Fatalf("Fixed006() returned %d fields", len(values))
+	}
+
+	expected := map[string]string{
+		"Form of material":      "Computer file/Electronic resource",
+		"Form of item":          "Online",
+		"Type of computer file": "Document",
+	}
+	for _, v := range values[0] {
+		if !v.Valid {
+			t.Errorf("%v is not valid", v)
+		}
+		if label, ok := expected[v.Name]; ok && v.Label != label {
+			t.Errorf("%v, expected %q", v, label)
+		}
+	}
+}
+
+func TestDecode007(t *testing.T) {
+
+	tests := []struct {
+		text     string
+		name     string
+		label    string
+		elements int
+	}{
+		{"cr |n|||||||||", "Specific material designation", "Remote", 12},
+		{"sd fsngnnmmned", "Speed", "1.4 m. per sec.", 14},
+		{"vd cvaizq", "Videorecording format", "DVD", 9},
+		{"ta", "Specific material designation", "Regular print", 2},
+		{"rucnn||||mm", "Data type", "Combination of various data types", 10},
+	}
+
+	for _, test := range tests {
+		values := Decode007(test.text)
+		if len(values) != test.elements {
+			t.Errorf("Decode007(%q) returned %d elements", test.text, len(values))
+		}
+		found := false
+		for _, v := range values {
+			if v.Name == test.name {
+				found = true
+				if v.Label != test.label || !v.Valid {
+					t.Errorf("Decode007(%q): %v, expected %q", test.text, v, test.label)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("Decode007(%q) has no %s", test.text, test.name)
+		}
+	}
+
+	values := Decode007("xa")
+	if len(values) != 1 || values[0].Valid {
+		t.Errorf("Decode007() of an unknown category = %v", values)
+	}
+}
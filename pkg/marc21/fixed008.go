@@ -0,0 +1,93 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+http://www.loc.gov/marc/bibliographic/bd008.html
+
+    Character positions 00-17 and 35-39 are defined the same across all
+    types of material, with special consideration for position 06. The
+    definition of character positions 18-34 differs according to the
+    type of material.
+
+Also:
+    http://www.loc.gov/marc/holdings/hd008.html
+    http://www.loc.gov/marc/authority/ad008.html
+    http://www.loc.gov/marc/classification/cd008.html
+    http://www.loc.gov/marc/community/ci008.html
+*/
+
+// f008Elements returns the 008 elements for the format (and, for
+// Bibliography records, the material type) of the record
+func (rec Record) f008Elements() []fixedElement {
+
+	tables := controlfieldElements["008"]
+
+	format := rec.RecordFormat()
+	switch format {
+	case FmtUnknown:
+		return nil
+	case Bibliography:
+		elements := append([]fixedElement{}, tables["All Materials"]...)
+		code, _ := rec.BibliographyMaterialType()
+		elements = append(elements, tables[code]...)
+		sort.Slice(elements, func(i, j int) bool { return elements[i].Offset < elements[j].Offset })
+		return elements
+	}
+
+	return tables[marcFormatName[format]]
+}
+
+// Fixed008 returns the values of the elements of the 008 as defined
+// for the format of the record (and, for Bibliography records, the
+// material type). Nothing is returned for records that have no 008 or
+// are of an unknown format.
+func (rec Record) Fixed008() []FixedValue {
+	f008 := rec.GetControlfield("008")
+	if f008 == "" {
+		return nil
+	}
+	return decodeElements(rec.f008Elements(), f008)
+}
+
+// Fixed008Element returns the value of the named 008 element, if the
+// element is defined for the record
+func (rec Record) Fixed008Element(name string) (FixedValue, bool) {
+	f008 := rec.GetControlfield("008")
+	e, ok := findElement(rec.f008Elements(), name)
+	if f008 == "" || !ok {
+		return FixedValue{}, false
+	}
+	return e.decode(f008), true
+}
+
+// SetFixed008Element sets the value of the named 008 element. The value
+// must be valid for the element.
+func (rec *Record) SetFixed008Element(name, value string) error {
+
+	e, ok := findElement(rec.f008Elements(), name)
+	if !ok {
+		return fmt.Errorf("%w: 008 %s is not defined for %s records", ErrBadFixedValue, name, rec.RecordFormatName())
+	}
+
+	text, err := e.encode(rec.GetControlfield("008"), value)
+	if err != nil {
+		return err
+	}
+	rec.SetControlfield("008", text)
+	return nil
+}
+
+// f008Value returns the code and label of the named 008 element. The
+// 008 element accessors are generated, see cftables.go.
+func (rec Record) f008Value(name string) (code, label string) {
+	v, _ := rec.Fixed008Element(name)
+	return v.Code, v.Label
+}
@@ -0,0 +1,123 @@
+package marc21
+
+import (
+	"errors"
+	"testing"
+)
+
+// checkElements checks that a set of elements covers each of the
+// positions of a fixed field exactly once
+func checkElements(t *testing.T, name string, elements []fixedElement, length int) {
+	used := make([]bool, length)
+	for _, e := range elements {
+		if e.Offset+e.Width > length || e.Width%e.CodeWidth != 0 {
+			t.Errorf("%s: bad element %q", name, e.Name)
+			continue
+		}
+		for i := e.Offset; i < e.Offset+e.Width; i++ {
+			if used[i] {
+				t.Errorf("%s: element %q overlaps position %d", name, e.Name, i)
+			}
+			used[i] = true
+		}
+		for code := range e.Codes {
+			if len(code) != e.CodeWidth {
+				t.Errorf("%s: element %q has code %q", name, e.Name, code)
+			}
+		}
+	}
+	for i, u := range used {
+		if !u {
+			t.Errorf("%s: position %d is not defined", name, i)
+		}
+	}
+}
+
+func TestFixed008Tables(t *testing.T) {
+
+	lengths := map[Template]int{TemplateHoldings: 32, TemplateClassification: 14}
+
+	// Each template covers one of the layouts of the 008
+	for tmpl := range recordTemplates {
+		rec, err := NewBuilder(tmpl).Record()
+		if err != nil {
+			t.Fatalf("%s: Record() failed: %q", tmpl, err)
+		}
+
+		length, ok := lengths[tmpl]
+		if !ok {
+			length = 40
+		}
+		checkElements(t, "008 "+string(tmpl), rec.f008Elements(), length)
+
+		for _, v := range rec.Fixed008() {
+			if !v.Valid {
+				t.Errorf("%s: 008 %v is not valid", tmpl, v)
+			}
+		}
+	}
+
+	checkElements(t, "008 Classification", controlfieldElements["008"]["Classification"], 14)
+	checkElements(t, "008 Community Information", controlfieldElements["008"]["Community Information"], 40)
+}
+
+func TestFixed008(t *testing.T) {
+
+	rec := newTestRecord("1")
+	rec.Controlfields[1].Text = "180115s2017    nyua   j b    001 0 eng d"
+
+	tests := []struct {
+		fn    func() (string, string)
+		code  string
+		label string
+	}{
+		{rec.TypeOfDate, "s", "Single known date/probable date"},
+		{rec.TargetAudience, "j", "Juvenile"},
+		{rec.FormOfItem, " ", "None of the following"},
+		{rec.CatalogingSource, "d", "Other"},
+	}
+	for _, test := range tests {
+		if code, label := test.fn(); code != test.code || label != test.label {
+			t.Errorf("returned %q %q, expected %q %q", code, label, test.code, test.label)
+		}
+	}
+
+	if rec.DateEnteredOnFile() != "180115" || rec.Date1() != "2017" || rec.Date2() != "    " ||
+		rec.PlaceOfPublication() != "nyu" || rec.Language() != "eng" {
+		t.Errorf("unexpected 008 values %v", rec.Fixed008())
+	}
+
+	v, ok := rec.Fixed008Element("Illustrations")
+	if !ok || v.Code != "a   " || v.Label != "Illustrations" || !v.Valid {
+		t.Errorf("Illustrations = %v", v)
+	}
+	v, ok = rec.Fixed008Element("Nature of contents")
+	if !ok || v.Label != "Bibliographies" {
+		t.Errorf("Nature of contents = %v", v)
+	}
+	if _, ok = rec.Fixed008Element("Type of visual material"); ok {
+		t.Errorf("returned a Visual Materials element for a book")
+	}
+
+	if err := rec.SetFixed008Element("Literary form", "p"); err != nil {
+		t.Errorf("SetFixed008Element() failed: %q", err)
+	}
+	if code, label := rec.f008Value("Literary form"); code != "p" || label != "Poetry" {
+		t.Errorf("Literary form = %q %q", code, label)
+	}
+	if err := rec.SetFixed008Element("Literary form", "x"); !errors.Is(err, ErrBadFixedValue) {
+		t.Errorf("SetFixed008Element() = %v", err)
+	}
+	if err := rec.SetFixed008Element("Relief", "a   "); !errors.Is(err, ErrBadFixedValue) {
+		t.Errorf("SetFixed008Element() = %v", err)
+	}
+
+	// Changing the material type changes the 008 elements
+	rec.Leader.Text = "00000nem a2200000 a 4500"
+	if err := rec.SetFixed008Element("Relief", "a   "); err != nil {
+		t.Errorf("SetFixed008Element() failed: %q", err)
+	}
+	if code, _ := rec.TargetAudience(); code != "" {
+		t.Errorf("TargetAudience() = %q for a map", code)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+The leader and the fixed length controlfields (006, 007 and 008) are
+made up of elements that are identified by character position. Most
+elements have a list of valid codes (the "#" that the LoC pages use for
+a blank is held here as a blank).
+
+The element tables (leadertables.go and cftables.go) are generated from
+the lists extracted from the LoC field lists (see codegen/README.md).
+*/
+
+//go:generate go run ../../codegen/gen-tables.go -i ../../codegen/lists -o .
+
+// ErrBadFixedValue indicates that a value is not valid for an element
+// of the leader or of a fixed length controlfield
+var ErrBadFixedValue = errors.New("invalid value for fixed field element")
+
+// fixedElement is the definition of an element of the leader or of a
+// fixed length controlfield
+type fixedElement struct {
+	// Name is the LoC name of the element
+	Name string
+	// Offset is the (zero-based) character position of the element
+	Offset int
+	// Width is the number of character positions in the element
+	Width int
+	// CodeWidth is the width of each code, when an element of several
+	// positions contains a list of codes (as in the 008/18-21
+	// illustrations of books), otherwise it is the same as Width
+	CodeWidth int
+	// Codes are the valid codes and their labels. Elements that have
+	// no list of codes (such as dates) have no Codes.
+	Codes map[string]string
+}
+
+// FixedValue is the value of an element of the leader or of a fixed
+// length controlfield
+type FixedValue struct {
+	// Name is the LoC name of the element
+	Name string
+	// Offset is the (zero-based) character position of the element
+	Offset int
+	// Width is the number of character positions in the element
+	Width int
+	// Code is the value of the element
+	Code string
+	// Label is the description of the code. For elements that contain
+	// a list of codes the labels are separated by "; ".
+	Label string
+	// Valid indicates whether the code is one of the codes defined for
+	// the element
+	Valid bool
+}
+
+// String returns the element as offset, name, code and label
+func (v FixedValue) String() string {
+	return fmt.Sprintf("%02d %s: %q %s", v.Offset, v.Name, v.Code, v.Label)
+}
+
+// decode returns the value of the element in the specified text
+func (e fixedElement) decode(text string) FixedValue {
+
+	v := FixedValue{Name: e.Name, Offset: e.Offset, Width: e.Width, Valid: true}
+
+	if len(text) < e.Offset+e.Width {
+		v.Valid = false
+		if len(text) > e.Offset {
+			v.Code = text[e.Offset:]
+		}
+		return v
+	}
+	v.Code = text[e.Offset : e.Offset+e.Width]
+
+	if e.Codes == nil {
+		return v
+	}
+
+	codes := e.split(v.Code)
+	blank := strings.Repeat(" ", len(codes[0]))
+
+	var labels []string
+	seen := make(map[string]bool)
+	for _, c := range codes {
+		label, ok := e.Codes[c]
+		if !ok {
+			v.Valid = false
+			continue
+		}
+		// For a list of codes, the trailing blanks are only padding
+		if c == blank && len(labels) > 0 || seen[c] {
+			continue
+		}
+		seen[c] = true
+		labels = append(labels, label)
+	}
+	v.Label = strings.Join(labels, "; ")
+
+	return v
+}
+
+// split splits a value into the codes that it contains
+func (e fixedElement) split(value string) (codes []string) {
+	w := e.CodeWidth
+	if w <= 0 || w > e.Width {
+		w = e.Width
+	}
+	for i := 0; i+w <= len(value); i += w {
+		codes = append(codes, value[i:i+w])
+	}
+	return codes
+}
+
+// encode checks a value for the element and returns the text with the
+// value set
+func (e fixedElement) encode(text, value string) (string, error) {
+
+	if len(value) != e.Width {
+		return text, fmt.Errorf("%w: %s must be %d characters, not %q", ErrBadFixedValue, e.Name, e.Width, value)
+	}
+	if len(text) < e.Offset+e.Width {
+		return text, fmt.Errorf("%w: %s is beyond the end of %q", ErrBadFixedValue, e.Name, text)
+	}
+	if e.Codes != nil {
+		for _, c := range e.split(value) {
+			if _, ok := e.Codes[c]; !ok {
+				return text, fmt.Errorf("%w: %q is not a valid %s", ErrBadFixedValue, c, e.Name)
+			}
+		}
+	}
+
+	return text[:e.Offset] + value + text[e.Offset+e.Width:], nil
+}
+
+// findElement returns the named element
+func findElement(elements []fixedElement, name string) (fixedElement, bool) {
+	for _, e := range elements {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return fixedElement{}, false
+}
+
+// decodeElements returns the values of all of the elements in the
+// specified text
+func decodeElements(elements []fixedElement, text string) (values []FixedValue) {
+	for _, e := range elements {
+		values = append(values, e.decode(text))
+	}
+	return values
+}
@@ -0,0 +1,94 @@
+package marc21
+
+import (
+	"bytes"
+	"testing"
+)
+
+// fuzzSeeds adds a few well formed and malformed records to the corpus
+func fuzzSeeds(f *testing.F) {
+
+	raw, err := newTestRecord("1").RecordAsMARC()
+	if err != nil {
+		f.Fatalf("RecordAsMARC() failed: %q", err)
+	}
+
+	f.Add(raw)
+	f.Add(raw[:leaderLen])
+	f.Add(raw[:len(raw)/2])
+	f.Add([]byte("00026nam a2200025 a 4500\x1e\x1d"))
+	f.Add([]byte("99999nam a2299999 a 4500001000500000\x1e\x1d"))
+}
+
+// FuzzParseRecord ensures that ParseRecordWithOptions does not panic on
+// malformed records, in either mode
+func FuzzParseRecord(f *testing.F) {
+
+	fuzzSeeds(f)
+
+	f.Fuzz(func(t *testing.T, raw []byte) {
+		for _, mode := range []ParseMode{Strict, Lenient} {
+			rec, err := ParseRecordWithOptions(raw, ParseOptions{Mode: mode, ConvertMARC8: true})
+			if err != nil {
+				if rec != nil {
+					t.Errorf("ParseRecordWithOptions() returned a record and an error")
+				}
+				continue
+			}
+			_ = rec.String()
+			_, _ = rec.RecordAsMARC()
+		}
+	})
+}
+
+// FuzzNextRecord ensures that reading a stream of malformed records does
+// not panic or loop
+func FuzzNextRecord(f *testing.F) {
+
+	fuzzSeeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+
+		r := bytes.NewReader(data)
+		for {
+			rawRec, err := NextRecord(r)
+			if err != nil {
+				break
+			}
+			_, _ = ParseRecord(rawRec)
+		}
+
+		rdr := NewReader(bytes.NewReader(data))
+		rdr.SkipCorrupt = true
+		rdr.Mode = Lenient
+		for rdr.Next() {
+			if rdr.Record() == nil {
+				t.Errorf("Record() returned nil with SkipCorrupt set")
+			}
+		}
+	})
+}
+
+// FuzzLoadXML ensures that reading malformed MARCXML does not panic
+func FuzzLoadXML(f *testing.F) {
+
+	f.Add([]byte(CollectionXMLHeader + CollectionXMLFooter))
+	f.Add([]byte(`<collection><record><leader>00000nam a2200000 a 4500</leader>` +
+		`<controlfield tag="001">1</controlfield>` +
+		`<datafield tag="245" ind1="1" ind2="0"><subfield code="a">A title</subfield></datafield>` +
+		`</record></collection>`))
+	f.Add([]byte(`<collection><record><leader>short</leader><datafield tag="2"/></record></collection>`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+
+		doc, err := ReadXML(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		_, _ = doc.AsXML()
+		for _, rec := range doc.Records {
+			_, _ = rec.RecordAsMARC()
+		}
+	})
+}
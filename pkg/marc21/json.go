@@ -0,0 +1,339 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+https://github.com/marc4j/marc4j/wiki/MARC-in-JSON-Description
+
+    {
+        "leader": "01471cjm  2200349 a 4500",
+        "fields": [
+            {"001": "5674874"},
+            {"245": {
+                "ind1": "0",
+                "ind2": "0",
+                "subfields": [
+                    {"a": "Title"},
+                    {"c": "Statement of responsibility."}
+                ]
+            }}
+        ]
+    }
+
+    Each field is an object having a single key, the tag. Controlfields
+    have a string value and datafields have an object value.
+*/
+
+// ErrBadJSON indicates that a MARC-in-JSON record is not properly
+// structured
+var ErrBadJSON = errors.New("malformed MARC-in-JSON record")
+
+// jsonDatafield is the MARC-in-JSON representation of a datafield
+type jsonDatafield struct {
+	Ind1      *string             `json:"ind1"`
+	Ind2      *string             `json:"ind2"`
+	Subfields []map[string]string `json:"subfields"`
+}
+
+// MarshalJSON converts a record to MARC-in-JSON. As JSON text is
+// Unicode, MARC-8 records are converted to UTF-8 (with Leader/09 set to
+// "a") as they are written. The record itself is not changed.
+func (rec Record) MarshalJSON() ([]byte, error) {
+
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+
+	leader := rec.Leader.GetText()
+	marc8 := len(leader) >= leaderLen && leader[9] != 'a'
+	if marc8 {
+		leader = leader[:9] + "a" + leader[10:]
+	}
+
+	b.WriteString(`{"leader":`)
+	writeJSONString(&b, enc, leader)
+	b.WriteString(`,"fields":[`)
+
+	for n, f := range rec.fieldOrder() {
+		if n > 0 {
+			b.WriteByte(',')
+		}
+
+		if f.control {
+			cf := rec.Controlfields[f.index]
+			text := cf.GetText()
+			if marc8 {
+				text = MARC8ToUTF8(text)
+			}
+			b.WriteByte('{')
+			writeJSONString(&b, enc, cf.GetTag())
+			b.WriteByte(':')
+			writeJSONString(&b, enc, text)
+			b.WriteByte('}')
+			continue
+		}
+
+		df := rec.Datafields[f.index]
+		b.WriteByte('{')
+		writeJSONString(&b, enc, df.GetTag())
+		b.WriteString(`:{"ind1":`)
+		writeJSONString(&b, enc, df.GetInd1())
+		b.WriteString(`,"ind2":`)
+		writeJSONString(&b, enc, df.GetInd2())
+		b.WriteString(`,"subfields":[`)
+		// escape sequences may persist across the subfields of a field
+		d := newMARC8Decoder()
+		for i, sf := range df.Subfields {
+			text := sf.GetText()
+			if marc8 {
+				d.decode(text)
+				text = d.flush()
+			}
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteByte('{')
+			writeJSONString(&b, enc, sf.GetCode())
+			b.WriteByte(':')
+			writeJSONString(&b, enc, text)
+			b.WriteByte('}')
+		}
+		b.WriteString(`]}}`)
+	}
+
+	b.WriteString(`]}`)
+
+	return b.Bytes(), nil
+}
+
+// writeJSONString writes a string as a JSON string value, using an
+// encoder that writes to the same buffer
+func writeJSONString(b *bytes.Buffer, enc *json.Encoder, s string) {
+	// Encoding a string does not fail
+	_ = enc.Encode(s)
+	// Remove the newline written by Encode
+	b.Truncate(b.Len() - 1)
+}
+
+// UnmarshalJSON sets a record from MARC-in-JSON
+func (rec *Record) UnmarshalJSON(data []byte) error {
+
+	var raw struct {
+		Leader *string                      `json:"leader"`
+		Fields []map[string]json.RawMessage `json:"fields"`
+	}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	if raw.Leader == nil {
+		return fmt.Errorf("%w: no leader", ErrBadJSON)
+	}
+
+	*rec = Record{Leader: Leader{Text: *raw.Leader}}
+
+	for i, f := range raw.Fields {
+		if len(f) != 1 {
+			return fmt.Errorf("%w: field %d has %d tags", ErrBadJSON, i, len(f))
+		}
+
+		for tag, value := range f {
+			v := bytes.TrimSpace(value)
+			if len(v) > 0 && v[0] == '"' {
+				var text string
+				err = json.Unmarshal(v, &text)
+				if err != nil {
+					return err
+				}
+				rec.Controlfields = append(rec.Controlfields, &Controlfield{Tag: tag, Text: text, seq: i + 1})
+				continue
+			}
+
+			var jdf jsonDatafield
+			err = json.Unmarshal(v, &jdf)
+			if err != nil {
+				return fmt.Errorf("%w: field %d (%s): %v", ErrBadJSON, i, tag, err)
+			}
+
+			df := &Datafield{Tag: tag, Ind1: " ", Ind2: " ", seq: i + 1}
+			if jdf.Ind1 != nil {
+				df.Ind1 = *jdf.Ind1
+			}
+			if jdf.Ind2 != nil {
+				df.Ind2 = *jdf.Ind2
+			}
+			for _, sf := range jdf.Subfields {
+				if len(sf) != 1 {
+					return fmt.Errorf("%w: field %d (%s) has a subfield with %d codes", ErrBadJSON, i, tag, len(sf))
+				}
+				for code, text := range sf {
+					df.Subfields = append(df.Subfields, &Subfield{Code: code, Text: text})
+				}
+			}
+			rec.Datafields = append(rec.Datafields, df)
+		}
+	}
+
+	return nil
+}
+
+// JSONReader reads MARC-in-JSON records from newline delimited JSON
+// (one record per line) or from a JSON array of records. It is used in
+// the same manner as the Reader:
+//
+//	rdr := marc21.NewJSONReader(f)
+//	for rdr.Next() {
+//		rec := rdr.Record()
+//		...
+//	}
+//	if err := rdr.Err(); err != nil {
+//		...
+//	}
+type JSONReader struct {
+	r       *bufio.Reader
+	dec     *json.Decoder
+	inArray bool
+	rec     *Record
+	err     error
+	index   int
+}
+
+// NewJSONReader returns a new JSONReader that reads from r
+func NewJSONReader(r io.Reader) *JSONReader {
+	return &JSONReader{
+		r:     bufio.NewReader(r),
+		index: -1,
+	}
+}
+
+// Next advances the JSONReader to the next record, which will then be
+// available through the Record method. It returns false when there are
+// no more records, either by reaching the end of the input or due to an
+// error.
+func (r *JSONReader) Next() bool {
+
+	if r.err != nil {
+		return false
+	}
+
+	r.rec = nil
+
+	if r.dec == nil {
+		r.err = r.start()
+		if r.err != nil {
+			return false
+		}
+	}
+
+	if r.inArray && !r.dec.More() {
+		return false
+	}
+
+	offset := r.dec.InputOffset()
+	rec := new(Record)
+	err := r.dec.Decode(rec)
+	if err == io.EOF && !r.inArray {
+		return false
+	}
+	if err != nil {
+		r.err = &ParseError{Record: r.index + 1, Offset: offset, Err: unexpectedEOF(err)}
+		return false
+	}
+
+	r.rec = rec
+	r.index++
+	return true
+}
+
+// start determines whether the input is a JSON array and prepares the
+// decoder
+func (r *JSONReader) start() error {
+
+	r.dec = json.NewDecoder(r.r)
+
+	for {
+		b, err := r.r.Peek(1)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = r.r.Discard(1)
+			continue
+		case '[':
+			r.inArray = true
+			_, err = r.dec.Token()
+			return err
+		}
+		return nil
+	}
+}
+
+// Record returns the current record
+func (r *JSONReader) Record() *Record {
+	return r.rec
+}
+
+// Err returns the first error that was encountered by the JSONReader.
+// The end of the input is not considered an error.
+func (r *JSONReader) Err() error {
+	return r.err
+}
+
+// Index returns the zero-based position of the current record in the
+// input
+func (r *JSONReader) Index() int {
+	return r.index
+}
+
+// JSONWriter writes MARC-in-JSON records as newline delimited JSON, one
+// record per line
+type JSONWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// NewJSONWriter returns a new JSONWriter that writes to w
+func NewJSONWriter(w io.Writer) *JSONWriter {
+	return &JSONWriter{w: bufio.NewWriter(w)}
+}
+
+// Write writes a record, followed by a newline
+func (w *JSONWriter) Write(rec *Record) error {
+
+	if w.err != nil {
+		return w.err
+	}
+
+	b, err := rec.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	_, w.err = w.w.Write(append(b, '\n'))
+	return w.err
+}
+
+// Flush writes any buffered data to the underlying io.Writer
+func (w *JSONWriter) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = w.w.Flush()
+	return w.err
+}
@@ -0,0 +1,131 @@
+package marc21
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+
+	rec := newTestRecord("1")
+	rec.Datafields[1].Subfields[0].Text = `"Quoted" & <bracketed> Café`
+
+	b, err := rec.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %q", err)
+	}
+
+	expected := `{"leader":"00000nam a2200000 a 4500","fields":[{"001":"1"},` +
+		`{"008":"180115s2017    nyu           000 0 eng d"},` +
+		`{"100":{"ind1":"1","ind2":" ","subfields":[{"a":"Smith, John."}]}},` +
+		`{"245":{"ind1":"1","ind2":"0","subfields":[{"a":"\"Quoted\" & <bracketed> Café"},{"b":"subtitle /"},{"c":"John Smith."}]}}]}`
+	if string(b) != expected {
+		t.Errorf("MarshalJSON() returned %s", b)
+	}
+
+	var got Record
+	err = json.Unmarshal(b, &got)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() failed: %q", err)
+	}
+	if got.String() != rec.String() {
+		t.Errorf("json.Unmarshal() returned %v", got)
+	}
+}
+
+func TestJSONMARC8(t *testing.T) {
+
+	rec := newTestRecord("1")
+	rec.Leader.Text = "00000nam  2200000 a 4500"
+	rec.Datafields[1].Subfields[0].Text = "Caf\xe2e \x1b(NABC"
+	rec.Datafields[1].Subfields[1].Text = "ABC\x1b(B /"
+
+	b, err := rec.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %q", err)
+	}
+
+	var got Record
+	err = json.Unmarshal(b, &got)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() failed: %q", err)
+	}
+
+	if code, _ := got.CharacterCodingScheme(); code != "a" {
+		t.Errorf("MarshalJSON() did not set Leader/09, got %q", code)
+	}
+	sfs := got.Datafields[1].Subfields
+	if sfs[0].Text != "Cafe\u0301 \u0430\u0431\u0446" || sfs[1].Text != "\u0430\u0431\u0446 /" {
+		t.Errorf("MarshalJSON() returned %q, %q", sfs[0].Text, sfs[1].Text)
+	}
+
+	// The record itself is not converted
+	if rec.Leader.Text[9] != ' ' || rec.Datafields[1].Subfields[0].Text != "Caf\xe2e \x1b(NABC" {
+		t.Errorf("MarshalJSON() changed the record")
+	}
+}
+
+func TestJSONUnmarshalErrors(t *testing.T) {
+
+	docs := []string{
+		`{"fields":[]}`,
+		`{"leader":"00000nam a2200000 a 4500","fields":[{"001":"1","002":"2"}]}`,
+		`{"leader":"00000nam a2200000 a 4500","fields":[{"245":{"subfields":[{"a":"x","b":"y"}]}}]}`,
+		`{"leader":"00000nam a2200000 a 4500","fields":[{"245":[]}]}`,
+	}
+
+	for _, doc := range docs {
+		var rec Record
+		err := json.Unmarshal([]byte(doc), &rec)
+		if !errors.Is(err, ErrBadJSON) {
+			t.Errorf("json.Unmarshal(%s) = %v, expected %v", doc, err, ErrBadJSON)
+		}
+	}
+}
+
+func TestJSONReaderWriter(t *testing.T) {
+
+	var b bytes.Buffer
+	w := NewJSONWriter(&b)
+	for _, id := range []string{"1", "2", "3"} {
+		if err := w.Write(newTestRecord(id)); err != nil {
+			t.Fatalf("Write() failed: %q", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() failed: %q", err)
+	}
+	if strings.Count(b.String(), "\n") != 3 {
+		t.Errorf("Write() did not write one record per line:\n%s", b.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	inputs := map[string]string{
+		"ndjson": b.String(),
+		"array":  " [\n" + strings.Join(lines, ",\n") + "\n]\n",
+	}
+
+	for name, input := range inputs {
+		rdr := NewJSONReader(strings.NewReader(input))
+		var ids []string
+		for rdr.Next() {
+			ids = append(ids, rdr.Record().GetControlfield("001"))
+		}
+		if rdr.Err() != nil || len(ids) != 3 || ids[2] != "3" {
+			t.Errorf("%s: read %v, Err() = %v", name, ids, rdr.Err())
+		}
+	}
+
+	rdr := NewJSONReader(strings.NewReader(lines[0] + "\n" + lines[1][:20]))
+	count := 0
+	for rdr.Next() {
+		count++
+	}
+	pe, ok := rdr.Err().(*ParseError)
+	if count != 1 || !ok || pe.Record != 1 {
+		t.Errorf("read %d records, Err() = %v", count, rdr.Err())
+	}
+}
@@ -4,6 +4,10 @@
 
 package marc21
 
+import (
+	"fmt"
+)
+
 /*
 https://www.loc.gov/marc/specifications/specrecstruc.html
 
@@ -82,35 +86,8 @@ func (rec Record) RecordFormatName() string {
 	return n
 }
 
-//  06 - Type of record
-var recordType = map[string]string{
-	// Bibliography
-	"a": "Language material",
-	"c": "Notated music",
-	"d": "Manuscript notated music",
-	"e": "Cartographic material",
-	"f": "Manuscript cartographic material",
-	"g": "Projected medium",
-	"i": "Nonmusical sound recording",
-	"j": "Musical sound recording",
-	"k": "Two-dimensional nonprojectable graphic",
-	"m": "Computer file",
-	"o": "Kit",
-	"p": "Mixed materials",
-	"r": "Three-dimensional artifact or naturally occurring object",
-	"t": "Manuscript language material",
-	// Holding
-	"u": "Unknown",
-	"v": "Multipart item holdings",
-	"x": "Single-part item holdings",
-	"y": "Serial item holdings",
-	// Classification
-	"w": "Classification data",
-	// Authority
-	"z": "Authority data",
-	// Community
-	"q": "Community information",
-}
+// 06 - Type of record, for all formats (from the leader tables)
+var recordType = leaderCodes("Type of record")
 
 // RecordType returns the one character code and label indicating
 // the "06 - Type of record" for the record. Use RecordFormat
@@ -119,10 +96,21 @@ func (rec Record) RecordType() (code, label string) {
 	return shortCodeLookup(recordType, rec.Leader.Text, 6)
 }
 
-//  09 - Character coding scheme
-var characterCodingScheme = map[string]string{
-	" ": "MARC-8",
-	"a": "UCS/Unicode",
+// 09 - Character coding scheme
+var characterCodingScheme = leaderCodes("Character coding scheme")
+
+// leaderCodes returns the codes of the named leader element, merged
+// across all formats
+func leaderCodes(name string) map[string]string {
+	codes := make(map[string]string)
+	for _, elements := range leaderElements {
+		if e, ok := findElement(elements, name); ok {
+			for k, v := range e.Codes {
+				codes[k] = v
+			}
+		}
+	}
+	return codes
 }
 
 // CharacterCodingScheme returns the code and label indicating the
@@ -187,6 +175,70 @@ func (rec Record) BibliographyMaterialType() (code, label string) {
 	return code, label
 }
 
+////////////////////////////////////////////////////////////////////////
+// Leader elements by record format (see leadertables.go, which also
+// holds the generated accessors for the coded elements)
+
+// LeaderElements returns the values of the elements of the leader as
+// defined for the format of the record. Nothing is returned for records
+// of an unknown format.
+func (rec Record) LeaderElements() []FixedValue {
+	return decodeElements(leaderElements[rec.RecordFormat()], rec.Leader.Text)
+}
+
+// leaderValue returns the code and label of the named leader element,
+// if the element is defined for the format of the record
+func (rec Record) leaderValue(name string) (code, label string) {
+	e, ok := findElement(leaderElements[rec.RecordFormat()], name)
+	if !ok {
+		return "", ""
+	}
+	v := e.decode(rec.Leader.Text)
+	return v.Code, v.Label
+}
+
+// setLeaderValue sets the named leader element. The code must be valid
+// for the format of the record.
+func (rec *Record) setLeaderValue(name, code string) error {
+
+	if len(rec.Leader.Text) != leaderLen {
+		return &ValidationError{Record: -1, Err: ErrBadLeader}
+	}
+
+	e, ok := findElement(leaderElements[rec.RecordFormat()], name)
+	if !ok {
+		return fmt.Errorf("%w: %s is not defined for %s records", ErrBadFixedValue, name, rec.RecordFormatName())
+	}
+
+	text, err := e.encode(rec.Leader.Text, code)
+	if err != nil {
+		return err
+	}
+	rec.Leader.Text = text
+	return nil
+}
+
+// SetRecordType sets the "06 - Type of record" of the record. As the
+// type of record determines the record format, any of the types of
+// record is accepted.
+func (rec *Record) SetRecordType(code string) error {
+
+	if len(rec.Leader.Text) != leaderLen {
+		return &ValidationError{Record: -1, Err: ErrBadLeader}
+	}
+	if _, ok := recordType[code]; !ok || len(code) != 1 {
+		return fmt.Errorf("%w: %q is not a valid Type of record", ErrBadFixedValue, code)
+	}
+	rec.Leader.Text = rec.Leader.Text[:6] + code + rec.Leader.Text[7:]
+	return nil
+}
+
+// SetCharacterCodingScheme sets the "09 - Character coding scheme" of
+// the record. Note that this does not convert the record.
+func (rec *Record) SetCharacterCodingScheme(code string) error {
+	return rec.setLeaderValue("Character coding scheme", code)
+}
+
 ////////////////////////////////////////////////////////////////////////
 
 // GetText returns the text for the leader
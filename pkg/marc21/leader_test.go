@@ -0,0 +1,100 @@
+package marc21
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLeaderTables(t *testing.T) {
+
+	for format, elements := range leaderElements {
+		used := make([]bool, leaderLen)
+		for _, e := range elements {
+			if e.Offset+e.Width > leaderLen || e.Width%e.CodeWidth != 0 {
+				t.Errorf("%s: bad element %q", marcFormatName[format], e.Name)
+				continue
+			}
+			for i := e.Offset; i < e.Offset+e.Width; i++ {
+				if used[i] {
+					t.Errorf("%s: element %q overlaps position %d", marcFormatName[format], e.Name, i)
+				}
+				used[i] = true
+			}
+			for code := range e.Codes {
+				if len(code) != e.CodeWidth {
+					t.Errorf("%s: element %q has code %q", marcFormatName[format], e.Name, code)
+				}
+			}
+		}
+		for i, u := range used {
+			if !u {
+				t.Errorf("%s: position %d is not defined", marcFormatName[format], i)
+			}
+		}
+	}
+}
+
+func TestLeaderElements(t *testing.T) {
+
+	rec := &Record{Leader: Leader{Text: "01234cam a2200289 i 4500"}}
+
+	expected := map[string]string{
+		"Record status":                   "Corrected or revised",
+		"Bibliographic level":             "Monograph/Item",
+		"Type of control":                 "No specified type",
+		"Character coding scheme":         "UCS/Unicode",
+		"Encoding level":                  "Full level",
+		"Descriptive cataloging form":     "ISBD punctuation included",
+		"Multipart resource record level": "Not specified or not applicable",
+	}
+	for _, v := range rec.LeaderElements() {
+		if !v.Valid {
+			t.Errorf("%v is not valid", v)
+		}
+		if label, ok := expected[v.Name]; ok && v.Label != label {
+			t.Errorf("%v, expected %q", v, label)
+		}
+	}
+
+	for _, fn := range []func() (string, string){
+		rec.KindOfData, rec.ItemInformation, rec.PunctuationPolicy,
+	} {
+		if code, label := fn(); code != "" || label != "" {
+			t.Errorf("returned %q %q for a Bibliography record", code, label)
+		}
+	}
+
+	if err := rec.SetEncodingLevel("7"); err != nil {
+		t.Errorf("SetEncodingLevel() failed: %q", err)
+	}
+	if _, label := rec.EncodingLevel(); label != "Minimal level" {
+		t.Errorf("EncodingLevel() = %q", label)
+	}
+	if err := rec.SetBibliographicLevel("x"); !errors.Is(err, ErrBadFixedValue) {
+		t.Errorf("SetBibliographicLevel() = %v", err)
+	}
+	if err := rec.SetRecordStatus("nn"); !errors.Is(err, ErrBadFixedValue) {
+		t.Errorf("SetRecordStatus() = %v", err)
+	}
+
+	// Changing the type of record changes the format, and so the
+	// elements that may be set
+	if err := rec.SetRecordType("z"); err != nil {
+		t.Fatalf("SetRecordType() failed: %q", err)
+	}
+	if err := rec.SetEncodingLevel("n"); err != nil {
+		t.Errorf("SetEncodingLevel() failed: %q", err)
+	}
+	if err := rec.SetPunctuationPolicy("c"); err != nil {
+		t.Errorf("SetPunctuationPolicy() failed: %q", err)
+	}
+	if err := rec.SetMultipartResourceRecordLevel("a"); !errors.Is(err, ErrBadFixedValue) {
+		t.Errorf("SetMultipartResourceRecordLevel() = %v", err)
+	}
+	if code, _ := rec.BibliographicLevel(); code != "" {
+		t.Errorf("BibliographicLevel() = %q for an Authority record", code)
+	}
+	if rec.Leader.Text != "01234czm a2200289nc 4500" {
+		t.Errorf("leader is %q", rec.Leader.Text)
+	}
+}
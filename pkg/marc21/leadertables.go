@@ -0,0 +1,399 @@
+// Code generated by codegen/gen-tables.go; DO NOT EDIT.
+
+package marc21
+
+// The leader elements for each record format, from the LoC field lists
+//
+//	http://www.loc.gov/marc/bibliographic/ecbdlist.html
+//	http://www.loc.gov/marc/holdings/echdlist.html
+//	http://www.loc.gov/marc/authority/ecadlist.html
+//	http://www.loc.gov/marc/classification/eccdlist.html
+//	http://www.loc.gov/marc/community/eccilist.html
+var leaderElements = map[int][]fixedElement{
+	Bibliography: {
+		{Name: "Record length", Offset: 0, Width: 5, CodeWidth: 5},
+		{Name: "Record status", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"a": "Increase in encoding level",
+			"c": "Corrected or revised",
+			"d": "Deleted",
+			"n": "New",
+			"p": "Increase in encoding level from prepublication",
+		}},
+		{Name: "Type of record", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"a": "Language material",
+			"c": "Notated music",
+			"d": "Manuscript notated music",
+			"e": "Cartographic material",
+			"f": "Manuscript cartographic material",
+			"g": "Projected medium",
+			"i": "Nonmusical sound recording",
+			"j": "Musical sound recording",
+			"k": "Two-dimensional nonprojectable graphic",
+			"m": "Computer file",
+			"o": "Kit",
+			"p": "Mixed materials",
+			"r": "Three-dimensional artifact or naturally occurring object",
+			"t": "Manuscript language material",
+		}},
+		{Name: "Bibliographic level", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"a": "Monographic component part",
+			"b": "Serial component part",
+			"c": "Collection",
+			"d": "Subunit",
+			"i": "Integrating resource",
+			"m": "Monograph/Item",
+			"s": "Serial",
+		}},
+		{Name: "Type of control", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "No specified type",
+			"a": "Archival",
+		}},
+		{Name: "Character coding scheme", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "MARC-8",
+			"a": "UCS/Unicode",
+		}},
+		{Name: "Indicator count", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for indicators",
+		}},
+		{Name: "Subfield code count", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for a subfield code",
+		}},
+		{Name: "Base address of data", Offset: 12, Width: 5, CodeWidth: 5},
+		{Name: "Encoding level", Offset: 17, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "Full level",
+			"1": "Full level, material not examined",
+			"2": "Less-than-full level, material not examined",
+			"3": "Abbreviated level",
+			"4": "Core level",
+			"5": "Partial (preliminary) level",
+			"7": "Minimal level",
+			"8": "Prepublication level",
+			"u": "Unknown",
+			"z": "Not applicable",
+		}},
+		{Name: "Descriptive cataloging form", Offset: 18, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "Non-ISBD",
+			"a": "AACR 2",
+			"c": "ISBD punctuation omitted",
+			"i": "ISBD punctuation included",
+			"n": "Non-ISBD punctuation omitted",
+			"u": "Unknown",
+		}},
+		{Name: "Multipart resource record level", Offset: 19, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "Not specified or not applicable",
+			"a": "Set",
+			"b": "Part with independent title",
+			"c": "Part with dependent title",
+		}},
+		{Name: "Length of the length-of-field portion", Offset: 20, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"4": "Number of characters in the length-of-field portion of a Directory entry",
+		}},
+		{Name: "Length of the starting-character-position portion", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"5": "Number of characters in the starting-character-position portion of a Directory entry",
+		}},
+		{Name: "Length of the implementation-defined portion", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Number of characters in the implementation-defined portion of a Directory entry",
+		}},
+		{Name: "Undefined", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Undefined",
+		}},
+	},
+	Holdings: {
+		{Name: "Record length", Offset: 0, Width: 5, CodeWidth: 5},
+		{Name: "Record status", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"c": "Corrected or revised",
+			"d": "Deleted",
+			"n": "New",
+		}},
+		{Name: "Type of record", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"u": "Unknown",
+			"v": "Multipart item holdings",
+			"x": "Single-part item holdings",
+			"y": "Serial item holdings",
+		}},
+		{Name: "Undefined character positions", Offset: 7, Width: 2, CodeWidth: 1, Codes: map[string]string{
+			" ": "Undefined",
+		}},
+		{Name: "Character coding scheme", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "MARC-8",
+			"a": "UCS/Unicode",
+		}},
+		{Name: "Indicator count", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for indicators",
+		}},
+		{Name: "Subfield code count", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for a subfield code",
+		}},
+		{Name: "Base address of data", Offset: 12, Width: 5, CodeWidth: 5},
+		{Name: "Encoding level", Offset: 17, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"1": "Holdings level 1",
+			"2": "Holdings level 2",
+			"3": "Holdings level 3",
+			"4": "Holdings level 4",
+			"5": "Holdings level 4 with piece designation",
+			"m": "Mixed level",
+			"u": "Unknown",
+			"z": "Other level",
+		}},
+		{Name: "Item information in record", Offset: 18, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"i": "Item information",
+			"n": "No item information",
+		}},
+		{Name: "Undefined character position", Offset: 19, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "Undefined",
+		}},
+		{Name: "Length of the length-of-field portion", Offset: 20, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"4": "Number of characters in the length-of-field portion of a Directory entry",
+		}},
+		{Name: "Length of the starting-character-position portion", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"5": "Number of characters in the starting-character-position portion of a Directory entry",
+		}},
+		{Name: "Length of the implementation-defined portion", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Number of characters in the implementation-defined portion of a Directory entry",
+		}},
+		{Name: "Undefined", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Undefined",
+		}},
+	},
+	Authority: {
+		{Name: "Record length", Offset: 0, Width: 5, CodeWidth: 5},
+		{Name: "Record status", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"a": "Increase in encoding level",
+			"c": "Corrected or revised",
+			"d": "Deleted",
+			"n": "New",
+			"o": "Obsolete",
+			"s": "Deleted; heading split into two or more headings",
+			"x": "Deleted; heading replaced by another heading",
+		}},
+		{Name: "Type of record", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"z": "Authority data",
+		}},
+		{Name: "Undefined character positions", Offset: 7, Width: 2, CodeWidth: 1, Codes: map[string]string{
+			" ": "Undefined",
+		}},
+		{Name: "Character coding scheme", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "MARC-8",
+			"a": "UCS/Unicode",
+		}},
+		{Name: "Indicator count", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for indicators",
+		}},
+		{Name: "Subfield code count", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for a subfield code",
+		}},
+		{Name: "Base address of data", Offset: 12, Width: 5, CodeWidth: 5},
+		{Name: "Encoding level", Offset: 17, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"n": "Complete authority record",
+			"o": "Incomplete authority record",
+		}},
+		{Name: "Punctuation policy", Offset: 18, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "No information provided",
+			"c": "Punctuation omitted",
+			"i": "Punctuation included",
+			"u": "Unknown",
+		}},
+		{Name: "Undefined character position", Offset: 19, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "Undefined",
+		}},
+		{Name: "Length of the length-of-field portion", Offset: 20, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"4": "Number of characters in the length-of-field portion of a Directory entry",
+		}},
+		{Name: "Length of the starting-character-position portion", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"5": "Number of characters in the starting-character-position portion of a Directory entry",
+		}},
+		{Name: "Length of the implementation-defined portion", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Number of characters in the implementation-defined portion of a Directory entry",
+		}},
+		{Name: "Undefined", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Undefined",
+		}},
+	},
+	Classification: {
+		{Name: "Record length", Offset: 0, Width: 5, CodeWidth: 5},
+		{Name: "Record status", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"a": "Increase in encoding level",
+			"c": "Corrected or revised",
+			"d": "Deleted",
+			"n": "New",
+		}},
+		{Name: "Type of record", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"w": "Classification data",
+		}},
+		{Name: "Undefined character positions", Offset: 7, Width: 2, CodeWidth: 1, Codes: map[string]string{
+			" ": "Undefined",
+		}},
+		{Name: "Character coding scheme", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "MARC-8",
+			"a": "UCS/Unicode",
+		}},
+		{Name: "Indicator count", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for indicators",
+		}},
+		{Name: "Subfield code count", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for a subfield code",
+		}},
+		{Name: "Base address of data", Offset: 12, Width: 5, CodeWidth: 5},
+		{Name: "Encoding level", Offset: 17, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"n": "Complete classification record",
+			"o": "Incomplete classification record",
+		}},
+		{Name: "Undefined character positions", Offset: 18, Width: 2, CodeWidth: 1, Codes: map[string]string{
+			" ": "Undefined",
+		}},
+		{Name: "Length of the length-of-field portion", Offset: 20, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"4": "Number of characters in the length-of-field portion of a Directory entry",
+		}},
+		{Name: "Length of the starting-character-position portion", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"5": "Number of characters in the starting-character-position portion of a Directory entry",
+		}},
+		{Name: "Length of the implementation-defined portion", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Number of characters in the implementation-defined portion of a Directory entry",
+		}},
+		{Name: "Undefined", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Undefined",
+		}},
+	},
+	Community: {
+		{Name: "Record length", Offset: 0, Width: 5, CodeWidth: 5},
+		{Name: "Record status", Offset: 5, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"c": "Corrected or revised",
+			"d": "Deleted",
+			"n": "New",
+		}},
+		{Name: "Type of record", Offset: 6, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"q": "Community information",
+		}},
+		{Name: "Kind of data", Offset: 7, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"n": "Individual",
+			"o": "Organization",
+			"p": "Program or service",
+			"q": "Event",
+			"z": "Other",
+		}},
+		{Name: "Undefined character position", Offset: 8, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "Undefined",
+		}},
+		{Name: "Character coding scheme", Offset: 9, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "MARC-8",
+			"a": "UCS/Unicode",
+		}},
+		{Name: "Indicator count", Offset: 10, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for indicators",
+		}},
+		{Name: "Subfield code count", Offset: 11, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"2": "Number of character positions used for a subfield code",
+		}},
+		{Name: "Base address of data", Offset: 12, Width: 5, CodeWidth: 5},
+		{Name: "Encoding level", Offset: 17, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			" ": "Full level",
+			"u": "Unknown",
+			"z": "Not applicable",
+		}},
+		{Name: "Undefined character positions", Offset: 18, Width: 2, CodeWidth: 1, Codes: map[string]string{
+			" ": "Undefined",
+		}},
+		{Name: "Length of the length-of-field portion", Offset: 20, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"4": "Number of characters in the length-of-field portion of a Directory entry",
+		}},
+		{Name: "Length of the starting-character-position portion", Offset: 21, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"5": "Number of characters in the starting-character-position portion of a Directory entry",
+		}},
+		{Name: "Length of the implementation-defined portion", Offset: 22, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Number of characters in the implementation-defined portion of a Directory entry",
+		}},
+		{Name: "Undefined", Offset: 23, Width: 1, CodeWidth: 1, Codes: map[string]string{
+			"0": "Undefined",
+		}},
+	},
+}
+
+// RecordStatus returns the code and label indicating the "05 - Record status" of the record
+func (rec Record) RecordStatus() (code, label string) {
+	return rec.leaderValue("Record status")
+}
+
+// SetRecordStatus sets the "05 - Record status" of the record
+func (rec *Record) SetRecordStatus(code string) error {
+	return rec.setLeaderValue("Record status", code)
+}
+
+// BibliographicLevel returns the code and label indicating the "07 - Bibliographic level" of a Bibliography record
+func (rec Record) BibliographicLevel() (code, label string) {
+	return rec.leaderValue("Bibliographic level")
+}
+
+// SetBibliographicLevel sets the "07 - Bibliographic level" of a Bibliography record
+func (rec *Record) SetBibliographicLevel(code string) error {
+	return rec.setLeaderValue("Bibliographic level", code)
+}
+
+// KindOfData returns the code and label indicating the "07 - Kind of data" of a Community Information record
+func (rec Record) KindOfData() (code, label string) {
+	return rec.leaderValue("Kind of data")
+}
+
+// SetKindOfData sets the "07 - Kind of data" of a Community Information record
+func (rec *Record) SetKindOfData(code string) error {
+	return rec.setLeaderValue("Kind of data", code)
+}
+
+// TypeOfControl returns the code and label indicating the "08 - Type of control" of a Bibliography record
+func (rec Record) TypeOfControl() (code, label string) {
+	return rec.leaderValue("Type of control")
+}
+
+// SetTypeOfControl sets the "08 - Type of control" of a Bibliography record
+func (rec *Record) SetTypeOfControl(code string) error {
+	return rec.setLeaderValue("Type of control", code)
+}
+
+// EncodingLevel returns the code and label indicating the "17 - Encoding level" of the record
+func (rec Record) EncodingLevel() (code, label string) {
+	return rec.leaderValue("Encoding level")
+}
+
+// SetEncodingLevel sets the "17 - Encoding level" of the record
+func (rec *Record) SetEncodingLevel(code string) error {
+	return rec.setLeaderValue("Encoding level", code)
+}
+
+// DescriptiveCatalogingForm returns the code and label indicating the "18 - Descriptive cataloging form" of a Bibliography record
+func (rec Record) DescriptiveCatalogingForm() (code, label string) {
+	return rec.leaderValue("Descriptive cataloging form")
+}
+
+// SetDescriptiveCatalogingForm sets the "18 - Descriptive cataloging form" of a Bibliography record
+func (rec *Record) SetDescriptiveCatalogingForm(code string) error {
+	return rec.setLeaderValue("Descriptive cataloging form", code)
+}
+
+// ItemInformation returns the code and label indicating the "18 - Item information in record" of a Holdings record
+func (rec Record) ItemInformation() (code, label string) {
+	return rec.leaderValue("Item information in record")
+}
+
+// SetItemInformation sets the "18 - Item information in record" of a Holdings record
+func (rec *Record) SetItemInformation(code string) error {
+	return rec.setLeaderValue("Item information in record", code)
+}
+
+// PunctuationPolicy returns the code and label indicating the "18 - Punctuation policy" of an Authority record
+func (rec Record) PunctuationPolicy() (code, label string) {
+	return rec.leaderValue("Punctuation policy")
+}
+
+// SetPunctuationPolicy sets the "18 - Punctuation policy" of an Authority record
+func (rec *Record) SetPunctuationPolicy(code string) error {
+	return rec.setLeaderValue("Punctuation policy", code)
+}
+
+// MultipartResourceRecordLevel returns the code and label indicating the "19 - Multipart resource record level" of a Bibliography record
+func (rec Record) MultipartResourceRecordLevel() (code, label string) {
+	return rec.leaderValue("Multipart resource record level")
+}
+
+// SetMultipartResourceRecordLevel sets the "19 - Multipart resource record level" of a Bibliography record
+func (rec *Record) SetMultipartResourceRecordLevel(code string) error {
+	return rec.setLeaderValue("Multipart resource record level", code)
+}
@@ -0,0 +1,55 @@
+// Code generated by codegen/gen-tables.go; DO NOT EDIT.
+
+package marc21
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLeaderAccessors(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		leader string
+		get    func(Record) (string, string)
+		set    func(*Record, string) error
+		code   string
+		label  string
+	}{
+		{"RecordStatus/Bibliography", "00000nam a2200000   4500", Record.RecordStatus, (*Record).SetRecordStatus, "a", "Increase in encoding level"},
+		{"RecordStatus/Holdings", "00000nx  a2200000   4500", Record.RecordStatus, (*Record).SetRecordStatus, "c", "Corrected or revised"},
+		{"RecordStatus/Authority", "00000nz  a2200000n  4500", Record.RecordStatus, (*Record).SetRecordStatus, "a", "Increase in encoding level"},
+		{"RecordStatus/Classification", "00000nw  a2200000n  4500", Record.RecordStatus, (*Record).SetRecordStatus, "a", "Increase in encoding level"},
+		{"RecordStatus/Community Information", "00000nq  a2200000n  4500", Record.RecordStatus, (*Record).SetRecordStatus, "c", "Corrected or revised"},
+		{"BibliographicLevel/Bibliography", "00000nam a2200000   4500", Record.BibliographicLevel, (*Record).SetBibliographicLevel, "a", "Monographic component part"},
+		{"KindOfData/Community Information", "00000nq  a2200000n  4500", Record.KindOfData, (*Record).SetKindOfData, "n", "Individual"},
+		{"TypeOfControl/Bibliography", "00000nam a2200000   4500", Record.TypeOfControl, (*Record).SetTypeOfControl, " ", "No specified type"},
+		{"EncodingLevel/Bibliography", "00000nam a2200000   4500", Record.EncodingLevel, (*Record).SetEncodingLevel, " ", "Full level"},
+		{"EncodingLevel/Holdings", "00000nx  a2200000   4500", Record.EncodingLevel, (*Record).SetEncodingLevel, "1", "Holdings level 1"},
+		{"EncodingLevel/Authority", "00000nz  a2200000n  4500", Record.EncodingLevel, (*Record).SetEncodingLevel, "n", "Complete authority record"},
+		{"EncodingLevel/Classification", "00000nw  a2200000n  4500", Record.EncodingLevel, (*Record).SetEncodingLevel, "n", "Complete classification record"},
+		{"EncodingLevel/Community Information", "00000nq  a2200000n  4500", Record.EncodingLevel, (*Record).SetEncodingLevel, " ", "Full level"},
+		{"DescriptiveCatalogingForm/Bibliography", "00000nam a2200000   4500", Record.DescriptiveCatalogingForm, (*Record).SetDescriptiveCatalogingForm, " ", "Non-ISBD"},
+		{"ItemInformation/Holdings", "00000nx  a2200000   4500", Record.ItemInformation, (*Record).SetItemInformation, "i", "Item information"},
+		{"PunctuationPolicy/Authority", "00000nz  a2200000n  4500", Record.PunctuationPolicy, (*Record).SetPunctuationPolicy, " ", "No information provided"},
+		{"MultipartResourceRecordLevel/Bibliography", "00000nam a2200000   4500", Record.MultipartResourceRecordLevel, (*Record).SetMultipartResourceRecordLevel, " ", "Not specified or not applicable"},
+	}
+
+	for _, tc := range tests {
+		rec := Record{Leader: Leader{Text: tc.leader}}
+		if err := tc.set(&rec, tc.code); err != nil {
+			t.Errorf("%s: set %q failed: %q", tc.name, tc.code, err)
+			continue
+		}
+		code, label := tc.get(rec)
+		if code != tc.code || label != tc.label {
+			t.Errorf("%s = %q, %q, expected %q, %q", tc.name, code, label, tc.code, tc.label)
+		}
+		bad := strings.Repeat("!", len(tc.code))
+		if err := tc.set(&rec, bad); !errors.Is(err, ErrBadFixedValue) {
+			t.Errorf("%s: set %q returned %v", tc.name, bad, err)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package lint
+
+import (
+	"fmt"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+/*
+https://www.loc.gov/marc/specifications/specrecstruc.html
+
+    Content designation: The codes and conventions established to
+    identify explicitly and characterize further the data elements
+    within a record and to support the manipulation of that data.
+    Content designators defined for the MARC 21 formats are: tags,
+    indicators, and subfield codes.
+
+The datafield definitions come from the LoC field lists (see
+marc21.DatafieldDefinition).
+*/
+
+// contentRules check the tags, indicators and subfield codes of a record
+var contentRules = []Rule{
+	{
+		Name:        "record-format",
+		Description: "The type of record (leader/06) identifies a record format",
+		Check:       checkRecordFormat,
+	},
+	{
+		Name:        "undefined-tag",
+		Description: "Fields are defined for the record format",
+		Check:       checkUndefinedTags,
+	},
+	{
+		Name:        "repeated-field",
+		Description: "Non-repeatable fields do not repeat",
+		Check:       checkRepeatedFields,
+	},
+	{
+		Name:        "invalid-indicator",
+		Description: "Indicator values are defined for the field",
+		Check:       checkIndicators,
+	},
+	{
+		Name:        "undefined-subfield",
+		Description: "Subfield codes are defined for the field",
+		Check:       checkUndefinedSubfields,
+	},
+	{
+		Name:        "repeated-subfield",
+		Description: "Non-repeatable subfields do not repeat within a field",
+		Check:       checkRepeatedSubfields,
+	},
+	{
+		Name:        "obsolete",
+		Description: "Tags, indicator values and subfield codes are not obsolete",
+		Check:       checkObsolete,
+	},
+}
+
+// controlfieldTags are the controlfields defined for each record format
+// and whether or not they are repeatable
+var controlfieldTags = map[int]map[string]bool{
+	marc21.Bibliography:   {"001": false, "003": false, "005": false, "006": true, "007": true, "008": false},
+	marc21.Holdings:       {"001": false, "003": false, "005": false, "007": true, "008": false},
+	marc21.Authority:      {"001": false, "003": false, "005": false, "008": false},
+	marc21.Classification: {"001": false, "003": false, "005": false, "008": false},
+	marc21.Community:      {"001": false, "003": false, "005": false, "008": false},
+}
+
+// field is a controlfield (df is nil) or datafield (cf is nil) of a
+// record along with its occurrence and definition. Controlfield
+// definitions only have a Tag and Repeatable.
+type field struct {
+	tag     string
+	occ     int
+	cf      *marc21.Controlfield
+	df      *marc21.Datafield
+	def     marc21.DatafieldDef
+	defined bool
+}
+
+// visitFields calls visit for each field of a record. Records of an
+// unknown format are not visited.
+func visitFields(rec *marc21.Record, visit func(f field)) {
+
+	format := rec.RecordFormat()
+	if format == marc21.FmtUnknown {
+		return
+	}
+
+	occurrences := make(map[string]int)
+
+	for _, cf := range rec.Controlfields {
+		occurrences[cf.Tag]++
+		repeatable, ok := controlfieldTags[format][cf.Tag]
+		def := marc21.DatafieldDef{Tag: cf.Tag, Repeatable: repeatable}
+		visit(field{tag: cf.Tag, occ: occurrences[cf.Tag], cf: cf, def: def, defined: ok})
+	}
+
+	for _, df := range rec.Datafields {
+		occurrences[df.Tag]++
+		def, ok := marc21.DatafieldDefinition(format, df.Tag)
+		visit(field{tag: df.Tag, occ: occurrences[df.Tag], df: df, def: def, defined: ok})
+	}
+}
+
+func checkRecordFormat(rec *marc21.Record) (findings []Finding) {
+	if rec.RecordFormat() == marc21.FmtUnknown {
+		code, _ := rec.RecordType()
+		findings = append(findings, Finding{
+			Tag:      "LDR",
+			Severity: Error,
+			Message:  fmt.Sprintf("type of record (leader/06) %q is not defined", code),
+		})
+	}
+	return findings
+}
+
+func checkUndefinedTags(rec *marc21.Record) (findings []Finding) {
+	name := rec.RecordFormatName()
+	visitFields(rec, func(f field) {
+		if !f.defined {
+			findings = append(findings, Finding{
+				Tag:        f.tag,
+				Occurrence: f.occ,
+				Severity:   Error,
+				Message:    fmt.Sprintf("tag is not defined for %s records", name),
+			})
+		}
+	})
+	return findings
+}
+
+func checkRepeatedFields(rec *marc21.Record) (findings []Finding) {
+	visitFields(rec, func(f field) {
+		if f.defined && f.occ > 1 && !f.def.Repeatable {
+			findings = append(findings, Finding{
+				Tag:        f.tag,
+				Occurrence: f.occ,
+				Severity:   Error,
+				Message:    "field is not repeatable",
+			})
+		}
+	})
+	return findings
+}
+
+func checkIndicators(rec *marc21.Record) (findings []Finding) {
+	visitFields(rec, func(f field) {
+		if f.df == nil || !f.defined {
+			return
+		}
+		for pos, ind := range []string{f.df.GetInd1(), f.df.GetInd2()} {
+			if _, ok := f.def.Indicator(pos+1, ind); !ok {
+				findings = append(findings, Finding{
+					Tag:        f.tag,
+					Occurrence: f.occ,
+					Severity:   Error,
+					Message:    fmt.Sprintf("indicator %d value %q is not defined", pos+1, ind),
+				})
+			}
+		}
+	})
+	return findings
+}
+
+func checkUndefinedSubfields(rec *marc21.Record) (findings []Finding) {
+	visitFields(rec, func(f field) {
+		if f.df == nil || !f.defined {
+			return
+		}
+		for _, sf := range f.df.Subfields {
+			if _, ok := f.def.Subfield(sf.Code); !ok {
+				findings = append(findings, Finding{
+					Tag:        f.tag,
+					Occurrence: f.occ,
+					Subfield:   sf.Code,
+					Severity:   Error,
+					Message:    "subfield code is not defined",
+				})
+			}
+		}
+	})
+	return findings
+}
+
+func checkRepeatedSubfields(rec *marc21.Record) (findings []Finding) {
+	visitFields(rec, func(f field) {
+		if f.df == nil || !f.defined {
+			return
+		}
+		seen := make(map[string]bool)
+		for _, sf := range f.df.Subfields {
+			sd, ok := f.def.Subfield(sf.Code)
+			if ok && seen[sf.Code] && !sd.Repeatable {
+				findings = append(findings, Finding{
+					Tag:        f.tag,
+					Occurrence: f.occ,
+					Subfield:   sf.Code,
+					Severity:   Error,
+					Message:    "subfield is not repeatable",
+				})
+			}
+			seen[sf.Code] = true
+		}
+	})
+	return findings
+}
+
+func checkObsolete(rec *marc21.Record) (findings []Finding) {
+	format := rec.RecordFormat()
+	visitFields(rec, func(f field) {
+		if f.df == nil || !f.defined {
+			return
+		}
+		migration := migrationFor(format, f.df)
+		if f.def.Obsolete {
+			findings = append(findings, Finding{
+				Tag:        f.tag,
+				Occurrence: f.occ,
+				Severity:   Warning,
+				Message:    fmt.Sprintf("tag is obsolete (%s)", f.def.Label),
+				Migration:  migration,
+			})
+			return
+		}
+		for pos, ind := range []string{f.df.GetInd1(), f.df.GetInd2()} {
+			if v, ok := f.def.Indicator(pos+1, ind); ok && v.Obsolete {
+				findings = append(findings, Finding{
+					Tag:        f.tag,
+					Occurrence: f.occ,
+					Severity:   Warning,
+					Message:    fmt.Sprintf("indicator %d value %q is obsolete (%s)", pos+1, ind, v.Label),
+				})
+			}
+		}
+		reported := make(map[string]bool)
+		for _, sf := range f.df.Subfields {
+			if sd, ok := f.def.Subfield(sf.Code); ok && sd.Obsolete && !reported[sf.Code] {
+				findings = append(findings, Finding{
+					Tag:        f.tag,
+					Occurrence: f.occ,
+					Subfield:   sf.Code,
+					Severity:   Warning,
+					Message:    fmt.Sprintf("subfield code is obsolete (%s)", sd.Label),
+					Migration:  migration,
+				})
+				reported[sf.Code] = true
+			}
+		}
+	})
+	return findings
+}
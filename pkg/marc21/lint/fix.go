@@ -0,0 +1,347 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+/*
+The fixes only make corrections that do not require knowing what the
+data should have been: whitespace is trimmed, empty fields and subfields
+are dropped, repeated non-repeatable subfields are reduced to one, the
+008 is padded (with blanks) or truncated to the length defined for the
+record, and the lengths in the leader are recomputed. Missing data is
+not added.
+
+There does not appear to be a standard convention for which of the
+repeated non-repeatable subfields to keep, so that is left to the
+KeepPolicy of the Fixer.
+*/
+
+// KeepPolicy indicates which of the repeated non-repeatable subfields
+// of a field to keep
+type KeepPolicy int
+
+const (
+	// KeepFirst keeps the first of the repeated subfields
+	KeepFirst KeepPolicy = iota
+	// KeepLast keeps the last of the repeated subfields
+	KeepLast
+)
+
+func (k KeepPolicy) String() string {
+	if k == KeepLast {
+		return "last"
+	}
+	return "first"
+}
+
+// Change is a single correction that was made to a record
+type Change struct {
+	// RecordID is the control number (001) of the record
+	RecordID string
+	// Tag is the tag of the field that was changed, or "LDR" for the
+	// leader
+	Tag string
+	// Occurrence is the one-based occurrence of the field among the
+	// fields of the record having the same tag (before the change), or
+	// 0 for the leader
+	Occurrence int
+	// Subfield is the code of the subfield that was changed, if any
+	Subfield string
+	// Fix is the name of the fix that made the change
+	Fix string
+	// Message describes the change
+	Message string
+}
+
+// Implement the Stringer interface for "Pretty-printing"
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s [%s]", location(c.RecordID, c.Tag, c.Occurrence, c.Subfield), c.Message, c.Fix)
+}
+
+// Fix is a named correction that is applied to each record
+type Fix struct {
+	// Name identifies the fix in the changes that it reports
+	Name string
+	// Description briefly describes the correction
+	Description string
+	// Apply corrects a record and returns the changes made. The
+	// RecordID and Fix of the changes are filled in by the Fixer.
+	Apply func(rec *marc21.Record, fx *Fixer) []Change
+}
+
+// Fixer corrects records using a set of fixes
+type Fixer struct {
+	Fixes []Fix
+	// Keep indicates which of the repeated non-repeatable subfields
+	// to keep
+	Keep KeepPolicy
+}
+
+// fixes are the fixes, in the order that they are applied
+var fixes = []Fix{
+	{
+		Name:        "trim-whitespace",
+		Description: "Trim leading and trailing whitespace from subfields and the 001, 003 and 005",
+		Apply:       fixWhitespace,
+	},
+	{
+		Name:        "drop-empty",
+		Description: "Drop empty subfields, and fields that are empty or have no subfields",
+		Apply:       fixEmpty,
+	},
+	{
+		Name:        "repeated-subfield",
+		Description: "Keep only one of the repeated non-repeatable subfields of a field",
+		Apply:       fixRepeatedSubfields,
+	},
+	{
+		Name:        "008-length",
+		Description: "Pad or truncate the 008 to the length defined for the record",
+		Apply:       fix008Length,
+	},
+	{
+		Name:        "leader-lengths",
+		Description: "Recompute the record length, base address of data and entry map of the leader",
+		Apply:       fixLeaderLengths,
+	},
+}
+
+// NewFixer returns a Fixer for the named fixes, or for all of the
+// DefaultFixes if no names are given
+func NewFixer(names ...string) (*Fixer, error) {
+
+	if len(names) == 0 {
+		return &Fixer{Fixes: DefaultFixes()}, nil
+	}
+
+	fx := &Fixer{}
+	for _, name := range names {
+		f, ok := findFix(name)
+		if !ok {
+			return nil, fmt.Errorf("lint: unknown fix %q", name)
+		}
+		fx.Fixes = append(fx.Fixes, f)
+	}
+	return fx, nil
+}
+
+// DefaultFixes returns all of the fixes, in the order that they are
+// applied
+func DefaultFixes() []Fix {
+	return append([]Fix{}, fixes...)
+}
+
+// findFix returns the default fix having the specified name
+func findFix(name string) (Fix, bool) {
+	for _, f := range DefaultFixes() {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Fix{}, false
+}
+
+// Disable removes the named fixes from the fixer
+func (fx *Fixer) Disable(names ...string) {
+	var kept []Fix
+	for _, f := range fx.Fixes {
+		if !contains(names, f.Name) {
+			kept = append(kept, f)
+		}
+	}
+	fx.Fixes = kept
+}
+
+// Fix applies the fixes of the fixer to a record and returns the
+// changes made
+func (fx *Fixer) Fix(rec *marc21.Record) (changes []Change) {
+
+	for _, f := range fx.Fixes {
+		for _, c := range f.Apply(rec, fx) {
+			c.Fix = f.Name
+			changes = append(changes, c)
+		}
+	}
+
+	id := rec.GetControlfield("001")
+	for i := range changes {
+		changes[i].RecordID = id
+	}
+	return changes
+}
+
+func fixWhitespace(rec *marc21.Record, fx *Fixer) (changes []Change) {
+
+	for i, cf := range rec.MatchControlfields("001,003,005") {
+		if text := strings.TrimSpace(cf.Text); text != cf.Text {
+			changes = append(changes, Change{
+				Tag:        cf.Tag,
+				Occurrence: i + 1,
+				Message:    fmt.Sprintf("trimmed %q to %q", cf.Text, text),
+			})
+			cf.Text = text
+		}
+	}
+
+	occurrences := make(map[string]int)
+	for _, df := range rec.Datafields {
+		occurrences[df.Tag]++
+		for _, sf := range df.Subfields {
+			if text := strings.TrimSpace(sf.Text); text != sf.Text {
+				changes = append(changes, Change{
+					Tag:        df.Tag,
+					Occurrence: occurrences[df.Tag],
+					Subfield:   sf.Code,
+					Message:    fmt.Sprintf("trimmed %q to %q", sf.Text, text),
+				})
+				sf.Text = text
+			}
+		}
+	}
+	return changes
+}
+
+func fixEmpty(rec *marc21.Record, fx *Fixer) (changes []Change) {
+
+	occurrences := make(map[string]int)
+	for _, cf := range append([]*marc21.Controlfield{}, rec.Controlfields...) {
+		occurrences[cf.Tag]++
+		if cf.Text == "" {
+			rec.DeleteControlfield(cf)
+			changes = append(changes, Change{
+				Tag:        cf.Tag,
+				Occurrence: occurrences[cf.Tag],
+				Message:    "dropped empty field",
+			})
+		}
+	}
+
+	for _, df := range append([]*marc21.Datafield{}, rec.Datafields...) {
+		occurrences[df.Tag]++
+		for _, sf := range append([]*marc21.Subfield{}, df.Subfields...) {
+			if sf.Text == "" {
+				df.DeleteSubfield(sf)
+				changes = append(changes, Change{
+					Tag:        df.Tag,
+					Occurrence: occurrences[df.Tag],
+					Subfield:   sf.Code,
+					Message:    "dropped empty subfield",
+				})
+			}
+		}
+		if len(df.Subfields) == 0 {
+			rec.DeleteDatafield(df)
+			changes = append(changes, Change{
+				Tag:        df.Tag,
+				Occurrence: occurrences[df.Tag],
+				Message:    "dropped field having no subfields",
+			})
+		}
+	}
+	return changes
+}
+
+func fixRepeatedSubfields(rec *marc21.Record, fx *Fixer) (changes []Change) {
+	visitFields(rec, func(f field) {
+		if f.df == nil || !f.defined {
+			return
+		}
+
+		// The index of the subfield to keep for each non-repeatable code
+		keep := make(map[string]int)
+		for i, sf := range f.df.Subfields {
+			sd, ok := f.def.Subfield(sf.Code)
+			if !ok || sd.Repeatable {
+				continue
+			}
+			if _, seen := keep[sf.Code]; !seen || fx.Keep == KeepLast {
+				keep[sf.Code] = i
+			}
+		}
+
+		for i, sf := range append([]*marc21.Subfield{}, f.df.Subfields...) {
+			if k, ok := keep[sf.Code]; ok && k != i {
+				f.df.DeleteSubfield(sf)
+				changes = append(changes, Change{
+					Tag:        f.tag,
+					Occurrence: f.occ,
+					Subfield:   sf.Code,
+					Message:    fmt.Sprintf("dropped repeated subfield %q (kept the %s)", sf.Text, fx.Keep),
+				})
+			}
+		}
+	})
+	return changes
+}
+
+func fix008Length(rec *marc21.Record, fx *Fixer) (changes []Change) {
+
+	text := rec.GetControlfield("008")
+	length := fixedLength(rec.Fixed008())
+	if text == "" || length == 0 || len(text) == length {
+		return nil
+	}
+
+	msg := fmt.Sprintf("padded from %d to %d characters", len(text), length)
+	if len(text) > length {
+		msg = fmt.Sprintf("truncated %q from %d to %d characters", text[length:], len(text), length)
+		text = text[:length]
+	} else {
+		text += strings.Repeat(" ", length-len(text))
+	}
+	rec.SetControlfield("008", text)
+
+	return append(changes, Change{Tag: "008", Occurrence: 1, Message: msg})
+}
+
+func fixLeaderLengths(rec *marc21.Record, fx *Fixer) (changes []Change) {
+
+	orig := rec.Leader.Text
+	if len(orig) != 24 {
+		return nil
+	}
+
+	// The indicator count, subfield code count and entry map are the
+	// same for all MARC 21 records
+	ldr := orig[:10] + "22" + orig[12:20] + "4500"
+
+	rec.Leader.Text = ldr
+	marc, err := rec.RecordAsMARC()
+	if err != nil {
+		// The record cannot be written, so there are no lengths to use
+		rec.Leader.Text = orig
+		return nil
+	}
+	ldr = string(marc[:5]) + ldr[5:12] + string(marc[12:17]) + ldr[17:]
+
+	elements := []struct {
+		name          string
+		offset, width int
+	}{
+		{"record length", 0, 5},
+		{"indicator count", 10, 1},
+		{"subfield code count", 11, 1},
+		{"base address of data", 12, 5},
+		{"entry map", 20, 4},
+	}
+	for _, e := range elements {
+		old := orig[e.offset : e.offset+e.width]
+		if ldr[e.offset:e.offset+e.width] != old {
+			changes = append(changes, Change{
+				Tag:     "LDR",
+				Message: fmt.Sprintf("changed %s from %q to %q", e.name, old, ldr[e.offset:e.offset+e.width]),
+			})
+		}
+	}
+
+	rec.Leader.Text = ldr
+	return changes
+}
@@ -0,0 +1,130 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+func changeKeys(changes []Change) []string {
+	var keys []string
+	for _, c := range changes {
+		k := c.Fix + " " + c.Tag
+		if c.Occurrence > 0 {
+			k += "/" + string(rune('0'+c.Occurrence))
+		}
+		if c.Subfield != "" {
+			k += " $" + c.Subfield
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestFix(t *testing.T) {
+
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	rec.Controlfields[0].Text = "rec1 "
+	rec.Controlfields[1].Text = "180115s2017    nyu           000 0 eng"
+	rec.Datafields[1].Subfields = []*marc21.Subfield{
+		{Code: "a", Text: " Title "},
+		{Code: "a", Text: "Second title"},
+		{Code: "b", Text: ""},
+		{Code: "c", Text: "by A. Author."},
+	}
+	rec.Datafields = append(rec.Datafields,
+		&marc21.Datafield{Tag: "500", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{{Code: "a", Text: "  "}}},
+		&marc21.Datafield{Tag: "500", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{{Code: "a", Text: "A note."}}},
+	)
+
+	fx, err := NewFixer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := fx.Fix(rec)
+
+	got := strings.Join(changeKeys(changes), "\n")
+	expected := strings.Join([]string{
+		"trim-whitespace 001/1",
+		"trim-whitespace 245/1 $a",
+		"trim-whitespace 500/1 $a",
+		"drop-empty 245/1 $b",
+		"drop-empty 500/1 $a",
+		"drop-empty 500/1",
+		"repeated-subfield 245/1 $a",
+		"008-length 008/1",
+		"leader-lengths LDR",
+		"leader-lengths LDR",
+	}, "\n")
+	if got != expected {
+		t.Errorf("got changes\n%s\nexpected\n%s", got, expected)
+	}
+	for _, c := range changes {
+		if c.RecordID != "rec1" {
+			t.Errorf("%v: RecordID %q", c, c.RecordID)
+		}
+	}
+
+	if findings := Lint(rec); len(findings) != 0 {
+		t.Errorf("unexpected findings after fixing: %v", findings)
+	}
+	if rec.GetControlfield("008") != "180115s2017    nyu           000 0 eng  " {
+		t.Errorf("008 is %q", rec.GetControlfield("008"))
+	}
+	if len(rec.MatchDatafields("500")) != 1 {
+		t.Errorf("empty 500 was not dropped")
+	}
+	if sfs := rec.Datafields[1].GetSubfields("a"); len(sfs) != 1 || sfs[0].Text != "Title" {
+		t.Errorf("245 $a: %v", sfs)
+	}
+
+	marc, err := rec.RecordAsMARC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(marc[:24]) != rec.Leader.Text {
+		t.Errorf("leader %q, expected %q", rec.Leader.Text, marc[:24])
+	}
+
+	// Fixing again changes nothing
+	if changes := fx.Fix(rec); len(changes) != 0 {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+}
+
+func TestFixKeepLast(t *testing.T) {
+
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	rec.Datafields[1].Subfields = append(rec.Datafields[1].Subfields, &marc21.Subfield{Code: "a", Text: "Last"})
+
+	fx, err := NewFixer("repeated-subfield")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fx.Keep = KeepLast
+	changes := fx.Fix(rec)
+	if len(changes) != 1 || changes[0].Message != `dropped repeated subfield "Title" (kept the last)` {
+		t.Errorf("got %v", changes)
+	}
+	if sfs := rec.Datafields[1].Subfields; len(sfs) != 2 || sfs[0].Code != "c" || sfs[1].Text != "Last" {
+		t.Errorf("245: %v", sfs)
+	}
+
+	if _, err := NewFixer("no-such-fix"); err == nil {
+		t.Error("expected an error for an unknown fix")
+	}
+}
+
+func TestFix008Truncate(t *testing.T) {
+
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	rec.Controlfields[1].Text += "xx"
+
+	fx, _ := NewFixer()
+	fx.Disable("leader-lengths")
+	changes := fx.Fix(rec)
+	if len(changes) != 1 || changes[0].String() != `rec1 008/1: truncated "xx" from 42 to 40 characters [008-length]` {
+		t.Errorf("got %v", changes)
+	}
+}
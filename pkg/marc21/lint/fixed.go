@@ -0,0 +1,319 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+/*
+The codes and lengths of the leader and of the fixed length
+controlfields (006, 007 and 008) depend on the record format and, for
+Bibliography records, on the type of material (leader/06-07), the form
+of material (006/00) and the category of material (007/00). The element
+definitions are those used by marc21.Record.Fixed008, marc21.Decode006
+and marc21.Decode007.
+
+http://www.loc.gov/marc/bibliographic/bd005.html
+
+    Sixteen characters that specify the date and time of the latest
+    record transaction [...] The date and time are recorded according
+    to Representation of Dates and Times (ISO 8601) [...] yyyymmdd
+    [...] hhmmss.f
+*/
+
+// fixedRules check the leader and the fixed length controlfields
+var fixedRules = []Rule{
+	{
+		Name:        "leader",
+		Description: "The leader codes are valid for the record format",
+		Check:       checkLeader,
+	},
+	{
+		Name:        "fixed-length",
+		Description: "The 006, 007 and 008 are the right length for the type of material",
+		Check:       checkFixedLengths,
+	},
+	{
+		Name:        "fixed-code",
+		Description: "The 006, 007 and 008 codes are valid for the type of material",
+		Check:       checkFixedCodes,
+	},
+	{
+		Name:        "fixed-date",
+		Description: "The 005 and the 008 dates are valid",
+		Check:       checkFixedDates,
+	},
+}
+
+// now returns the current time, for checking that dates are not in the
+// future
+var now = time.Now
+
+// firstMARCYear is the year that the earliest MARC records were created
+const firstMARCYear = 1968
+
+// entryDate parses a yymmdd date entered on file. Rather than the 1969
+// pivot of time.Parse, two digit years after the current year are taken
+// to be in the 1900s, unless that would be before there were MARC
+// records.
+func entryDate(s string) (time.Time, error) {
+	t, err := time.Parse("060102", s)
+	if err != nil {
+		return t, err
+	}
+	if t.Year() > now().Year() && t.Year()-100 >= firstMARCYear {
+		t = t.AddDate(-100, 0, 0)
+	}
+	return t, nil
+}
+
+var (
+	reLanguage = regexp.MustCompile(`^([a-z]{3}|\|\|\|)$`)
+	rePlace    = regexp.MustCompile(`^([a-z]{2} |[a-z]{3}|\|\|\|)$`)
+	reYear     = regexp.MustCompile(`^[0-9]*u*$`)
+)
+
+// fixedField is the decoded value of a fixed length controlfield
+type fixedField struct {
+	tag    string
+	occ    int
+	text   string
+	values []marc21.FixedValue
+}
+
+// decodeFixedFields returns the decoded 006, 007 and (the first) 008
+// fields of a record
+func decodeFixedFields(rec *marc21.Record) (fields []fixedField) {
+
+	occurrences := make(map[string]int)
+	for _, cf := range rec.Controlfields {
+		occurrences[cf.Tag]++
+		f := fixedField{tag: cf.Tag, occ: occurrences[cf.Tag], text: cf.Text}
+		switch {
+		case cf.Tag == "006":
+			f.values = marc21.Decode006(cf.Text)
+		case cf.Tag == "007":
+			f.values = marc21.Decode007(cf.Text)
+		case cf.Tag == "008" && f.occ == 1:
+			f.values = rec.Fixed008()
+		default:
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// fixedLength returns the length of the field that the elements of a
+// decoded field require
+func fixedLength(values []marc21.FixedValue) (length int) {
+	for _, v := range values {
+		if v.Offset+v.Width > length {
+			length = v.Offset + v.Width
+		}
+	}
+	return length
+}
+
+// position returns the character position(s) and name of an element
+func position(v marc21.FixedValue) string {
+	if v.Width > 1 {
+		return fmt.Sprintf("%02d-%02d (%s)", v.Offset, v.Offset+v.Width-1, v.Name)
+	}
+	return fmt.Sprintf("%02d (%s)", v.Offset, v.Name)
+}
+
+func checkLeader(rec *marc21.Record) (findings []Finding) {
+
+	ldr := rec.Leader.Text
+	if len(ldr) != 24 {
+		findings = append(findings, Finding{
+			Tag:      "LDR",
+			Severity: Error,
+			Message:  fmt.Sprintf("leader is %d characters, not 24", len(ldr)),
+		})
+		return findings
+	}
+
+	for _, v := range rec.LeaderElements() {
+		msg := ""
+		switch {
+		case v.Name == "Record length" || v.Name == "Base address of data":
+			if strings.Trim(v.Code, "0123456789") != "" {
+				msg = fmt.Sprintf("%s: %q is not numeric", position(v), v.Code)
+			}
+		case !v.Valid:
+			msg = fmt.Sprintf("%s: %q is not a valid code", position(v), v.Code)
+		}
+		if msg != "" {
+			findings = append(findings, Finding{Tag: "LDR", Severity: Error, Message: msg})
+		}
+	}
+	return findings
+}
+
+func checkFixedLengths(rec *marc21.Record) (findings []Finding) {
+
+	if rec.RecordFormat() == marc21.FmtUnknown {
+		return nil
+	}
+
+	if rec.GetControlfield("008") == "" {
+		findings = append(findings, Finding{
+			Tag:      "008",
+			Severity: Error,
+			Message:  "record has no 008",
+		})
+	}
+
+	for _, f := range decodeFixedFields(rec) {
+		// The length of a 006 or 007 depends on its first code, which
+		// is reported by the fixed-code rule when it is not valid
+		if len(f.values) == 0 || f.tag != "008" && f.text != "" && !f.values[0].Valid {
+			continue
+		}
+		length := fixedLength(f.values)
+		if len(f.text) != length {
+			findings = append(findings, Finding{
+				Tag:        f.tag,
+				Occurrence: f.occ,
+				Severity:   Error,
+				Message:    fmt.Sprintf("field is %d characters, expected %d", len(f.text), length),
+			})
+		}
+	}
+	return findings
+}
+
+func checkFixedCodes(rec *marc21.Record) (findings []Finding) {
+
+	if rec.RecordFormat() == marc21.FmtUnknown {
+		return nil
+	}
+
+	for _, f := range decodeFixedFields(rec) {
+		for _, v := range f.values {
+			// Short fields are reported by the fixed-length rule
+			if len(v.Code) != v.Width {
+				continue
+			}
+
+			severity := Error
+			msg := ""
+			switch {
+			case !v.Valid:
+				msg = fmt.Sprintf("%s: %q is not a valid code", position(v), v.Code)
+			case v.Name == "Language" && strings.TrimSpace(v.Code) == "":
+				severity = Warning
+				msg = fmt.Sprintf("%s: is blank", position(v))
+			case v.Name == "Language" && !reLanguage.MatchString(v.Code):
+				msg = fmt.Sprintf("%s: %q is not a language code", position(v), v.Code)
+			case v.Name == "Place of publication, production, or execution" && !rePlace.MatchString(v.Code):
+				msg = fmt.Sprintf("%s: %q is not a country code", position(v), v.Code)
+			}
+			if msg != "" {
+				findings = append(findings, Finding{
+					Tag:        f.tag,
+					Occurrence: f.occ,
+					Severity:   severity,
+					Message:    msg,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func checkFixedDates(rec *marc21.Record) (findings []Finding) {
+
+	add := func(tag string, occ int, severity Severity, format string, a ...interface{}) {
+		findings = append(findings, Finding{
+			Tag:        tag,
+			Occurrence: occ,
+			Severity:   severity,
+			Message:    fmt.Sprintf(format, a...),
+		})
+	}
+
+	for i, cf := range rec.MatchControlfields("005") {
+		t, err := time.Parse("20060102150405.0", cf.Text)
+		switch {
+		case err != nil:
+			add("005", i+1, Error, "%q is not a valid date and time (yyyymmddhhmmss.f)", cf.Text)
+		case t.After(now()):
+			add("005", i+1, Warning, "%q is in the future", cf.Text)
+		}
+	}
+
+	if rec.RecordFormat() == marc21.FmtUnknown {
+		return findings
+	}
+
+	if v, ok := rec.Fixed008Element("Date entered on file"); ok && len(v.Code) == v.Width && v.Code != "||||||" {
+		t, err := entryDate(v.Code)
+		switch {
+		case err != nil:
+			add("008", 1, Error, "%s: %q is not a valid date (yymmdd)", position(v), v.Code)
+		case t.After(now()):
+			add("008", 1, Warning, "%s: %q is in the future", position(v), v.Code)
+		}
+	}
+
+	if rec.RecordFormat() != marc21.Bibliography {
+		return findings
+	}
+
+	typeOfDate, _ := rec.TypeOfDate()
+	date1, ok1 := rec.Fixed008Element("Date 1")
+	date2, ok2 := rec.Fixed008Element("Date 2")
+	if !ok1 || !ok2 || len(date1.Code) != 4 || len(date2.Code) != 4 || typeOfDate == "" || typeOfDate == "|" {
+		return findings
+	}
+
+	valid := true
+	for _, v := range []marc21.FixedValue{date1, date2} {
+		if v.Code != "    " && v.Code != "||||" && !reYear.MatchString(v.Code) {
+			add("008", 1, Error, "%s: %q is not a valid year", position(v), v.Code)
+			valid = false
+		}
+	}
+	if !valid {
+		return findings
+	}
+
+	d1, d2 := date1.Code, date2.Code
+	switch {
+	case typeOfDate == "b" && (d1 != "    " || d2 != "    "):
+		add("008", 1, Error, "dates should be blank for type of date %q", typeOfDate)
+	case typeOfDate == "c" && d2 != "9999":
+		add("008", 1, Error, "%s: should be \"9999\" for type of date %q", position(date2), typeOfDate)
+	case typeOfDate == "d" && (d2 == "    " || d2 == "9999"):
+		add("008", 1, Error, "%s: should be a year for type of date %q", position(date2), typeOfDate)
+	case typeOfDate == "n" && (d1 != "uuuu" || d2 != "uuuu"):
+		add("008", 1, Error, "dates should be \"uuuu\" for type of date %q", typeOfDate)
+	case typeOfDate == "s" && d2 != "    ":
+		add("008", 1, Error, "%s: should be blank for type of date %q", position(date2), typeOfDate)
+	case typeOfDate == "u" && d2 != "uuuu":
+		add("008", 1, Error, "%s: should be \"uuuu\" for type of date %q", position(date2), typeOfDate)
+	}
+
+	y1, err1 := strconv.Atoi(d1)
+	y2, err2 := strconv.Atoi(d2)
+	if err1 == nil && err2 == nil && strings.Contains("cdikmq", typeOfDate) && y1 > y2 {
+		add("008", 1, Error, "Date 1 %q is later than Date 2 %q", d1, d2)
+	}
+	if err1 == nil && y1 > now().Year()+1 {
+		add("008", 1, Warning, "%s: %q is in the future", position(date1), d1)
+	}
+
+	return findings
+}
@@ -0,0 +1,128 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+func lintRules(t *testing.T, rec *marc21.Record, rules ...string) []Finding {
+	l, err := New(rules...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l.Lint(rec)
+}
+
+func TestLintLeader(t *testing.T) {
+
+	rec := newTestRecord("0000xnam a22000000a 4500")
+	findings := lintRules(t, rec, "leader")
+	if len(findings) != 2 {
+		t.Fatalf("got %v", findings)
+	}
+	if !strings.Contains(findings[0].Message, "00-04 (Record length)") {
+		t.Errorf("got %q", findings[0].Message)
+	}
+	if !strings.Contains(findings[1].Message, `"0" is not a valid code`) {
+		t.Errorf("got %q", findings[1].Message)
+	}
+
+	rec.Leader.Text = "00000nam"
+	if findings := lintRules(t, rec, "leader"); len(findings) != 1 || findings[0].Message != "leader is 8 characters, not 24" {
+		t.Errorf("got %v", findings)
+	}
+}
+
+func TestLintFixedFields(t *testing.T) {
+
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	rec.Controlfields[1].Text = "180115s2017    xx#           000 0    d  "
+	rec.Controlfields = append(rec.Controlfields,
+		&marc21.Controlfield{Tag: "006", Text: "m     o  d        "},
+		&marc21.Controlfield{Tag: "006", Text: "b                 "},
+		&marc21.Controlfield{Tag: "007", Text: "cr"},
+		&marc21.Controlfield{Tag: "007", Text: "ta"},
+		&marc21.Controlfield{Tag: "007", Text: "cq n|||||||||"},
+	)
+
+	got := strings.Join(findingKeys(lintRules(t, rec, "fixed-length", "fixed-code")), "\n")
+	expected := strings.Join([]string{
+		"fixed-length 008/1",
+		"fixed-length 007/1",
+		"fixed-length 007/3",
+		"fixed-code 008/1",
+		"fixed-code 008/1",
+		"fixed-code 006/2",
+		"fixed-code 007/3",
+	}, "\n")
+	if got != expected {
+		t.Errorf("got findings\n%s\nexpected\n%s", got, expected)
+	}
+
+	for _, f := range lintRules(t, rec, "fixed-code") {
+		if strings.Contains(f.Message, "Language") != (f.Severity == Warning) {
+			t.Errorf("%v: unexpected severity", f)
+		}
+	}
+
+	rec.Controlfields = rec.Controlfields[:1]
+	if findings := lintRules(t, rec, "fixed-length"); len(findings) != 1 || findings[0].Message != "record has no 008" {
+		t.Errorf("got %v", findings)
+	}
+}
+
+func TestLintFixedDates(t *testing.T) {
+
+	now = func() time.Time { return time.Date(2018, 1, 15, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	tests := []struct {
+		f005     string
+		f008     string
+		expected []string
+	}{
+		{"20180115101010.0", "180115s2017    nyu           000 0 eng d", nil},
+		{"20180115", "181315s2017    nyu           000 0 eng d", []string{
+			`"20180115" is not a valid date and time (yyyymmddhhmmss.f)`,
+			`00-05 (Date entered on file): "181315" is not a valid date (yymmdd)`,
+		}},
+		{"20190115101010.0", "190115s2021    nyu           000 0 eng d", []string{
+			`"20190115101010.0" is in the future`,
+			`00-05 (Date entered on file): "190115" is in the future`,
+			`07-10 (Date 1): "2021" is in the future`,
+		}},
+		{"20180115101010.0", "180115s20172018nyu           000 0 eng d", []string{
+			`11-14 (Date 2): should be blank for type of date "s"`,
+		}},
+		{"20180115101010.0", "180115d20171999nyu           000 0 eng d", []string{
+			`Date 1 "2017" is later than Date 2 "1999"`,
+		}},
+		{"20180115101010.0", "180115c2017    nyu           000 0 eng d", []string{
+			`11-14 (Date 2): should be "9999" for type of date "c"`,
+		}},
+		{"20180115101010.0", "180115q19u-19uunyu           000 0 eng d", []string{
+			`07-10 (Date 1): "19u-" is not a valid year`,
+		}},
+		{"20180115101010.0", "180115q19uu19uunyu           000 0 eng d", nil},
+		// Entered in 1968, not 2068
+		{"20180115101010.0", "680115s1968    nyu           000 0 eng d", nil},
+		{"20180115101010.0", "691231s1969    nyu           000 0 eng d", nil},
+	}
+
+	for _, test := range tests {
+		rec := newTestRecord("00000nam a2200000 a 4500")
+		rec.Controlfields[1].Text = test.f008
+		rec.InsertControlfield(&marc21.Controlfield{Tag: "005", Text: test.f005})
+
+		var got []string
+		for _, f := range lintRules(t, rec, "fixed-date") {
+			got = append(got, f.Message)
+		}
+		if strings.Join(got, "\n") != strings.Join(test.expected, "\n") {
+			t.Errorf("%s %s: got\n%s\nexpected\n%s", test.f005, test.f008, strings.Join(got, "\n"), strings.Join(test.expected, "\n"))
+		}
+	}
+}
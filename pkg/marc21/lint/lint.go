@@ -0,0 +1,184 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+// Package lint checks MARC records for "bad data" such as undefined
+// tags, repeated non-repeatable fields and subfields, invalid
+// indicators, obsolete tags and invalid leader and fixed field codes.
+// The checks depend on the format of the record (see
+// marc21.Record.RecordFormat). Some of the problems can be corrected by
+// a Fixer, and some obsolete fields migrated by a Migrator.
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+// Severity indicates how serious a finding is
+type Severity int
+
+const (
+	// Warning indicates data that is valid but should be looked at,
+	// such as an obsolete tag
+	Warning Severity = iota
+	// Error indicates data that is not valid for the record format
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	}
+	return fmt.Sprintf("severity(%d)", int(s))
+}
+
+// Finding is a single problem that was found in a record
+type Finding struct {
+	// RecordID is the control number (001) of the record
+	RecordID string
+	// Tag is the tag of the field having the problem, or "LDR" for the
+	// leader
+	Tag string
+	// Occurrence is the one-based occurrence of the field among the
+	// fields of the record having the same tag, or 0 for the leader
+	Occurrence int
+	// Subfield is the code of the subfield having the problem, if any
+	Subfield string
+	// Severity indicates how serious the problem is
+	Severity Severity
+	// Rule is the name of the rule that reported the problem
+	Rule string
+	// Message describes the problem
+	Message string
+	// Migration is the name of the migration that would correct the
+	// problem, if any (see Migrator)
+	Migration string
+}
+
+// Implement the Stringer interface for "Pretty-printing"
+func (f Finding) String() string {
+	msg := f.Message
+	if f.Migration != "" {
+		msg += fmt.Sprintf(" (see migration %s)", f.Migration)
+	}
+	return fmt.Sprintf("%s: %s: %s [%s]", location(f.RecordID, f.Tag, f.Occurrence, f.Subfield), f.Severity, msg, f.Rule)
+}
+
+// location returns the record id, tag/occurrence and subfield code of a
+// finding or change
+func location(id, tag string, occ int, code string) string {
+
+	var loc []string
+	if id != "" {
+		loc = append(loc, id)
+	}
+	if tag != "" {
+		if occ > 0 {
+			loc = append(loc, fmt.Sprintf("%s/%d", tag, occ))
+		} else {
+			loc = append(loc, tag)
+		}
+	}
+	if code != "" {
+		loc = append(loc, "$"+code)
+	}
+	return strings.Join(loc, " ")
+}
+
+// Rule is a named check that is run against each record
+type Rule struct {
+	// Name identifies the rule in the findings that it reports
+	Name string
+	// Description briefly describes what the rule checks for
+	Description string
+	// Check returns the problems that the rule finds in a record. The
+	// RecordID and Rule of the findings are filled in by the Linter.
+	Check func(rec *marc21.Record) []Finding
+}
+
+// Linter checks records against a set of rules
+type Linter struct {
+	Rules []Rule
+}
+
+// New returns a Linter for the named rules, or for all of the
+// DefaultRules if no names are given
+func New(names ...string) (*Linter, error) {
+
+	if len(names) == 0 {
+		return &Linter{Rules: DefaultRules()}, nil
+	}
+
+	l := &Linter{}
+	for _, name := range names {
+		r, ok := findRule(name)
+		if !ok {
+			return nil, fmt.Errorf("lint: unknown rule %q", name)
+		}
+		l.Rules = append(l.Rules, r)
+	}
+	return l, nil
+}
+
+// DefaultRules returns all of the rules, in the order that they are run
+func DefaultRules() []Rule {
+	rules := append([]Rule{}, contentRules...)
+	return append(rules, fixedRules...)
+}
+
+// findRule returns the default rule having the specified name
+func findRule(name string) (Rule, bool) {
+	for _, r := range DefaultRules() {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return Rule{}, false
+}
+
+// Disable removes the named rules from the linter
+func (l *Linter) Disable(names ...string) {
+	var rules []Rule
+	for _, r := range l.Rules {
+		if !contains(names, r.Name) {
+			rules = append(rules, r)
+		}
+	}
+	l.Rules = rules
+}
+
+// Lint checks a record against the rules of the linter and returns
+// the problems found
+func (l *Linter) Lint(rec *marc21.Record) (findings []Finding) {
+
+	id := rec.GetControlfield("001")
+	for _, r := range l.Rules {
+		for _, f := range r.Check(rec) {
+			f.RecordID = id
+			f.Rule = r.Name
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// Lint checks a record against all of the default rules
+func Lint(rec *marc21.Record) []Finding {
+	l, _ := New()
+	return l.Lint(rec)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
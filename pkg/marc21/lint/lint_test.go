@@ -0,0 +1,144 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+func newTestRecord(leader string) *marc21.Record {
+	return &marc21.Record{
+		Leader: marc21.Leader{Text: leader},
+		Controlfields: []*marc21.Controlfield{
+			{Tag: "001", Text: "rec1"},
+			{Tag: "008", Text: "180115s2017    nyu           000 0 eng d"},
+		},
+		Datafields: []*marc21.Datafield{
+			{Tag: "100", Ind1: "1", Ind2: " ", Subfields: []*marc21.Subfield{
+				{Code: "a", Text: "Author, A."},
+			}},
+			{Tag: "245", Ind1: "1", Ind2: "0", Subfields: []*marc21.Subfield{
+				{Code: "a", Text: "Title"},
+				{Code: "c", Text: "by A. Author."},
+			}},
+		},
+	}
+}
+
+// findingKeys returns the "rule tag/occurrence $code" of each finding
+func findingKeys(findings []Finding) []string {
+	var keys []string
+	for _, f := range findings {
+		k := f.Rule + " " + f.Tag
+		if f.Occurrence > 0 {
+			k += "/" + string(rune('0'+f.Occurrence))
+		}
+		if f.Subfield != "" {
+			k += " $" + f.Subfield
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestLintClean(t *testing.T) {
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	if findings := Lint(rec); len(findings) != 0 {
+		t.Errorf("unexpected findings: %v", findings)
+	}
+}
+
+func TestLintContent(t *testing.T) {
+
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	rec.Controlfields = append(rec.Controlfields,
+		&marc21.Controlfield{Tag: "008", Text: "180115s2017    nyu           000 0 eng d"},
+		&marc21.Controlfield{Tag: "009", Text: "local"},
+	)
+	rec.Datafields[1].Subfields = append(rec.Datafields[1].Subfields,
+		&marc21.Subfield{Code: "a", Text: "Again"},
+		&marc21.Subfield{Code: "j", Text: "Undefined"},
+	)
+	rec.Datafields = append(rec.Datafields,
+		&marc21.Datafield{Tag: "245", Ind1: "x", Ind2: "0", Subfields: []*marc21.Subfield{{Code: "a", Text: "Second title"}}},
+		&marc21.Datafield{Tag: "440", Ind1: " ", Ind2: "0", Subfields: []*marc21.Subfield{{Code: "a", Text: "Series"}}},
+		&marc21.Datafield{Tag: "020", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{{Code: "b", Text: "pbk."}}},
+		&marc21.Datafield{Tag: "012", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{{Code: "a", Text: "?"}}},
+		&marc21.Datafield{Tag: "999", Ind1: "q", Ind2: "q", Subfields: []*marc21.Subfield{{Code: "z", Text: "local"}}},
+	)
+
+	got := strings.Join(findingKeys(Lint(rec)), "\n")
+	expected := strings.Join([]string{
+		"undefined-tag 009/1",
+		"undefined-tag 012/1",
+		"repeated-field 008/2",
+		"repeated-field 245/2",
+		"invalid-indicator 245/2",
+		"undefined-subfield 245/1 $j",
+		"repeated-subfield 245/1 $a",
+		"obsolete 440/1",
+		"obsolete 020/1 $b",
+	}, "\n")
+	if got != expected {
+		t.Errorf("got findings\n%s\nexpected\n%s", got, expected)
+	}
+
+	for _, f := range Lint(rec) {
+		if f.RecordID != "rec1" {
+			t.Errorf("%v: RecordID %q", f, f.RecordID)
+		}
+		if (f.Rule == "obsolete") != (f.Severity == Warning) {
+			t.Errorf("%v: unexpected severity", f)
+		}
+	}
+}
+
+func TestLintFormat(t *testing.T) {
+
+	// A 245 is not defined for authority records, an unknown format
+	// is only reported once
+	rec := newTestRecord("00000nz  a2200000n  4500")
+	rec.Controlfields[1].Text = "180115n| azannaabn          |a aaa      "
+	keys := findingKeys(Lint(rec))
+	if strings.Join(keys, ",") != "undefined-tag 245/1" {
+		t.Errorf("authority: %v", keys)
+	}
+
+	rec = newTestRecord("00000n#m a2200000 a 4500")
+	keys = findingKeys(Lint(rec))
+	if strings.Join(keys, ",") != "record-format LDR" {
+		t.Errorf("unknown format: %v", keys)
+	}
+}
+
+func TestLinterRules(t *testing.T) {
+
+	if _, err := New("no-such-rule"); err == nil {
+		t.Error("expected an error for an unknown rule")
+	}
+
+	l, err := New("obsolete")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	rec.Datafields = append(rec.Datafields, &marc21.Datafield{Tag: "012", Ind1: " ", Ind2: " "})
+	if findings := l.Lint(rec); len(findings) != 0 {
+		t.Errorf("unexpected findings: %v", findings)
+	}
+
+	l, _ = New()
+	l.Disable("undefined-tag")
+	if findings := l.Lint(rec); len(findings) != 0 {
+		t.Errorf("unexpected findings: %v", findings)
+	}
+}
+
+func TestFindingString(t *testing.T) {
+	f := Finding{RecordID: "rec1", Tag: "245", Occurrence: 1, Subfield: "a", Severity: Error, Rule: "repeated-subfield", Message: "subfield is not repeatable"}
+	expected := "rec1 245/1 $a: error: subfield is not repeatable [repeated-subfield]"
+	if f.String() != expected {
+		t.Errorf("got %q, expected %q", f.String(), expected)
+	}
+}
@@ -0,0 +1,442 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+/*
+Obsolete fields and subfields are "no longer valid for input" but, as
+LoC notes for many of them, "records created before this change may
+contain" them. The migrations convert the more common ones to their
+current equivalents, following the notes on the LoC pages:
+
+http://www.loc.gov/marc/bibliographic/bd4xx.html
+
+    Series Statements--General Information [...] Field 440 was made
+    obsolete in 2008. [...] the series title is recorded in field 490
+    (Series Statement) with first indicator value 1 (Series traced) and
+    the added entry in field 830 (Series Added Entry-Uniform Title).
+
+http://www.loc.gov/marc/bibliographic/bd020.html
+
+    $q - Qualifying information. [...] Prior to the definition of
+    subfield $q in 2013, qualifying information was recorded in
+    parentheses in subfield $a. [...] $b - Binding information [OBSOLETE]
+
+Fields that are linked to an 880 (by a $6) are not migrated, as the
+880 would also need to be changed.
+*/
+
+// Migration converts an obsolete field or subfield to its current
+// equivalent
+type Migration struct {
+	// Name identifies the migration in the changes that it reports
+	Name string
+	// Description describes the conversion
+	Description string
+	// Format is the record format that the migration applies to
+	Format int
+	// Tag is the tag pattern (see marc21.MatchTag) of the fields that
+	// the migration applies to
+	Tag string
+	// Match, if set, indicates whether a field needs to be migrated.
+	// Otherwise all of the fields matching the Tag are migrated.
+	Match func(df *marc21.Datafield) bool
+	// Migrate converts a field and returns a description of the change
+	Migrate func(rec *marc21.Record, df *marc21.Datafield) string
+}
+
+// applies indicates whether the migration applies to a field of a record
+func (m Migration) applies(format int, df *marc21.Datafield) bool {
+	if format != m.Format || !marc21.MatchTag(m.Tag, df.Tag) || len(df.GetSubfields("6")) > 0 {
+		return false
+	}
+	return m.Match == nil || m.Match(df)
+}
+
+// Migrator converts the obsolete fields of records using a set of
+// migrations
+type Migrator struct {
+	Migrations []Migration
+}
+
+// migrations are the migrations, in the order that they are applied
+var migrations = []Migration{
+	{
+		Name:        "440-to-490-830",
+		Description: "Replace a 440 series statement/added entry with a 490 (traced) and an 830",
+		Format:      marc21.Bibliography,
+		Tag:         "440",
+		Migrate:     migrateSeries("830"),
+	},
+	{
+		Name:        "400-to-490-800",
+		Description: "Replace a 400 series statement/added entry with a 490 (traced) and an 800",
+		Format:      marc21.Bibliography,
+		Tag:         "400",
+		Migrate:     migrateSeries("800"),
+	},
+	{
+		Name:        "410-to-490-810",
+		Description: "Replace a 410 series statement/added entry with a 490 (traced) and an 810",
+		Format:      marc21.Bibliography,
+		Tag:         "410",
+		Migrate:     migrateSeries("810"),
+	},
+	{
+		Name:        "411-to-490-811",
+		Description: "Replace a 411 series statement/added entry with a 490 (traced) and an 811",
+		Format:      marc21.Bibliography,
+		Tag:         "411",
+		Migrate:     migrateSeries("811"),
+	},
+	{
+		Name:        "21X-to-246",
+		Description: "Replace a 211 acronym, 212 variant access or 214 augmented title with a 246",
+		Format:      marc21.Bibliography,
+		Tag:         "211,212,214",
+		Migrate:     migrateVariantTitle,
+	},
+	{
+		Name:        "245-d-e-to-n-p",
+		Description: "Change the 242, 245 and 246 $d and $e (designation and name of section/part/series) to $n and $p",
+		Format:      marc21.Bibliography,
+		Tag:         "242,245,246",
+		Match:       hasSubfields("de"),
+		Migrate:     renameSubfields(map[string]string{"d": "n", "e": "p"}),
+	},
+	{
+		Name:        "265-to-037",
+		Description: "Replace a 265 source for acquisition/subscription address with a 037 $b",
+		Format:      marc21.Bibliography,
+		Tag:         "265",
+		Migrate:     migrateTo037(map[string]string{"a": "b"}),
+	},
+	{
+		Name:        "350-to-037",
+		Description: "Replace a 350 price with a 037 $c (terms of availability) and $f (form of issue)",
+		Format:      marc21.Bibliography,
+		Tag:         "350",
+		Migrate:     migrateTo037(map[string]string{"a": "c", "b": "f"}),
+	},
+	{
+		Name:        "020-b-to-q",
+		Description: "Change the 020 $b (binding information) to $q (qualifying information)",
+		Format:      marc21.Bibliography,
+		Tag:         "020",
+		Match:       hasSubfields("b"),
+		Migrate:     renameSubfields(map[string]string{"b": "q"}),
+	},
+	{
+		Name:        "020-qualifier",
+		Description: "Move the parenthetical qualifiers of the 020 $a, and a $c having no price, to $q",
+		Format:      marc21.Bibliography,
+		Tag:         "020",
+		Match:       hasISBNQualifier,
+		Migrate:     migrateISBNQualifier,
+	},
+	{
+		Name:        "5XX-to-500",
+		Description: "Replace the obsolete 503, 512, 517, 523, 527, 537, 543 and 570 notes with a 500",
+		Format:      marc21.Bibliography,
+		Tag:         "503,512,517,523,527,537,543,570",
+		Migrate:     migrateNote,
+	},
+	{
+		Name:        "755-to-655",
+		Description: "Replace a 755 physical characteristics added entry with a 655 genre/form term",
+		Format:      marc21.Bibliography,
+		Tag:         "755",
+		Migrate:     migratePhysicalCharacteristics,
+	},
+}
+
+// NewMigrator returns a Migrator for the named migrations, or for all
+// of the DefaultMigrations if no names are given
+func NewMigrator(names ...string) (*Migrator, error) {
+
+	if len(names) == 0 {
+		return &Migrator{Migrations: DefaultMigrations()}, nil
+	}
+
+	mg := &Migrator{}
+	for _, name := range names {
+		m, ok := findMigration(name)
+		if !ok {
+			return nil, fmt.Errorf("lint: unknown migration %q", name)
+		}
+		mg.Migrations = append(mg.Migrations, m)
+	}
+	return mg, nil
+}
+
+// DefaultMigrations returns all of the migrations, in the order that
+// they are applied
+func DefaultMigrations() []Migration {
+	return append([]Migration{}, migrations...)
+}
+
+// findMigration returns the default migration having the specified name
+func findMigration(name string) (Migration, bool) {
+	for _, m := range DefaultMigrations() {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return Migration{}, false
+}
+
+// migrationFor returns the name of the first of the default migrations
+// that applies to a field of a record, if any
+func migrationFor(format int, df *marc21.Datafield) string {
+	for _, m := range migrations {
+		if m.applies(format, df) {
+			return m.Name
+		}
+	}
+	return ""
+}
+
+// Disable removes the named migrations from the migrator
+func (mg *Migrator) Disable(names ...string) {
+	var kept []Migration
+	for _, m := range mg.Migrations {
+		if !contains(names, m.Name) {
+			kept = append(kept, m)
+		}
+	}
+	mg.Migrations = kept
+}
+
+// Migrate applies the migrations of the migrator to a record and
+// returns the changes made
+func (mg *Migrator) Migrate(rec *marc21.Record) (changes []Change) {
+
+	id := rec.GetControlfield("001")
+	format := rec.RecordFormat()
+
+	for _, m := range mg.Migrations {
+		occurrences := make(map[string]int)
+		for _, df := range append([]*marc21.Datafield{}, rec.Datafields...) {
+			occurrences[df.Tag]++
+			if !m.applies(format, df) {
+				continue
+			}
+			changes = append(changes, Change{
+				RecordID:   id,
+				Tag:        df.Tag,
+				Occurrence: occurrences[df.Tag],
+				Fix:        m.Name,
+				Message:    m.Migrate(rec, df),
+			})
+		}
+	}
+	return changes
+}
+
+// hasSubfields returns a Match function for the fields that have any of
+// the specified subfield codes
+func hasSubfields(codes string) func(df *marc21.Datafield) bool {
+	return func(df *marc21.Datafield) bool {
+		return len(df.GetSubfields(codes)) > 0
+	}
+}
+
+// renameSubfields returns a Migrate function that changes the codes of
+// subfields
+func renameSubfields(codes map[string]string) func(rec *marc21.Record, df *marc21.Datafield) string {
+	return func(rec *marc21.Record, df *marc21.Datafield) string {
+		var changed []string
+		for _, sf := range df.Subfields {
+			if code, ok := codes[sf.Code]; ok {
+				changed = append(changed, fmt.Sprintf("$%s to $%s", sf.Code, code))
+				sf.Code = code
+			}
+		}
+		return "changed " + strings.Join(changed, ", ")
+	}
+}
+
+// replaceDatafield replaces a field with new fields, which are inserted
+// in tag order, and returns a description of the change
+func replaceDatafield(rec *marc21.Record, df *marc21.Datafield, dfs ...*marc21.Datafield) string {
+	rec.DeleteDatafield(df)
+	var tags []string
+	for _, n := range dfs {
+		rec.InsertDatafield(n)
+		tags = append(tags, n.Tag)
+	}
+	return fmt.Sprintf("replaced %s with %s", df.Tag, strings.Join(tags, " and "))
+}
+
+// isControlSubfield indicates whether a subfield code is one of the
+// numeric control subfields rather than data
+func isControlSubfield(code string) bool {
+	return code >= "0" && code <= "9"
+}
+
+// joinText returns the text of the data (non-numeric) subfields of a
+// field joined by spaces
+func joinText(df *marc21.Datafield) string {
+	var text []string
+	for _, sf := range df.Subfields {
+		if !isControlSubfield(sf.Code) {
+			text = append(text, sf.Text)
+		}
+	}
+	return strings.Join(text, " ")
+}
+
+// migrateSeries returns a Migrate function that replaces a 4XX series
+// statement/added entry with a 490 (series traced) and the 8XX added
+// entry. The 490 $a is the text of the series statement, less the $v
+// and $x that are carried over as is.
+func migrateSeries(tag string) func(rec *marc21.Record, df *marc21.Datafield) string {
+	return func(rec *marc21.Record, df *marc21.Datafield) string {
+
+		f490 := &marc21.Datafield{Tag: "490", Ind1: "1", Ind2: " "}
+		var statement []string
+		for _, sf := range df.Subfields {
+			if sf.Code != "v" && sf.Code != "x" && !isControlSubfield(sf.Code) {
+				statement = append(statement, sf.Text)
+			}
+		}
+		f490.AddSubfield("a", strings.Join(statement, " "))
+		for _, sf := range df.Subfields {
+			if sf.Code == "v" || sf.Code == "x" {
+				f490.AddSubfield(sf.Code, sf.Text)
+			}
+		}
+
+		// The 440 second indicator (nonfiling characters) is the 830
+		// second indicator, the name type of a 400, 410 and 411 is the
+		// 8XX first indicator
+		added := &marc21.Datafield{Tag: tag, Ind1: df.GetInd1(), Ind2: " "}
+		if df.Tag == "440" {
+			added.Ind1, added.Ind2 = " ", df.GetInd2()
+		}
+		def, _ := marc21.DatafieldDefinition(marc21.Bibliography, tag)
+		for _, sf := range df.Subfields {
+			if _, ok := def.Subfield(sf.Code); ok {
+				added.AddSubfield(sf.Code, sf.Text)
+			}
+		}
+
+		return replaceDatafield(rec, df, f490, added)
+	}
+}
+
+func migrateVariantTitle(rec *marc21.Record, df *marc21.Datafield) string {
+
+	// 211, 212 and 214 first indicator: 0 - No title added entry,
+	// 1 - Title added entry. None of them generated a note.
+	ind1 := "3"
+	if df.GetInd1() == "0" {
+		ind1 = "2"
+	}
+
+	f246 := &marc21.Datafield{Tag: "246", Ind1: ind1, Ind2: " "}
+	f246.AddSubfield("a", joinText(df))
+
+	return replaceDatafield(rec, df, f246)
+}
+
+// migrateTo037 returns a Migrate function that replaces a field with a
+// 037 having the specified subfield codes
+func migrateTo037(codes map[string]string) func(rec *marc21.Record, df *marc21.Datafield) string {
+	return func(rec *marc21.Record, df *marc21.Datafield) string {
+		f037 := &marc21.Datafield{Tag: "037", Ind1: " ", Ind2: " "}
+		for _, sf := range df.Subfields {
+			if code, ok := codes[sf.Code]; ok {
+				f037.AddSubfield(code, sf.Text)
+			}
+		}
+		return replaceDatafield(rec, df, f037)
+	}
+}
+
+var (
+	reISBNQualifier  = regexp.MustCompile(`^([0-9][0-9Xx-]*)\s*\((.+)\)\s*[:;.]?$`)
+	reTermsQualifier = regexp.MustCompile(`^\(([^0-9]+)\)\s*[:;.]?$`)
+)
+
+func hasISBNQualifier(df *marc21.Datafield) bool {
+	for _, sf := range df.Subfields {
+		if sf.Code == "a" && reISBNQualifier.MatchString(sf.Text) || sf.Code == "c" && reTermsQualifier.MatchString(sf.Text) {
+			return true
+		}
+	}
+	return false
+}
+
+// qualifiers splits the qualifying information of an 020, as in "pbk. ;
+// alk. paper", into the separate qualifiers
+func qualifiers(text string) (q []string) {
+	for _, s := range strings.Split(text, ";") {
+		if s = strings.TrimSpace(s); s != "" {
+			q = append(q, s)
+		}
+	}
+	return q
+}
+
+func migrateISBNQualifier(rec *marc21.Record, df *marc21.Datafield) string {
+
+	var subfields []*marc21.Subfield
+	var moved []string
+	for _, sf := range df.Subfields {
+		var q []string
+		if m := reISBNQualifier.FindStringSubmatch(sf.Text); sf.Code == "a" && m != nil {
+			subfields = append(subfields, &marc21.Subfield{Code: "a", Text: m[1]})
+			q = qualifiers(m[2])
+		} else if m := reTermsQualifier.FindStringSubmatch(sf.Text); sf.Code == "c" && m != nil {
+			q = qualifiers(m[1])
+		} else {
+			subfields = append(subfields, sf)
+			continue
+		}
+		for _, s := range q {
+			subfields = append(subfields, &marc21.Subfield{Code: "q", Text: s})
+		}
+		moved = append(moved, fmt.Sprintf("$%s %q", sf.Code, sf.Text))
+	}
+	df.Subfields = subfields
+
+	return fmt.Sprintf("moved the qualifiers of %s to $q", strings.Join(moved, ", "))
+}
+
+func migrateNote(rec *marc21.Record, df *marc21.Datafield) string {
+	f500 := &marc21.Datafield{Tag: "500", Ind1: " ", Ind2: " "}
+	for _, sf := range df.GetSubfields("3") {
+		f500.AddSubfield(sf.Code, sf.Text)
+	}
+	f500.AddSubfield("a", joinText(df))
+	for _, sf := range df.GetSubfields("8") {
+		f500.AddSubfield(sf.Code, sf.Text)
+	}
+	return replaceDatafield(rec, df, f500)
+}
+
+func migratePhysicalCharacteristics(rec *marc21.Record, df *marc21.Datafield) string {
+
+	// 655 second indicator: 4 - Source not specified, 7 - Source
+	// specified in subfield $2
+	ind2 := "4"
+	if len(df.GetSubfields("2")) > 0 {
+		ind2 = "7"
+	}
+
+	f655 := &marc21.Datafield{Tag: "655", Ind1: " ", Ind2: ind2}
+	for _, sf := range df.Subfields {
+		f655.AddSubfield(sf.Code, sf.Text)
+	}
+	return replaceDatafield(rec, df, f655)
+}
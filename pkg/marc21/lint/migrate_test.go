@@ -0,0 +1,151 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gsiems/go-marc21/pkg/marc21"
+)
+
+// fieldStrings returns the datafields of a record as "tag ind1ind2 $a..."
+func fieldStrings(rec *marc21.Record) []string {
+	var s []string
+	for _, df := range rec.Datafields {
+		f := df.Tag + " " + df.GetInd1() + df.GetInd2() + " "
+		for _, sf := range df.Subfields {
+			f += "$" + sf.Code + sf.Text
+		}
+		s = append(s, f)
+	}
+	return s
+}
+
+func TestMigrate(t *testing.T) {
+
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	rec.Datafields = []*marc21.Datafield{
+		{Tag: "020", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{
+			{Code: "a", Text: "0123456789 (pbk. ; alk. paper) :"},
+			{Code: "c", Text: "$12.00"},
+		}},
+		{Tag: "020", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{
+			{Code: "a", Text: "9780000000002"},
+			{Code: "b", Text: "cloth"},
+		}},
+		{Tag: "020", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{
+			{Code: "a", Text: "9780000000019"},
+			{Code: "c", Text: "(set)"},
+		}},
+		{Tag: "245", Ind1: "0", Ind2: "0", Subfields: []*marc21.Subfield{
+			{Code: "a", Text: "Journal."},
+			{Code: "d", Text: "Series B,"},
+			{Code: "e", Text: "Chemistry."},
+		}},
+		{Tag: "350", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{
+			{Code: "a", Text: "$5.00"},
+		}},
+		{Tag: "440", Ind1: " ", Ind2: "4", Subfields: []*marc21.Subfield{
+			{Code: "a", Text: "The series."},
+			{Code: "p", Text: "Subseries ;"},
+			{Code: "v", Text: "v. 3"},
+		}},
+		{Tag: "440", Ind1: " ", Ind2: "0", Subfields: []*marc21.Subfield{
+			{Code: "6", Text: "880-01"},
+			{Code: "a", Text: "Linked series"},
+		}},
+		{Tag: "503", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{
+			{Code: "a", Text: "Originally issued in 1899."},
+		}},
+		{Tag: "755", Ind1: " ", Ind2: " ", Subfields: []*marc21.Subfield{
+			{Code: "a", Text: "Braille."},
+			{Code: "2", Text: "local"},
+		}},
+	}
+
+	// The lint findings name the migrations
+	var migrations []string
+	for _, f := range Lint(rec) {
+		if f.Rule == "obsolete" {
+			migrations = append(migrations, f.Tag+" "+f.Migration)
+		}
+	}
+	expected := "020 020-b-to-q,245 245-d-e-to-n-p,245 245-d-e-to-n-p,350 350-to-037,440 440-to-490-830,440 ,503 5XX-to-500,755 755-to-655"
+	if strings.Join(migrations, ",") != expected {
+		t.Errorf("got %v\nexpected %s", migrations, expected)
+	}
+
+	mg, err := NewMigrator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := mg.Migrate(rec)
+
+	got := strings.Join(changeKeys(changes), "\n")
+	expected = strings.Join([]string{
+		"440-to-490-830 440/1",
+		"245-d-e-to-n-p 245/1",
+		"350-to-037 350/1",
+		"020-b-to-q 020/2",
+		"020-qualifier 020/1",
+		"020-qualifier 020/3",
+		"5XX-to-500 503/1",
+		"755-to-655 755/1",
+	}, "\n")
+	if got != expected {
+		t.Errorf("got changes\n%s\nexpected\n%s", got, expected)
+	}
+
+	got = strings.Join(fieldStrings(rec), "\n")
+	expected = strings.Join([]string{
+		"020    $a0123456789$qpbk.$qalk. paper$c$12.00",
+		"020    $a9780000000002$qcloth",
+		"020    $a9780000000019$qset",
+		"037    $c$5.00",
+		"245 00 $aJournal.$nSeries B,$pChemistry.",
+		"440  0 $6880-01$aLinked series",
+		"490 1  $aThe series. Subseries ;$vv. 3",
+		"500    $aOriginally issued in 1899.",
+		"655  7 $aBraille.$2local",
+		"830  4 $aThe series.$pSubseries ;$vv. 3",
+	}, "\n")
+	if got != expected {
+		t.Errorf("got fields\n%s\nexpected\n%s", got, expected)
+	}
+
+	// Only the linked 440 is left
+	if changes := mg.Migrate(rec); len(changes) != 0 {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+}
+
+func TestMigrateSeriesName(t *testing.T) {
+
+	rec := newTestRecord("00000nam a2200000 a 4500")
+	rec.Datafields = append(rec.Datafields, &marc21.Datafield{Tag: "400", Ind1: "1", Ind2: "0", Subfields: []*marc21.Subfield{
+		{Code: "a", Text: "Author, A."},
+		{Code: "t", Text: "Collected works ;"},
+		{Code: "v", Text: "v. 2"},
+	}})
+
+	mg, err := NewMigrator("400-to-490-800", "21X-to-246")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changes := mg.Migrate(rec); len(changes) != 1 || changes[0].Message != "replaced 400 with 490 and 800" {
+		t.Errorf("got %v", changes)
+	}
+
+	got := strings.Join(fieldStrings(rec)[2:], "\n")
+	expected := "490 1  $aAuthor, A. Collected works ;$vv. 2\n800 1  $aAuthor, A.$tCollected works ;$vv. 2"
+	if got != expected {
+		t.Errorf("got fields\n%s\nexpected\n%s", got, expected)
+	}
+
+	mg.Disable("400-to-490-800")
+	if len(mg.Migrations) != 1 || mg.Migrations[0].Name != "21X-to-246" {
+		t.Errorf("got %v", mg.Migrations)
+	}
+	if _, err := NewMigrator("no-such-migration"); err == nil {
+		t.Error("expected an error for an unknown migration")
+	}
+}
@@ -9,7 +9,6 @@ package marc21
 
 import (
 	"encoding/xml"
-	"errors"
 	"fmt"
 	"io"
 )
@@ -50,6 +49,12 @@ type Record struct {
 	Leader        Leader          `xml:"leader"`
 	Controlfields []*Controlfield `xml:"controlfield"`
 	Datafields    []*Datafield    `xml:"datafield"`
+	// Warnings are the structural problems that were found (and
+	// recovered from) when parsing the record
+	Warnings []ParseWarning `xml:"-"`
+
+	// raw holds the original bytes of the record, see KeepRaw
+	raw *rawRecord
 }
 
 // directoryEntry contains a single directory entry
@@ -57,12 +62,16 @@ type directoryEntry struct {
 	tag         string
 	startingPos int
 	fieldLength int
+	invalid     bool
 }
 
 // Controlfield contains a controlfield entry
 type Controlfield struct {
 	Tag  string `xml:"tag,attr"`
 	Text string `xml:",chardata"`
+	// seq is the position of the field in the source record, see
+	// fieldOrder
+	seq int
 }
 
 // Datafield contains a datafield entry
@@ -71,6 +80,9 @@ type Datafield struct {
 	Ind1      string      `xml:"ind1,attr"`
 	Ind2      string      `xml:"ind2,attr"`
 	Subfields []*Subfield `xml:"subfield"`
+	// seq is the position of the field in the source record, see
+	// fieldOrder
+	seq int
 }
 
 // Subfield contains a subfield entry
@@ -98,86 +110,84 @@ func ParseNextRecord(r io.Reader) (rec *Record, err error) {
 
 // NextRecord reads the next MARC record and returns the unparsed bytes
 func NextRecord(r io.Reader) (rawRec []byte, err error) {
+	return readRecord(r)
+}
+
+// readRecord reads the next MARC record from r. The reads are repeated
+// until the full record is obtained, as a single Read is not
+// guaranteed to fill the buffer. io.EOF is only returned when there is
+// no data at all, a partial record is io.ErrUnexpectedEOF.
+func readRecord(r io.Reader) (rawRec []byte, err error) {
 
 	// Read the first 5 bytes, determine the record length and
 	//    read the remainder of the record
 	rawLen := make([]byte, 5)
-	_, err = r.Read(rawLen)
+	n, err := io.ReadFull(r, rawLen)
 	if err != nil {
-		return nil, err
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, newParseError(n, "", err)
 	}
 
-	recLen, err := toInt(rawLen[0:5])
+	recLen, err := recordLength(rawLen)
 	if err != nil {
 		return nil, err
 	}
 
-	// Ensure that we have a "sane" record length?
-	if recLen <= leaderLen {
-		err = errors.New("MARC record is too short")
-		return nil, err
-	} else if recLen > maxRecordSize {
-		err = errors.New("MARC record is too long")
-		return nil, err
-	}
-
 	rawRec = make([]byte, recLen)
 	// ensure that the raw len is available for the leader
 	copy(rawRec, rawLen)
 
 	// Read the remainder of the record
-	_, err = r.Read(rawRec[5:recLen])
+	n, err = io.ReadFull(r, rawRec[5:recLen])
 	if err != nil {
-		return nil, err
+		return nil, newParseError(5+n, "", unexpectedEOF(err))
 	}
 
 	// The last byte should be a record terminator
 	if rawRec[len(rawRec)-1] != recordTerminator {
-		return nil, errors.New("Record terminator not found at end of record")
+		return nil, newParseError(recLen-1, "", ErrNoRecordTerminator)
 	}
 
 	return rawRec, nil
 }
 
-// ParseRecord takes the bytes for a MARC record and returns the parsed
-// record structure
-func ParseRecord(rawRec []byte) (rec *Record, err error) {
-
-	rec = new(Record)
+// recordLength returns the record length from the first 5 bytes of a
+// record, ensuring that it is a "sane" value
+func recordLength(rawLen []byte) (recLen int, err error) {
 
-	rec.Leader.Text = string(rawRec[:24])
-
-	dir, err := parseDirectory(rawRec)
-	if err != nil {
-		return nil, err
-	}
-
-	baseDataAddress, err := toInt(rawRec[12:17])
+	recLen, err = toInt(rawLen[0:5])
 	if err != nil {
-		return nil, err
+		return 0, newParseError(0, "", err)
 	}
 
-	rec.Controlfields, err = extractControlfields(rawRec, baseDataAddress, dir)
-	if err != nil {
-		return nil, err
+	if recLen <= leaderLen {
+		return 0, newParseError(0, "", ErrRecordTooShort)
+	} else if recLen > maxRecordSize {
+		return 0, newParseError(0, "", ErrRecordTooLong)
 	}
 
-	rec.Datafields, err = extractDatafields(rawRec, baseDataAddress, dir)
-	if err != nil {
-		return nil, err
-	}
+	return recLen, nil
+}
 
-	return rec, nil
+// ParseRecord takes the bytes for a MARC record and returns the parsed
+// record structure using the default (strict) parse options
+func ParseRecord(rawRec []byte) (rec *Record, err error) {
+	return ParseRecordWithOptions(rawRec, ParseOptions{})
 }
 
 // Implement the Stringer interface for "Pretty-printing"
 func (rec Record) String() string {
 
 	ret := fmt.Sprintf("LDR %s\n", rec.Leader.Text)
-	for _, cf := range rec.Controlfields {
-		ret += fmt.Sprintf("%s     %s\n", cf.GetTag(), cf.GetText())
-	}
-	for _, df := range rec.Datafields {
+	for _, f := range rec.fieldOrder() {
+		if f.control {
+			cf := rec.Controlfields[f.index]
+			ret += fmt.Sprintf("%s     %s\n", cf.GetTag(), cf.GetText())
+			continue
+		}
+		df := rec.Datafields[f.index]
 		pre := fmt.Sprintf("%s %s%s _", df.GetTag(), df.GetInd1(), df.GetInd2())
 		for _, sf := range df.Subfields {
 			ret += fmt.Sprintf("%s%s%s\n", pre, sf.GetCode(), sf.GetText())
@@ -187,82 +197,17 @@ func (rec Record) String() string {
 	return ret
 }
 
-// RecordAsMARC converts a Record into a MARC record byte array
+// RecordAsMARC converts a Record into a MARC record byte array. An
+// error is returned if the record cannot be represented as a valid
+// MARC record (see Writer for the limits that are checked). Records
+// that were parsed with KeepRaw and have not been modified are returned
+// as the original bytes.
 func (rec Record) RecordAsMARC() (marc []byte, err error) {
 
-	if rec.Leader.Text == "" {
-		err = errors.New("record Leader is undefined")
-		return marc, err
-	}
-
-	var dir []directoryEntry
-	var rawDir []byte
-	var cfs []byte
-	var dfs []byte
-	var startPos int
-
-	// Pack the control fields
-	for _, cf := range rec.Controlfields {
-
-		if cf.GetText() == "" {
-			continue
-		}
-
-		b := []byte(cf.GetText())
-		b = append(b, fieldTerminator)
-		cfs = append(cfs, b...)
-
-		dir = append(dir, directoryEntry{tag: cf.GetTag(), startingPos: startPos, fieldLength: len(b)})
-
-		startPos += len(b)
-	}
-
-	// Pack the data fields/sub-fields
-	for _, df := range rec.Datafields {
-
-		ind1 := df.GetInd1()
-		ind2 := df.GetInd2()
-
-		b := []byte(ind1)
-		b = append(b, []byte(ind2)...)
-
-		for _, sf := range df.Subfields {
-			b = append(b, delimiter)
-			b = append(b, []byte(sf.GetCode())...)
-			b = append(b, []byte(sf.GetText())...)
-		}
-		b = append(b, fieldTerminator)
-		dfs = append(dfs, b...)
-
-		dir = append(dir, directoryEntry{tag: df.GetTag(), startingPos: startPos, fieldLength: len(b)})
-
-		startPos += len(b)
-	}
-
-	// Generate the directory
-	for _, de := range dir {
-		rawDir = append(rawDir, []byte(de.tag)...)
-		rawDir = append(rawDir, []byte(fmt.Sprintf("%04d", de.fieldLength))...)
-		rawDir = append(rawDir, []byte(fmt.Sprintf("%05d", de.startingPos))...)
-	}
-	rawDir = append(rawDir, fieldTerminator)
-
-	// Build the leader
-	recLen := []byte(fmt.Sprintf("%05d", 24+len(rawDir)+len(cfs)+len(dfs)+1))
-	recBaseDataAddress := []byte(fmt.Sprintf("%05d", 24+len(rawDir)))
-
-	ldr := []byte(rec.Leader.Text)
-	for i := 0; i <= 4; i++ {
-		ldr[i] = recLen[i]
-		ldr[i+12] = recBaseDataAddress[i]
+	recs, _, err := encodeRecord(&rec, RejectOversize)
+	if err != nil {
+		return nil, err
 	}
 
-	// Final assembly
-	marc = append(marc, ldr...)
-	marc = append(marc, rawDir...)
-	marc = append(marc, cfs...)
-	marc = append(marc, dfs...)
-	marc = append(marc, recordTerminator)
-
-	return marc, nil
+	return recs[0], nil
 }
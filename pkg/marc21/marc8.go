@@ -0,0 +1,651 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+https://www.loc.gov/marc/specifications/speccharmarc8.html
+
+    MARC-8 uses the ISO 2022 code extension techniques. At the start
+    of each field the default graphic sets are in effect: Basic Latin
+    (ASCII) as G0 and Extended Latin (ANSEL) as G1. Alternate sets are
+    designated by escape sequences that remain in effect until another
+    set is designated or the end of the field is reached.
+
+    Technique 1 (Greek symbols, subscripts, superscripts):
+        ESC g, ESC b, ESC p designate the set as G0
+        ESC s returns G0 to Basic Latin
+
+    Technique 2 (ISO 2022 designation):
+        ESC ( F  or  ESC , F      designate single-byte set F as G0
+        ESC ) F  or  ESC - F      designate single-byte set F as G1
+        ESC $ F  or  ESC $ , F    designate multi-byte set F as G0
+        ESC $ ) F  or  ESC $ - F  designate multi-byte set F as G1
+
+https://www.loc.gov/marc/specifications/speccharucs.html
+
+    In MARC-8 the combining (non-spacing) characters precede the
+    character they modify whereas in Unicode they follow it. Multiple
+    combining characters are retained in the order in which they were
+    encountered.
+*/
+
+const escape = byte(0x1b)
+
+// marc8Decoder holds the state needed for converting one MARC-8
+// encoded field to UTF-8
+type marc8Decoder struct {
+	g0      byte
+	g1      byte
+	pending []rune
+	out     strings.Builder
+}
+
+// newMARC8Decoder returns a decoder with the default graphic sets
+// designated
+func newMARC8Decoder() *marc8Decoder {
+	return &marc8Decoder{g0: csBasicLatin, g1: csExtendedLatin}
+}
+
+// MARC8ToUTF8 converts a MARC-8 encoded string to UTF-8. Characters
+// that have no mapping are replaced with the Unicode replacement
+// character (U+FFFD) and numeric character references (&#xXXXX;) are
+// replaced with the characters that they represent.
+func MARC8ToUTF8(s string) string {
+	d := newMARC8Decoder()
+	d.decode(s)
+	return d.flush()
+}
+
+// decode converts the supplied MARC-8 text, appending the results to
+// the output of the decoder. Escape state is retained across calls so
+// that the subfields of a datafield may be decoded as a unit.
+func (d *marc8Decoder) decode(s string) {
+
+	b := []byte(s)
+
+	for i := 0; i < len(b); {
+		i += d.step(b[i:])
+	}
+}
+
+// step decodes the character (or escape sequence) at the start of b
+// and returns the number of bytes consumed
+func (d *marc8Decoder) step(b []byte) int {
+
+	c := b[0]
+
+	switch {
+	case c == escape:
+		return d.designate(b)
+
+	case c <= 0x20 || c == 0x7f:
+		d.emit(rune(c))
+		return 1
+
+	case c < 0x7f:
+		return d.decodeGraphic(d.g0, b)
+
+	case c >= 0x80 && c <= 0xa0:
+		r, ok := marc8C1[c]
+		if !ok {
+			r = unicode.ReplacementChar
+		}
+		d.emit(r)
+		return 1
+
+	case c < 0xff:
+		return d.decodeGraphic(d.g1, b)
+	}
+
+	d.emit(unicode.ReplacementChar)
+	return 1
+}
+
+// resetSequence returns the escape sequences that return the decoder to
+// the default graphic sets
+func (d *marc8Decoder) resetSequence() (seq []byte) {
+	switch d.g0 {
+	case csBasicLatin:
+	case csSubscript, csSuperscript, csGreekSymbol:
+		seq = append(seq, escape, 's')
+	default:
+		seq = append(seq, escape, '(', csBasicLatin)
+	}
+	if d.g1 != csExtendedLatin {
+		seq = append(seq, escape, ')', '!', csExtendedLatin)
+	}
+	return seq
+}
+
+// marc8Truncate returns the length, no more than n, to which the MARC-8
+// encoded data b may be cut without splitting an escape sequence or a
+// multi-byte (EACC) character, or separating combining characters from
+// the character that they modify. It also returns the escape sequences
+// that are needed at that point to return to the default graphic sets,
+// which fit within n.
+func marc8Truncate(b []byte, n int) (length int, reset []byte) {
+
+	d := newMARC8Decoder()
+	for i := 0; i <= n && i < len(b); {
+		if len(d.pending) == 0 {
+			seq := d.resetSequence()
+			if i+len(seq) <= n {
+				length, reset = i, seq
+			}
+		}
+		i += d.step(b[i:])
+	}
+
+	return length, reset
+}
+
+// designate processes the escape sequence at the start of b and returns
+// the number of bytes consumed
+func (d *marc8Decoder) designate(b []byte) int {
+
+	if len(b) < 2 {
+		return len(b)
+	}
+
+	switch b[1] {
+	case csGreekSymbol, csSubscript, csSuperscript:
+		d.g0 = b[1]
+		return 2
+	case 's':
+		d.g0 = csBasicLatin
+		return 2
+	case '(', ',':
+		if cs, n := finalCharacter(b[2:]); n > 0 {
+			d.g0 = cs
+			return 2 + n
+		}
+	case ')', '-':
+		if cs, n := finalCharacter(b[2:]); n > 0 {
+			d.g1 = cs
+			return 2 + n
+		}
+	case '$':
+		if len(b) > 3 {
+			switch b[2] {
+			case '(', ',':
+				d.g0 = b[3]
+				return 4
+			case ')', '-':
+				d.g1 = b[3]
+				return 4
+			}
+		}
+		if len(b) > 2 {
+			d.g0 = b[2]
+			return 3
+		}
+	}
+
+	// Not a recognized escape sequence, drop the escape and carry on
+	return 1
+}
+
+// finalCharacter returns the character set identified by the final
+// character(s) of a designating escape sequence and the number of bytes
+// used. The Extended Latin (ANSEL) set is identified by the two byte
+// final "!E".
+func finalCharacter(b []byte) (cs byte, n int) {
+	switch {
+	case len(b) == 0:
+		return 0, 0
+	case b[0] == '!':
+		if len(b) < 2 {
+			return 0, 0
+		}
+		return b[1], 2
+	}
+	return b[0], 1
+}
+
+// decodeGraphic decodes the graphic character at the start of b using
+// the specified character set and returns the number of bytes consumed
+func (d *marc8Decoder) decodeGraphic(cs byte, b []byte) int {
+
+	if cs == csEACC {
+		if len(b) < 3 {
+			d.emit(unicode.ReplacementChar)
+			return len(b)
+		}
+		code := int(b[0]&0x7f)<<16 | int(b[1]&0x7f)<<8 | int(b[2]&0x7f)
+		r, ok := eaccToUCS[code]
+		if !ok {
+			r = unicode.ReplacementChar
+		}
+		d.emit(r)
+		return 3
+	}
+
+	c := b[0] & 0x7f
+
+	if cs == csBasicLatin {
+		d.emit(rune(c))
+		return 1
+	}
+
+	r, ok := marc8Sets[cs][c]
+	if !ok {
+		// The non-Latin sets share the ASCII digits and punctuation
+		if c <= 0x40 {
+			r = rune(c)
+		} else {
+			r = unicode.ReplacementChar
+		}
+	}
+	d.emit(r)
+	return 1
+}
+
+// emit writes the rune to the output, holding combining characters
+// until the character that they modify has been written
+func (d *marc8Decoder) emit(r rune) {
+	if unicode.Is(unicode.Mn, r) {
+		d.pending = append(d.pending, r)
+		return
+	}
+	d.out.WriteRune(r)
+	d.writePending()
+}
+
+// writePending writes any held combining characters to the output
+func (d *marc8Decoder) writePending() {
+	for _, p := range d.pending {
+		d.out.WriteRune(p)
+	}
+	d.pending = d.pending[:0]
+}
+
+// flush returns the decoded text and resets the decoder output. Any
+// combining characters that did not precede a base character are
+// written as-is and any numeric character references are converted.
+func (d *marc8Decoder) flush() string {
+	d.writePending()
+	s := decodeNCRs(d.out.String())
+	d.out.Reset()
+	return s
+}
+
+// ConvertToUTF8 converts the controlfields and datafields of a MARC-8
+// encoded record to UTF-8 and sets the Leader/09 character coding
+// scheme to "a" (UCS/Unicode). Records that are already flagged as
+// Unicode are not changed.
+func (rec *Record) ConvertToUTF8() error {
+
+	if len(rec.Leader.Text) < leaderLen {
+		return errors.New("record Leader is undefined or too short")
+	}
+
+	if code, _ := rec.CharacterCodingScheme(); code == "a" {
+		return nil
+	}
+
+	for _, cf := range rec.Controlfields {
+		cf.Text = MARC8ToUTF8(cf.Text)
+	}
+
+	for _, df := range rec.Datafields {
+		// escape sequences may persist across the subfields of a field
+		d := newMARC8Decoder()
+		for _, sf := range df.Subfields {
+			d.decode(sf.Text)
+			sf.Text = d.flush()
+		}
+	}
+
+	ldr := []byte(rec.Leader.Text)
+	ldr[9] = 'a'
+	rec.Leader.Text = string(ldr)
+
+	return nil
+}
+
+// ParseNextRecordUTF8 reads the next MARC record and returns the parsed
+// record structure with MARC-8 encoded records converted to UTF-8
+func ParseNextRecordUTF8(r io.Reader) (rec *Record, err error) {
+
+	rec, err = ParseNextRecord(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rec.ConvertToUTF8()
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+/*
+https://www.loc.gov/marc/specifications/speccharconversion.html
+
+    When converting from UCS/Unicode to MARC-8, characters that do not
+    exist in the MARC-8 repertoire are to be represented using a
+    numeric character reference (NCR) in the hexadecimal form &#xXXXX;
+    where XXXX is the UCS code point of the character. When converting
+    from MARC-8 to Unicode, such NCRs are converted back to the
+    character that they represent.
+*/
+
+// marc8Code is the character set and (7-bit) code for a character
+type marc8Code struct {
+	cs   byte
+	code byte
+}
+
+// MARC8Fallback identifies a controlfield or subfield containing
+// characters that could not be represented in MARC-8 and that were
+// written as numeric character references instead
+type MARC8Fallback struct {
+	Tag   string
+	Code  string
+	Chars []rune
+}
+
+var (
+	ucsToMARC8     map[rune]marc8Code
+	ucsToMARC8Sets map[byte]map[rune]byte
+	ucsToEACC      map[rune]int
+	ucsToMARC8Once sync.Once
+)
+
+// marc8SetPrecedence is the order in which the character sets are
+// searched when encoding. Extended Latin comes first as it is the
+// default G1 set (and so requires no escape sequence).
+var marc8SetPrecedence = []byte{
+	csExtendedLatin,
+	csBasicCyrillic,
+	csExtendedCyrillic,
+	csBasicGreek,
+	csBasicHebrew,
+	csBasicArabic,
+	csExtendedArabic,
+	csSubscript,
+	csSuperscript,
+	csGreekSymbol,
+}
+
+// loadUCSToMARC8 builds the reverse lookup tables used for encoding
+func loadUCSToMARC8() {
+
+	ucsToMARC8 = make(map[rune]marc8Code)
+	ucsToMARC8Sets = make(map[byte]map[rune]byte)
+
+	for i := len(marc8SetPrecedence) - 1; i >= 0; i-- {
+		cs := marc8SetPrecedence[i]
+		ucsToMARC8Sets[cs] = make(map[rune]byte)
+		for code, r := range marc8Sets[cs] {
+			ucsToMARC8Sets[cs][r] = code
+			ucsToMARC8[r] = marc8Code{cs: cs, code: code}
+		}
+	}
+
+	ucsToEACC = make(map[rune]int)
+	for code, r := range eaccToUCS {
+		if c, ok := ucsToEACC[r]; !ok || code < c {
+			ucsToEACC[r] = code
+		}
+	}
+}
+
+// marc8Encoder holds the state needed for converting UTF-8 text to
+// MARC-8
+type marc8Encoder struct {
+	g0        byte
+	g1        byte
+	out       []byte
+	fallbacks []rune
+}
+
+// UTF8ToMARC8 converts a UTF-8 string to MARC-8. Any characters that
+// cannot be represented in MARC-8 are written as numeric character
+// references and are returned as fallbacks. An ampersand that would
+// otherwise start a numeric character reference is itself written as
+// one (&#x0026;) so that MARC8ToUTF8 restores the original text.
+func UTF8ToMARC8(s string) (marc8 string, fallbacks []rune) {
+	ucsToMARC8Once.Do(loadUCSToMARC8)
+
+	e := &marc8Encoder{g0: csBasicLatin, g1: csExtendedLatin}
+	e.encode(s)
+	return string(e.out), e.fallbacks
+}
+
+// encode converts the text, reordering combining characters to precede
+// the character that they modify, and returns the sets to their
+// defaults at the end
+func (e *marc8Encoder) encode(s string) {
+
+	var runes []rune
+	for _, r := range s {
+		if _, ok := ucsToMARC8[r]; ok {
+			runes = append(runes, r)
+		} else if d, ok := marc8Decompositions[r]; ok {
+			runes = append(runes, []rune(d)...)
+		} else {
+			runes = append(runes, r)
+		}
+	}
+
+	for i := 0; i < len(runes); {
+		j := i + 1
+		for j < len(runes) && unicode.Is(unicode.Mn, runes[j]) {
+			j++
+		}
+
+		for _, m := range runes[i+1 : j] {
+			e.encodeRune(m)
+		}
+		if runes[i] == '&' && j+1 < len(runes) && runes[j] == '#' && runes[j+1] == 'x' {
+			// Not a reference, escape it so that it is not decoded
+			// as one
+			e.designateG0(csBasicLatin)
+			e.out = append(e.out, "&#x0026;"...)
+		} else {
+			e.encodeRune(runes[i])
+		}
+		i = j
+	}
+
+	e.designateG0(csBasicLatin)
+	e.designateG1(csExtendedLatin)
+}
+
+// encodeRune writes the MARC-8 bytes for a single character, including
+// any escape sequences needed to designate the character set
+func (e *marc8Encoder) encodeRune(r rune) {
+
+	switch {
+	case r < 0x80:
+		c := byte(r)
+		if c > 0x20 && c < 0x7f && !e.sharesASCII(c) {
+			e.designateG0(csBasicLatin)
+		}
+		e.out = append(e.out, c)
+		return
+	case r >= 0x80 && r <= 0x9f:
+		for c, u := range marc8C1 {
+			if u == r {
+				e.out = append(e.out, c)
+				return
+			}
+		}
+	case r == 0x200c || r == 0x200d:
+		for c, u := range marc8C1 {
+			if u == r {
+				e.out = append(e.out, c)
+				return
+			}
+		}
+	}
+
+	// Prefer whichever sets are currently designated
+	if code, ok := ucsToMARC8Sets[e.g0][r]; ok {
+		e.out = append(e.out, code)
+		return
+	}
+	if code, ok := ucsToMARC8Sets[e.g1][r]; ok {
+		e.out = append(e.out, code|0x80)
+		return
+	}
+
+	if mc, ok := ucsToMARC8[r]; ok {
+		switch mc.cs {
+		case csExtendedLatin, csExtendedCyrillic, csExtendedArabic:
+			e.designateG1(mc.cs)
+			e.out = append(e.out, mc.code|0x80)
+		default:
+			e.designateG0(mc.cs)
+			e.out = append(e.out, mc.code)
+		}
+		return
+	}
+
+	if code, ok := ucsToEACC[r]; ok {
+		e.designateG0(csEACC)
+		e.out = append(e.out, byte(code>>16), byte(code>>8), byte(code))
+		return
+	}
+
+	// No MARC-8 equivalent, use a numeric character reference
+	e.fallbacks = append(e.fallbacks, r)
+	e.designateG0(csBasicLatin)
+	e.out = append(e.out, []byte(fmt.Sprintf("&#x%04X;", r))...)
+}
+
+// sharesASCII indicates whether the ASCII character may be written
+// without returning G0 to Basic Latin
+func (e *marc8Encoder) sharesASCII(c byte) bool {
+	switch e.g0 {
+	case csBasicLatin:
+		return true
+	case csBasicHebrew, csBasicArabic, csBasicCyrillic, csBasicGreek:
+		_, ok := marc8Sets[e.g0][c]
+		return c <= 0x40 && !ok
+	}
+	return false
+}
+
+// designateG0 writes the escape sequence for designating the G0 set
+func (e *marc8Encoder) designateG0(cs byte) {
+	if e.g0 == cs {
+		return
+	}
+
+	switch {
+	case cs == csBasicLatin && (e.g0 == csSubscript || e.g0 == csSuperscript || e.g0 == csGreekSymbol):
+		e.out = append(e.out, escape, 's')
+	case cs == csSubscript || cs == csSuperscript || cs == csGreekSymbol:
+		e.out = append(e.out, escape, cs)
+	case cs == csEACC:
+		e.out = append(e.out, escape, '$', cs)
+	default:
+		e.out = append(e.out, escape, '(', cs)
+	}
+	e.g0 = cs
+}
+
+// designateG1 writes the escape sequence for designating the G1 set
+func (e *marc8Encoder) designateG1(cs byte) {
+	if e.g1 == cs {
+		return
+	}
+	e.out = append(e.out, escape, ')')
+	if cs == csExtendedLatin {
+		e.out = append(e.out, '!')
+	}
+	e.out = append(e.out, cs)
+	e.g1 = cs
+}
+
+// ConvertToMARC8 converts the controlfields and datafields of a UTF-8
+// encoded record to MARC-8 and sets the Leader/09 character coding
+// scheme to blank (MARC-8). Characters that cannot be represented in
+// MARC-8 are written as numeric character references and the fields
+// containing them are returned. Records that are already flagged as
+// MARC-8 are not changed.
+func (rec *Record) ConvertToMARC8() (fallbacks []MARC8Fallback, err error) {
+
+	if len(rec.Leader.Text) < leaderLen {
+		return nil, errors.New("record Leader is undefined or too short")
+	}
+
+	if code, _ := rec.CharacterCodingScheme(); code != "a" {
+		return nil, nil
+	}
+
+	for _, cf := range rec.Controlfields {
+		var fb []rune
+		cf.Text, fb = UTF8ToMARC8(cf.Text)
+		if len(fb) > 0 {
+			fallbacks = append(fallbacks, MARC8Fallback{Tag: cf.Tag, Chars: fb})
+		}
+	}
+
+	for _, df := range rec.Datafields {
+		for _, sf := range df.Subfields {
+			var fb []rune
+			sf.Text, fb = UTF8ToMARC8(sf.Text)
+			if len(fb) > 0 {
+				fallbacks = append(fallbacks, MARC8Fallback{Tag: df.Tag, Code: sf.Code, Chars: fb})
+			}
+		}
+	}
+
+	ldr := []byte(rec.Leader.Text)
+	ldr[9] = ' '
+	rec.Leader.Text = string(ldr)
+
+	return fallbacks, nil
+}
+
+// decodeNCRs replaces any hexadecimal numeric character references in
+// the string with the characters that they represent
+func decodeNCRs(s string) string {
+
+	if !strings.Contains(s, "&#x") {
+		return s
+	}
+
+	var sb strings.Builder
+	for {
+		i := strings.Index(s, "&#x")
+		if i < 0 {
+			break
+		}
+		j := strings.IndexByte(s[i:], ';')
+		if j < 0 {
+			break
+		}
+
+		v, err := strconv.ParseUint(s[i+3:i+j], 16, 32)
+		if err != nil || j == 3 || !utf8.ValidRune(rune(v)) {
+			sb.WriteString(s[:i+3])
+			s = s[i+3:]
+			continue
+		}
+
+		sb.WriteString(s[:i])
+		sb.WriteRune(rune(v))
+		s = s[i+j+1:]
+	}
+	sb.WriteString(s)
+
+	return sb.String()
+}
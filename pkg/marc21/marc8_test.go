@@ -0,0 +1,213 @@
+package marc21
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMARC8ToUTF8(t *testing.T) {
+
+	var tests = []struct {
+		name  string
+		marc8 string
+		utf8  string
+	}{
+		{"ASCII", "Plain text", "Plain text"},
+		{"ANSEL spacing", "\xb1\xf3d\xba", "\u0142d\u0324\u00f0"},
+		{"Diacritic reorder", "Caf\xe2e", "Cafe\u0301"},
+		{"Multiple diacritics", "\xe3\xe2a", "a\u0302\u0301"},
+		{"Ligature halves", "\xebt\xecs", "t\ufe20s\ufe21"},
+		{"Basic Cyrillic", "\x1b(NABC\x1b(B.", "\u0430\u0431\u0446."},
+		{"Extended Cyrillic G1", "\x1b)Q\xc4", "\u0451"},
+		{"Cyrillic G1 to ANSEL", "\x1b)Q\xc0\x1b)!E\xe2a", "\u0491a\u0301"},
+		{"Cyrillic G0 to ANSEL", "\x1b(NA\x1b(!E\x62\x1b(Ba", "\u0430a\u0301"},
+		{"Basic Greek", "\x1b(S\x22ab\x1b(B", "\u03b1\u0301\u03b2"},
+		{"Hebrew", "\x1b(2`ab\x1b(B", "\u05d0\u05d1\u05d2"},
+		{"Subscript", "H\x1bb2\x1bsO", "H\u2082O"},
+		{"Superscript", "x\x1bp2\x1bs", "x\u00b2"},
+		{"Greek symbol", "\x1bga\x1bs-ray", "\u03b1-ray"},
+		{"Non-sort", "\x88The \x89Title", "\u0098The \u009cTitle"},
+		{"Unmapped", "a\xffb", "a\ufffdb"},
+	}
+
+	for _, tc := range tests {
+		got := MARC8ToUTF8(tc.marc8)
+		if got != tc.utf8 {
+			t.Errorf("MARC8ToUTF8(%s) = %q, expected %q", tc.name, got, tc.utf8)
+		}
+	}
+}
+
+func TestMARC8EACC(t *testing.T) {
+
+	if len(eaccToUCS) == 0 {
+		t.Fatal("EACC table not generated, see codegen/README.md")
+	}
+
+	tests := []struct {
+		name  string
+		marc8 string
+		utf8  string
+	}{
+		{"ideograph", "\x1b$1\x21\x30\x21", "\u4e00"},
+		{"with ASCII", "a\x1b$1\x21\x30\x21\x1b(Bb", "a\u4e00b"},
+		{"G0 designation", "\x1b$\x2c1\x21\x30\x21\x1b(B", "\u4e00"},
+	}
+
+	for _, tc := range tests {
+		got := MARC8ToUTF8(tc.marc8)
+		if got != tc.utf8 {
+			t.Errorf("MARC8ToUTF8(%s) = %q, expected %q", tc.name, got, tc.utf8)
+		}
+	}
+
+	// Every mapping in the table should decode to its code point
+	for code, r := range eaccToUCS {
+		in := "\x1b$1" + string([]byte{byte(code >> 16), byte(code >> 8), byte(code)}) + "\x1b(B"
+		if got := MARC8ToUTF8(in); got != string(r) {
+			t.Errorf("MARC8ToUTF8(0x%06X) = %q, expected %q", code, got, string(r))
+		}
+	}
+}
+
+func TestConvertToUTF8(t *testing.T) {
+
+	rec := &Record{
+		Leader: Leader{Text: "00000nam  2200000   4500"},
+		Controlfields: []*Controlfield{
+			{Tag: "001", Text: "12345"},
+		},
+		Datafields: []*Datafield{
+			{Tag: "245", Ind1: "1", Ind2: "0", Subfields: []*Subfield{
+				{Code: "a", Text: "\x1b(NKNIGA :"},
+				{Code: "b", Text: "ABC\x1b(B."},
+			}},
+		},
+	}
+
+	err := rec.ConvertToUTF8()
+	if err != nil {
+		t.Fatalf("ConvertToUTF8() failed: %q", err)
+	}
+
+	if code, _ := rec.CharacterCodingScheme(); code != "a" {
+		t.Errorf("ConvertToUTF8() did not set Leader/09, got %q", code)
+	}
+
+	// The escape to Cyrillic carries over into the second subfield
+	sfs := rec.Datafields[0].Subfields
+	if sfs[0].Text != "\u043a\u043d\u0438\u0433\u0430 :" || sfs[1].Text != "\u0430\u0431\u0446." {
+		t.Errorf("ConvertToUTF8() returned %q, %q", sfs[0].Text, sfs[1].Text)
+	}
+
+	// A second conversion should not change anything
+	marc, _ := rec.RecordAsMARC()
+	err = rec.ConvertToUTF8()
+	if err != nil {
+		t.Errorf("ConvertToUTF8() failed: %q", err)
+	}
+	marc2, _ := rec.RecordAsMARC()
+	if !bytes.Equal(marc, marc2) {
+		t.Errorf("ConvertToUTF8() modified a UTF-8 record")
+	}
+}
+
+func TestUTF8ToMARC8(t *testing.T) {
+
+	var tests = []struct {
+		name  string
+		utf8  string
+		marc8 string
+	}{
+		{"ASCII", "Plain text", "Plain text"},
+		{"Precomposed", "Café", "Caf\xe2e"},
+		{"Decomposed", "Café", "Caf\xe2e"},
+		{"Cyrillic", "книга 1", "\x1b(NKNIGA 1\x1b(B"},
+		{"Extended Cyrillic", "ёж", "\x1b)Q\xc4\x1b(NV\x1b(B\x1b)!E"},
+		{"Extended Cyrillic to ANSEL", "ђxa\u0301", "\x1b)Q\xc1x\x1b)!E\xe2a"},
+		{"Literal NCR", "a&#x263A;b & c", "a&#x0026;#x263A;b & c"},
+		{"Subscript", "H₂O", "H\x1bb2\x1bsO"},
+		{"NCR fallback", "a☺b", "a&#x263A;b"},
+	}
+
+	for _, tc := range tests {
+		got, _ := UTF8ToMARC8(tc.utf8)
+		if got != tc.marc8 {
+			t.Errorf("UTF8ToMARC8(%s) = %q, expected %q", tc.name, got, tc.marc8)
+		}
+
+		back := MARC8ToUTF8(got)
+		if tc.name != "Precomposed" && back != tc.utf8 {
+			t.Errorf("MARC8ToUTF8(UTF8ToMARC8(%s)) = %q, expected %q", tc.name, back, tc.utf8)
+		}
+	}
+}
+
+func TestUTF8ToMARC8EACC(t *testing.T) {
+
+	if len(eaccToUCS) == 0 {
+		t.Fatal("EACC table not generated, see codegen/README.md")
+	}
+
+	got, fallbacks := UTF8ToMARC8("a\u4e00b")
+	if got != "a\x1b$1\x21\x30\x21\x1b(Bb" || len(fallbacks) != 0 {
+		t.Errorf("UTF8ToMARC8(EACC) = %q, %q", got, fallbacks)
+	}
+
+	// Every ideograph in the table should survive the round trip
+	var b strings.Builder
+	for _, r := range eaccToUCS {
+		b.WriteRune(r)
+	}
+	s := b.String()
+
+	got, fallbacks = UTF8ToMARC8(s)
+	if len(fallbacks) != 0 {
+		t.Errorf("UTF8ToMARC8(EACC) used %d fallbacks", len(fallbacks))
+	}
+	if back := MARC8ToUTF8(got); back != s {
+		t.Errorf("MARC8ToUTF8(UTF8ToMARC8(EACC)) did not round trip")
+	}
+}
+
+func TestConvertToMARC8(t *testing.T) {
+
+	rec := &Record{
+		Leader: Leader{Text: "00000nam a2200000   4500"},
+		Controlfields: []*Controlfield{
+			{Tag: "001", Text: "12345"},
+		},
+		Datafields: []*Datafield{
+			{Tag: "245", Ind1: "1", Ind2: "0", Subfields: []*Subfield{
+				{Code: "a", Text: "Café :"},
+				{Code: "b", Text: "☃ snow."},
+			}},
+		},
+	}
+
+	fallbacks, err := rec.ConvertToMARC8()
+	if err != nil {
+		t.Fatalf("ConvertToMARC8() failed: %q", err)
+	}
+
+	if code, _ := rec.CharacterCodingScheme(); code != " " {
+		t.Errorf("ConvertToMARC8() did not set Leader/09, got %q", code)
+	}
+
+	if len(fallbacks) != 1 || fallbacks[0].Tag != "245" || fallbacks[0].Code != "b" || fallbacks[0].Chars[0] != '☃' {
+		t.Errorf("ConvertToMARC8() returned fallbacks %v", fallbacks)
+	}
+
+	if rec.Datafields[0].Subfields[1].Text != "&#x2603; snow." {
+		t.Errorf("ConvertToMARC8() returned %q", rec.Datafields[0].Subfields[1].Text)
+	}
+
+	err = rec.ConvertToUTF8()
+	if err != nil {
+		t.Fatalf("ConvertToUTF8() failed: %q", err)
+	}
+	if rec.Datafields[0].Subfields[1].Text != "☃ snow." {
+		t.Errorf("ConvertToUTF8() did not restore the NCR, got %q", rec.Datafields[0].Subfields[1].Text)
+	}
+}
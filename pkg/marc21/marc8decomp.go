@@ -0,0 +1,744 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+// marc8Decompositions lists the canonical decompositions (from the
+// Unicode Character Database) for those precomposed characters whose
+// components are all available in the MARC-8 character sets. MARC-8
+// has no precomposed letters, so these are written as base character
+// plus combining diacritics.
+var marc8Decompositions = map[rune]string{
+	0x00C0: "A\u0300",                  // LATIN CAPITAL LETTER A WITH GRAVE
+	0x00C1: "A\u0301",                  // LATIN CAPITAL LETTER A WITH ACUTE
+	0x00C2: "A\u0302",                  // LATIN CAPITAL LETTER A WITH CIRCUMFLEX
+	0x00C3: "A\u0303",                  // LATIN CAPITAL LETTER A WITH TILDE
+	0x00C4: "A\u0308",                  // LATIN CAPITAL LETTER A WITH DIAERESIS
+	0x00C5: "A\u030a",                  // LATIN CAPITAL LETTER A WITH RING ABOVE
+	0x00C7: "C\u0327",                  // LATIN CAPITAL LETTER C WITH CEDILLA
+	0x00C8: "E\u0300",                  // LATIN CAPITAL LETTER E WITH GRAVE
+	0x00C9: "E\u0301",                  // LATIN CAPITAL LETTER E WITH ACUTE
+	0x00CA: "E\u0302",                  // LATIN CAPITAL LETTER E WITH CIRCUMFLEX
+	0x00CB: "E\u0308",                  // LATIN CAPITAL LETTER E WITH DIAERESIS
+	0x00CC: "I\u0300",                  // LATIN CAPITAL LETTER I WITH GRAVE
+	0x00CD: "I\u0301",                  // LATIN CAPITAL LETTER I WITH ACUTE
+	0x00CE: "I\u0302",                  // LATIN CAPITAL LETTER I WITH CIRCUMFLEX
+	0x00CF: "I\u0308",                  // LATIN CAPITAL LETTER I WITH DIAERESIS
+	0x00D1: "N\u0303",                  // LATIN CAPITAL LETTER N WITH TILDE
+	0x00D2: "O\u0300",                  // LATIN CAPITAL LETTER O WITH GRAVE
+	0x00D3: "O\u0301",                  // LATIN CAPITAL LETTER O WITH ACUTE
+	0x00D4: "O\u0302",                  // LATIN CAPITAL LETTER O WITH CIRCUMFLEX
+	0x00D5: "O\u0303",                  // LATIN CAPITAL LETTER O WITH TILDE
+	0x00D6: "O\u0308",                  // LATIN CAPITAL LETTER O WITH DIAERESIS
+	0x00D9: "U\u0300",                  // LATIN CAPITAL LETTER U WITH GRAVE
+	0x00DA: "U\u0301",                  // LATIN CAPITAL LETTER U WITH ACUTE
+	0x00DB: "U\u0302",                  // LATIN CAPITAL LETTER U WITH CIRCUMFLEX
+	0x00DC: "U\u0308",                  // LATIN CAPITAL LETTER U WITH DIAERESIS
+	0x00DD: "Y\u0301",                  // LATIN CAPITAL LETTER Y WITH ACUTE
+	0x00E0: "a\u0300",                  // LATIN SMALL LETTER A WITH GRAVE
+	0x00E1: "a\u0301",                  // LATIN SMALL LETTER A WITH ACUTE
+	0x00E2: "a\u0302",                  // LATIN SMALL LETTER A WITH CIRCUMFLEX
+	0x00E3: "a\u0303",                  // LATIN SMALL LETTER A WITH TILDE
+	0x00E4: "a\u0308",                  // LATIN SMALL LETTER A WITH DIAERESIS
+	0x00E5: "a\u030a",                  // LATIN SMALL LETTER A WITH RING ABOVE
+	0x00E7: "c\u0327",                  // LATIN SMALL LETTER C WITH CEDILLA
+	0x00E8: "e\u0300",                  // LATIN SMALL LETTER E WITH GRAVE
+	0x00E9: "e\u0301",                  // LATIN SMALL LETTER E WITH ACUTE
+	0x00EA: "e\u0302",                  // LATIN SMALL LETTER E WITH CIRCUMFLEX
+	0x00EB: "e\u0308",                  // LATIN SMALL LETTER E WITH DIAERESIS
+	0x00EC: "i\u0300",                  // LATIN SMALL LETTER I WITH GRAVE
+	0x00ED: "i\u0301",                  // LATIN SMALL LETTER I WITH ACUTE
+	0x00EE: "i\u0302",                  // LATIN SMALL LETTER I WITH CIRCUMFLEX
+	0x00EF: "i\u0308",                  // LATIN SMALL LETTER I WITH DIAERESIS
+	0x00F1: "n\u0303",                  // LATIN SMALL LETTER N WITH TILDE
+	0x00F2: "o\u0300",                  // LATIN SMALL LETTER O WITH GRAVE
+	0x00F3: "o\u0301",                  // LATIN SMALL LETTER O WITH ACUTE
+	0x00F4: "o\u0302",                  // LATIN SMALL LETTER O WITH CIRCUMFLEX
+	0x00F5: "o\u0303",                  // LATIN SMALL LETTER O WITH TILDE
+	0x00F6: "o\u0308",                  // LATIN SMALL LETTER O WITH DIAERESIS
+	0x00F9: "u\u0300",                  // LATIN SMALL LETTER U WITH GRAVE
+	0x00FA: "u\u0301",                  // LATIN SMALL LETTER U WITH ACUTE
+	0x00FB: "u\u0302",                  // LATIN SMALL LETTER U WITH CIRCUMFLEX
+	0x00FC: "u\u0308",                  // LATIN SMALL LETTER U WITH DIAERESIS
+	0x00FD: "y\u0301",                  // LATIN SMALL LETTER Y WITH ACUTE
+	0x00FF: "y\u0308",                  // LATIN SMALL LETTER Y WITH DIAERESIS
+	0x0100: "A\u0304",                  // LATIN CAPITAL LETTER A WITH MACRON
+	0x0101: "a\u0304",                  // LATIN SMALL LETTER A WITH MACRON
+	0x0102: "A\u0306",                  // LATIN CAPITAL LETTER A WITH BREVE
+	0x0103: "a\u0306",                  // LATIN SMALL LETTER A WITH BREVE
+	0x0104: "A\u0328",                  // LATIN CAPITAL LETTER A WITH OGONEK
+	0x0105: "a\u0328",                  // LATIN SMALL LETTER A WITH OGONEK
+	0x0106: "C\u0301",                  // LATIN CAPITAL LETTER C WITH ACUTE
+	0x0107: "c\u0301",                  // LATIN SMALL LETTER C WITH ACUTE
+	0x0108: "C\u0302",                  // LATIN CAPITAL LETTER C WITH CIRCUMFLEX
+	0x0109: "c\u0302",                  // LATIN SMALL LETTER C WITH CIRCUMFLEX
+	0x010A: "C\u0307",                  // LATIN CAPITAL LETTER C WITH DOT ABOVE
+	0x010B: "c\u0307",                  // LATIN SMALL LETTER C WITH DOT ABOVE
+	0x010C: "C\u030c",                  // LATIN CAPITAL LETTER C WITH CARON
+	0x010D: "c\u030c",                  // LATIN SMALL LETTER C WITH CARON
+	0x010E: "D\u030c",                  // LATIN CAPITAL LETTER D WITH CARON
+	0x010F: "d\u030c",                  // LATIN SMALL LETTER D WITH CARON
+	0x0112: "E\u0304",                  // LATIN CAPITAL LETTER E WITH MACRON
+	0x0113: "e\u0304",                  // LATIN SMALL LETTER E WITH MACRON
+	0x0114: "E\u0306",                  // LATIN CAPITAL LETTER E WITH BREVE
+	0x0115: "e\u0306",                  // LATIN SMALL LETTER E WITH BREVE
+	0x0116: "E\u0307",                  // LATIN CAPITAL LETTER E WITH DOT ABOVE
+	0x0117: "e\u0307",                  // LATIN SMALL LETTER E WITH DOT ABOVE
+	0x0118: "E\u0328",                  // LATIN CAPITAL LETTER E WITH OGONEK
+	0x0119: "e\u0328",                  // LATIN SMALL LETTER E WITH OGONEK
+	0x011A: "E\u030c",                  // LATIN CAPITAL LETTER E WITH CARON
+	0x011B: "e\u030c",                  // LATIN SMALL LETTER E WITH CARON
+	0x011C: "G\u0302",                  // LATIN CAPITAL LETTER G WITH CIRCUMFLEX
+	0x011D: "g\u0302",                  // LATIN SMALL LETTER G WITH CIRCUMFLEX
+	0x011E: "G\u0306",                  // LATIN CAPITAL LETTER G WITH BREVE
+	0x011F: "g\u0306",                  // LATIN SMALL LETTER G WITH BREVE
+	0x0120: "G\u0307",                  // LATIN CAPITAL LETTER G WITH DOT ABOVE
+	0x0121: "g\u0307",                  // LATIN SMALL LETTER G WITH DOT ABOVE
+	0x0122: "G\u0327",                  // LATIN CAPITAL LETTER G WITH CEDILLA
+	0x0123: "g\u0327",                  // LATIN SMALL LETTER G WITH CEDILLA
+	0x0124: "H\u0302",                  // LATIN CAPITAL LETTER H WITH CIRCUMFLEX
+	0x0125: "h\u0302",                  // LATIN SMALL LETTER H WITH CIRCUMFLEX
+	0x0128: "I\u0303",                  // LATIN CAPITAL LETTER I WITH TILDE
+	0x0129: "i\u0303",                  // LATIN SMALL LETTER I WITH TILDE
+	0x012A: "I\u0304",                  // LATIN CAPITAL LETTER I WITH MACRON
+	0x012B: "i\u0304",                  // LATIN SMALL LETTER I WITH MACRON
+	0x012C: "I\u0306",                  // LATIN CAPITAL LETTER I WITH BREVE
+	0x012D: "i\u0306",                  // LATIN SMALL LETTER I WITH BREVE
+	0x012E: "I\u0328",                  // LATIN CAPITAL LETTER I WITH OGONEK
+	0x012F: "i\u0328",                  // LATIN SMALL LETTER I WITH OGONEK
+	0x0130: "I\u0307",                  // LATIN CAPITAL LETTER I WITH DOT ABOVE
+	0x0134: "J\u0302",                  // LATIN CAPITAL LETTER J WITH CIRCUMFLEX
+	0x0135: "j\u0302",                  // LATIN SMALL LETTER J WITH CIRCUMFLEX
+	0x0136: "K\u0327",                  // LATIN CAPITAL LETTER K WITH CEDILLA
+	0x0137: "k\u0327",                  // LATIN SMALL LETTER K WITH CEDILLA
+	0x0139: "L\u0301",                  // LATIN CAPITAL LETTER L WITH ACUTE
+	0x013A: "l\u0301",                  // LATIN SMALL LETTER L WITH ACUTE
+	0x013B: "L\u0327",                  // LATIN CAPITAL LETTER L WITH CEDILLA
+	0x013C: "l\u0327",                  // LATIN SMALL LETTER L WITH CEDILLA
+	0x013D: "L\u030c",                  // LATIN CAPITAL LETTER L WITH CARON
+	0x013E: "l\u030c",                  // LATIN SMALL LETTER L WITH CARON
+	0x0143: "N\u0301",                  // LATIN CAPITAL LETTER N WITH ACUTE
+	0x0144: "n\u0301",                  // LATIN SMALL LETTER N WITH ACUTE
+	0x0145: "N\u0327",                  // LATIN CAPITAL LETTER N WITH CEDILLA
+	0x0146: "n\u0327",                  // LATIN SMALL LETTER N WITH CEDILLA
+	0x0147: "N\u030c",                  // LATIN CAPITAL LETTER N WITH CARON
+	0x0148: "n\u030c",                  // LATIN SMALL LETTER N WITH CARON
+	0x014C: "O\u0304",                  // LATIN CAPITAL LETTER O WITH MACRON
+	0x014D: "o\u0304",                  // LATIN SMALL LETTER O WITH MACRON
+	0x014E: "O\u0306",                  // LATIN CAPITAL LETTER O WITH BREVE
+	0x014F: "o\u0306",                  // LATIN SMALL LETTER O WITH BREVE
+	0x0150: "O\u030b",                  // LATIN CAPITAL LETTER O WITH DOUBLE ACUTE
+	0x0151: "o\u030b",                  // LATIN SMALL LETTER O WITH DOUBLE ACUTE
+	0x0154: "R\u0301",                  // LATIN CAPITAL LETTER R WITH ACUTE
+	0x0155: "r\u0301",                  // LATIN SMALL LETTER R WITH ACUTE
+	0x0156: "R\u0327",                  // LATIN CAPITAL LETTER R WITH CEDILLA
+	0x0157: "r\u0327",                  // LATIN SMALL LETTER R WITH CEDILLA
+	0x0158: "R\u030c",                  // LATIN CAPITAL LETTER R WITH CARON
+	0x0159: "r\u030c",                  // LATIN SMALL LETTER R WITH CARON
+	0x015A: "S\u0301",                  // LATIN CAPITAL LETTER S WITH ACUTE
+	0x015B: "s\u0301",                  // LATIN SMALL LETTER S WITH ACUTE
+	0x015C: "S\u0302",                  // LATIN CAPITAL LETTER S WITH CIRCUMFLEX
+	0x015D: "s\u0302",                  // LATIN SMALL LETTER S WITH CIRCUMFLEX
+	0x015E: "S\u0327",                  // LATIN CAPITAL LETTER S WITH CEDILLA
+	0x015F: "s\u0327",                  // LATIN SMALL LETTER S WITH CEDILLA
+	0x0160: "S\u030c",                  // LATIN CAPITAL LETTER S WITH CARON
+	0x0161: "s\u030c",                  // LATIN SMALL LETTER S WITH CARON
+	0x0162: "T\u0327",                  // LATIN CAPITAL LETTER T WITH CEDILLA
+	0x0163: "t\u0327",                  // LATIN SMALL LETTER T WITH CEDILLA
+	0x0164: "T\u030c",                  // LATIN CAPITAL LETTER T WITH CARON
+	0x0165: "t\u030c",                  // LATIN SMALL LETTER T WITH CARON
+	0x0168: "U\u0303",                  // LATIN CAPITAL LETTER U WITH TILDE
+	0x0169: "u\u0303",                  // LATIN SMALL LETTER U WITH TILDE
+	0x016A: "U\u0304",                  // LATIN CAPITAL LETTER U WITH MACRON
+	0x016B: "u\u0304",                  // LATIN SMALL LETTER U WITH MACRON
+	0x016C: "U\u0306",                  // LATIN CAPITAL LETTER U WITH BREVE
+	0x016D: "u\u0306",                  // LATIN SMALL LETTER U WITH BREVE
+	0x016E: "U\u030a",                  // LATIN CAPITAL LETTER U WITH RING ABOVE
+	0x016F: "u\u030a",                  // LATIN SMALL LETTER U WITH RING ABOVE
+	0x0170: "U\u030b",                  // LATIN CAPITAL LETTER U WITH DOUBLE ACUTE
+	0x0171: "u\u030b",                  // LATIN SMALL LETTER U WITH DOUBLE ACUTE
+	0x0172: "U\u0328",                  // LATIN CAPITAL LETTER U WITH OGONEK
+	0x0173: "u\u0328",                  // LATIN SMALL LETTER U WITH OGONEK
+	0x0174: "W\u0302",                  // LATIN CAPITAL LETTER W WITH CIRCUMFLEX
+	0x0175: "w\u0302",                  // LATIN SMALL LETTER W WITH CIRCUMFLEX
+	0x0176: "Y\u0302",                  // LATIN CAPITAL LETTER Y WITH CIRCUMFLEX
+	0x0177: "y\u0302",                  // LATIN SMALL LETTER Y WITH CIRCUMFLEX
+	0x0178: "Y\u0308",                  // LATIN CAPITAL LETTER Y WITH DIAERESIS
+	0x0179: "Z\u0301",                  // LATIN CAPITAL LETTER Z WITH ACUTE
+	0x017A: "z\u0301",                  // LATIN SMALL LETTER Z WITH ACUTE
+	0x017B: "Z\u0307",                  // LATIN CAPITAL LETTER Z WITH DOT ABOVE
+	0x017C: "z\u0307",                  // LATIN SMALL LETTER Z WITH DOT ABOVE
+	0x017D: "Z\u030c",                  // LATIN CAPITAL LETTER Z WITH CARON
+	0x017E: "z\u030c",                  // LATIN SMALL LETTER Z WITH CARON
+	0x01CD: "A\u030c",                  // LATIN CAPITAL LETTER A WITH CARON
+	0x01CE: "a\u030c",                  // LATIN SMALL LETTER A WITH CARON
+	0x01CF: "I\u030c",                  // LATIN CAPITAL LETTER I WITH CARON
+	0x01D0: "i\u030c",                  // LATIN SMALL LETTER I WITH CARON
+	0x01D1: "O\u030c",                  // LATIN CAPITAL LETTER O WITH CARON
+	0x01D2: "o\u030c",                  // LATIN SMALL LETTER O WITH CARON
+	0x01D3: "U\u030c",                  // LATIN CAPITAL LETTER U WITH CARON
+	0x01D4: "u\u030c",                  // LATIN SMALL LETTER U WITH CARON
+	0x01D5: "U\u0308\u0304",            // LATIN CAPITAL LETTER U WITH DIAERESIS AND MACRON
+	0x01D6: "u\u0308\u0304",            // LATIN SMALL LETTER U WITH DIAERESIS AND MACRON
+	0x01D7: "U\u0308\u0301",            // LATIN CAPITAL LETTER U WITH DIAERESIS AND ACUTE
+	0x01D8: "u\u0308\u0301",            // LATIN SMALL LETTER U WITH DIAERESIS AND ACUTE
+	0x01D9: "U\u0308\u030c",            // LATIN CAPITAL LETTER U WITH DIAERESIS AND CARON
+	0x01DA: "u\u0308\u030c",            // LATIN SMALL LETTER U WITH DIAERESIS AND CARON
+	0x01DB: "U\u0308\u0300",            // LATIN CAPITAL LETTER U WITH DIAERESIS AND GRAVE
+	0x01DC: "u\u0308\u0300",            // LATIN SMALL LETTER U WITH DIAERESIS AND GRAVE
+	0x01DE: "A\u0308\u0304",            // LATIN CAPITAL LETTER A WITH DIAERESIS AND MACRON
+	0x01DF: "a\u0308\u0304",            // LATIN SMALL LETTER A WITH DIAERESIS AND MACRON
+	0x01E0: "A\u0307\u0304",            // LATIN CAPITAL LETTER A WITH DOT ABOVE AND MACRON
+	0x01E1: "a\u0307\u0304",            // LATIN SMALL LETTER A WITH DOT ABOVE AND MACRON
+	0x01E2: "\u00c6\u0304",             // LATIN CAPITAL LETTER AE WITH MACRON
+	0x01E3: "\u00e6\u0304",             // LATIN SMALL LETTER AE WITH MACRON
+	0x01E6: "G\u030c",                  // LATIN CAPITAL LETTER G WITH CARON
+	0x01E7: "g\u030c",                  // LATIN SMALL LETTER G WITH CARON
+	0x01E8: "K\u030c",                  // LATIN CAPITAL LETTER K WITH CARON
+	0x01E9: "k\u030c",                  // LATIN SMALL LETTER K WITH CARON
+	0x01EA: "O\u0328",                  // LATIN CAPITAL LETTER O WITH OGONEK
+	0x01EB: "o\u0328",                  // LATIN SMALL LETTER O WITH OGONEK
+	0x01EC: "O\u0328\u0304",            // LATIN CAPITAL LETTER O WITH OGONEK AND MACRON
+	0x01ED: "o\u0328\u0304",            // LATIN SMALL LETTER O WITH OGONEK AND MACRON
+	0x01F0: "j\u030c",                  // LATIN SMALL LETTER J WITH CARON
+	0x01F4: "G\u0301",                  // LATIN CAPITAL LETTER G WITH ACUTE
+	0x01F5: "g\u0301",                  // LATIN SMALL LETTER G WITH ACUTE
+	0x01F8: "N\u0300",                  // LATIN CAPITAL LETTER N WITH GRAVE
+	0x01F9: "n\u0300",                  // LATIN SMALL LETTER N WITH GRAVE
+	0x01FA: "A\u030a\u0301",            // LATIN CAPITAL LETTER A WITH RING ABOVE AND ACUTE
+	0x01FB: "a\u030a\u0301",            // LATIN SMALL LETTER A WITH RING ABOVE AND ACUTE
+	0x01FC: "\u00c6\u0301",             // LATIN CAPITAL LETTER AE WITH ACUTE
+	0x01FD: "\u00e6\u0301",             // LATIN SMALL LETTER AE WITH ACUTE
+	0x01FE: "\u00d8\u0301",             // LATIN CAPITAL LETTER O WITH STROKE AND ACUTE
+	0x01FF: "\u00f8\u0301",             // LATIN SMALL LETTER O WITH STROKE AND ACUTE
+	0x0218: "S\u0326",                  // LATIN CAPITAL LETTER S WITH COMMA BELOW
+	0x0219: "s\u0326",                  // LATIN SMALL LETTER S WITH COMMA BELOW
+	0x021A: "T\u0326",                  // LATIN CAPITAL LETTER T WITH COMMA BELOW
+	0x021B: "t\u0326",                  // LATIN SMALL LETTER T WITH COMMA BELOW
+	0x021E: "H\u030c",                  // LATIN CAPITAL LETTER H WITH CARON
+	0x021F: "h\u030c",                  // LATIN SMALL LETTER H WITH CARON
+	0x0226: "A\u0307",                  // LATIN CAPITAL LETTER A WITH DOT ABOVE
+	0x0227: "a\u0307",                  // LATIN SMALL LETTER A WITH DOT ABOVE
+	0x0228: "E\u0327",                  // LATIN CAPITAL LETTER E WITH CEDILLA
+	0x0229: "e\u0327",                  // LATIN SMALL LETTER E WITH CEDILLA
+	0x022A: "O\u0308\u0304",            // LATIN CAPITAL LETTER O WITH DIAERESIS AND MACRON
+	0x022B: "o\u0308\u0304",            // LATIN SMALL LETTER O WITH DIAERESIS AND MACRON
+	0x022C: "O\u0303\u0304",            // LATIN CAPITAL LETTER O WITH TILDE AND MACRON
+	0x022D: "o\u0303\u0304",            // LATIN SMALL LETTER O WITH TILDE AND MACRON
+	0x022E: "O\u0307",                  // LATIN CAPITAL LETTER O WITH DOT ABOVE
+	0x022F: "o\u0307",                  // LATIN SMALL LETTER O WITH DOT ABOVE
+	0x0230: "O\u0307\u0304",            // LATIN CAPITAL LETTER O WITH DOT ABOVE AND MACRON
+	0x0231: "o\u0307\u0304",            // LATIN SMALL LETTER O WITH DOT ABOVE AND MACRON
+	0x0232: "Y\u0304",                  // LATIN CAPITAL LETTER Y WITH MACRON
+	0x0233: "y\u0304",                  // LATIN SMALL LETTER Y WITH MACRON
+	0x1E00: "A\u0325",                  // LATIN CAPITAL LETTER A WITH RING BELOW
+	0x1E01: "a\u0325",                  // LATIN SMALL LETTER A WITH RING BELOW
+	0x1E02: "B\u0307",                  // LATIN CAPITAL LETTER B WITH DOT ABOVE
+	0x1E03: "b\u0307",                  // LATIN SMALL LETTER B WITH DOT ABOVE
+	0x1E04: "B\u0323",                  // LATIN CAPITAL LETTER B WITH DOT BELOW
+	0x1E05: "b\u0323",                  // LATIN SMALL LETTER B WITH DOT BELOW
+	0x1E08: "C\u0327\u0301",            // LATIN CAPITAL LETTER C WITH CEDILLA AND ACUTE
+	0x1E09: "c\u0327\u0301",            // LATIN SMALL LETTER C WITH CEDILLA AND ACUTE
+	0x1E0A: "D\u0307",                  // LATIN CAPITAL LETTER D WITH DOT ABOVE
+	0x1E0B: "d\u0307",                  // LATIN SMALL LETTER D WITH DOT ABOVE
+	0x1E0C: "D\u0323",                  // LATIN CAPITAL LETTER D WITH DOT BELOW
+	0x1E0D: "d\u0323",                  // LATIN SMALL LETTER D WITH DOT BELOW
+	0x1E10: "D\u0327",                  // LATIN CAPITAL LETTER D WITH CEDILLA
+	0x1E11: "d\u0327",                  // LATIN SMALL LETTER D WITH CEDILLA
+	0x1E14: "E\u0304\u0300",            // LATIN CAPITAL LETTER E WITH MACRON AND GRAVE
+	0x1E15: "e\u0304\u0300",            // LATIN SMALL LETTER E WITH MACRON AND GRAVE
+	0x1E16: "E\u0304\u0301",            // LATIN CAPITAL LETTER E WITH MACRON AND ACUTE
+	0x1E17: "e\u0304\u0301",            // LATIN SMALL LETTER E WITH MACRON AND ACUTE
+	0x1E1C: "E\u0327\u0306",            // LATIN CAPITAL LETTER E WITH CEDILLA AND BREVE
+	0x1E1D: "e\u0327\u0306",            // LATIN SMALL LETTER E WITH CEDILLA AND BREVE
+	0x1E1E: "F\u0307",                  // LATIN CAPITAL LETTER F WITH DOT ABOVE
+	0x1E1F: "f\u0307",                  // LATIN SMALL LETTER F WITH DOT ABOVE
+	0x1E20: "G\u0304",                  // LATIN CAPITAL LETTER G WITH MACRON
+	0x1E21: "g\u0304",                  // LATIN SMALL LETTER G WITH MACRON
+	0x1E22: "H\u0307",                  // LATIN CAPITAL LETTER H WITH DOT ABOVE
+	0x1E23: "h\u0307",                  // LATIN SMALL LETTER H WITH DOT ABOVE
+	0x1E24: "H\u0323",                  // LATIN CAPITAL LETTER H WITH DOT BELOW
+	0x1E25: "h\u0323",                  // LATIN SMALL LETTER H WITH DOT BELOW
+	0x1E26: "H\u0308",                  // LATIN CAPITAL LETTER H WITH DIAERESIS
+	0x1E27: "h\u0308",                  // LATIN SMALL LETTER H WITH DIAERESIS
+	0x1E28: "H\u0327",                  // LATIN CAPITAL LETTER H WITH CEDILLA
+	0x1E29: "h\u0327",                  // LATIN SMALL LETTER H WITH CEDILLA
+	0x1E2A: "H\u032e",                  // LATIN CAPITAL LETTER H WITH BREVE BELOW
+	0x1E2B: "h\u032e",                  // LATIN SMALL LETTER H WITH BREVE BELOW
+	0x1E2E: "I\u0308\u0301",            // LATIN CAPITAL LETTER I WITH DIAERESIS AND ACUTE
+	0x1E2F: "i\u0308\u0301",            // LATIN SMALL LETTER I WITH DIAERESIS AND ACUTE
+	0x1E30: "K\u0301",                  // LATIN CAPITAL LETTER K WITH ACUTE
+	0x1E31: "k\u0301",                  // LATIN SMALL LETTER K WITH ACUTE
+	0x1E32: "K\u0323",                  // LATIN CAPITAL LETTER K WITH DOT BELOW
+	0x1E33: "k\u0323",                  // LATIN SMALL LETTER K WITH DOT BELOW
+	0x1E36: "L\u0323",                  // LATIN CAPITAL LETTER L WITH DOT BELOW
+	0x1E37: "l\u0323",                  // LATIN SMALL LETTER L WITH DOT BELOW
+	0x1E38: "L\u0323\u0304",            // LATIN CAPITAL LETTER L WITH DOT BELOW AND MACRON
+	0x1E39: "l\u0323\u0304",            // LATIN SMALL LETTER L WITH DOT BELOW AND MACRON
+	0x1E3E: "M\u0301",                  // LATIN CAPITAL LETTER M WITH ACUTE
+	0x1E3F: "m\u0301",                  // LATIN SMALL LETTER M WITH ACUTE
+	0x1E40: "M\u0307",                  // LATIN CAPITAL LETTER M WITH DOT ABOVE
+	0x1E41: "m\u0307",                  // LATIN SMALL LETTER M WITH DOT ABOVE
+	0x1E42: "M\u0323",                  // LATIN CAPITAL LETTER M WITH DOT BELOW
+	0x1E43: "m\u0323",                  // LATIN SMALL LETTER M WITH DOT BELOW
+	0x1E44: "N\u0307",                  // LATIN CAPITAL LETTER N WITH DOT ABOVE
+	0x1E45: "n\u0307",                  // LATIN SMALL LETTER N WITH DOT ABOVE
+	0x1E46: "N\u0323",                  // LATIN CAPITAL LETTER N WITH DOT BELOW
+	0x1E47: "n\u0323",                  // LATIN SMALL LETTER N WITH DOT BELOW
+	0x1E4C: "O\u0303\u0301",            // LATIN CAPITAL LETTER O WITH TILDE AND ACUTE
+	0x1E4D: "o\u0303\u0301",            // LATIN SMALL LETTER O WITH TILDE AND ACUTE
+	0x1E4E: "O\u0303\u0308",            // LATIN CAPITAL LETTER O WITH TILDE AND DIAERESIS
+	0x1E4F: "o\u0303\u0308",            // LATIN SMALL LETTER O WITH TILDE AND DIAERESIS
+	0x1E50: "O\u0304\u0300",            // LATIN CAPITAL LETTER O WITH MACRON AND GRAVE
+	0x1E51: "o\u0304\u0300",            // LATIN SMALL LETTER O WITH MACRON AND GRAVE
+	0x1E52: "O\u0304\u0301",            // LATIN CAPITAL LETTER O WITH MACRON AND ACUTE
+	0x1E53: "o\u0304\u0301",            // LATIN SMALL LETTER O WITH MACRON AND ACUTE
+	0x1E54: "P\u0301",                  // LATIN CAPITAL LETTER P WITH ACUTE
+	0x1E55: "p\u0301",                  // LATIN SMALL LETTER P WITH ACUTE
+	0x1E56: "P\u0307",                  // LATIN CAPITAL LETTER P WITH DOT ABOVE
+	0x1E57: "p\u0307",                  // LATIN SMALL LETTER P WITH DOT ABOVE
+	0x1E58: "R\u0307",                  // LATIN CAPITAL LETTER R WITH DOT ABOVE
+	0x1E59: "r\u0307",                  // LATIN SMALL LETTER R WITH DOT ABOVE
+	0x1E5A: "R\u0323",                  // LATIN CAPITAL LETTER R WITH DOT BELOW
+	0x1E5B: "r\u0323",                  // LATIN SMALL LETTER R WITH DOT BELOW
+	0x1E5C: "R\u0323\u0304",            // LATIN CAPITAL LETTER R WITH DOT BELOW AND MACRON
+	0x1E5D: "r\u0323\u0304",            // LATIN SMALL LETTER R WITH DOT BELOW AND MACRON
+	0x1E60: "S\u0307",                  // LATIN CAPITAL LETTER S WITH DOT ABOVE
+	0x1E61: "s\u0307",                  // LATIN SMALL LETTER S WITH DOT ABOVE
+	0x1E62: "S\u0323",                  // LATIN CAPITAL LETTER S WITH DOT BELOW
+	0x1E63: "s\u0323",                  // LATIN SMALL LETTER S WITH DOT BELOW
+	0x1E64: "S\u0301\u0307",            // LATIN CAPITAL LETTER S WITH ACUTE AND DOT ABOVE
+	0x1E65: "s\u0301\u0307",            // LATIN SMALL LETTER S WITH ACUTE AND DOT ABOVE
+	0x1E66: "S\u030c\u0307",            // LATIN CAPITAL LETTER S WITH CARON AND DOT ABOVE
+	0x1E67: "s\u030c\u0307",            // LATIN SMALL LETTER S WITH CARON AND DOT ABOVE
+	0x1E68: "S\u0323\u0307",            // LATIN CAPITAL LETTER S WITH DOT BELOW AND DOT ABOVE
+	0x1E69: "s\u0323\u0307",            // LATIN SMALL LETTER S WITH DOT BELOW AND DOT ABOVE
+	0x1E6A: "T\u0307",                  // LATIN CAPITAL LETTER T WITH DOT ABOVE
+	0x1E6B: "t\u0307",                  // LATIN SMALL LETTER T WITH DOT ABOVE
+	0x1E6C: "T\u0323",                  // LATIN CAPITAL LETTER T WITH DOT BELOW
+	0x1E6D: "t\u0323",                  // LATIN SMALL LETTER T WITH DOT BELOW
+	0x1E72: "U\u0324",                  // LATIN CAPITAL LETTER U WITH DIAERESIS BELOW
+	0x1E73: "u\u0324",                  // LATIN SMALL LETTER U WITH DIAERESIS BELOW
+	0x1E78: "U\u0303\u0301",            // LATIN CAPITAL LETTER U WITH TILDE AND ACUTE
+	0x1E79: "u\u0303\u0301",            // LATIN SMALL LETTER U WITH TILDE AND ACUTE
+	0x1E7A: "U\u0304\u0308",            // LATIN CAPITAL LETTER U WITH MACRON AND DIAERESIS
+	0x1E7B: "u\u0304\u0308",            // LATIN SMALL LETTER U WITH MACRON AND DIAERESIS
+	0x1E7C: "V\u0303",                  // LATIN CAPITAL LETTER V WITH TILDE
+	0x1E7D: "v\u0303",                  // LATIN SMALL LETTER V WITH TILDE
+	0x1E7E: "V\u0323",                  // LATIN CAPITAL LETTER V WITH DOT BELOW
+	0x1E7F: "v\u0323",                  // LATIN SMALL LETTER V WITH DOT BELOW
+	0x1E80: "W\u0300",                  // LATIN CAPITAL LETTER W WITH GRAVE
+	0x1E81: "w\u0300",                  // LATIN SMALL LETTER W WITH GRAVE
+	0x1E82: "W\u0301",                  // LATIN CAPITAL LETTER W WITH ACUTE
+	0x1E83: "w\u0301",                  // LATIN SMALL LETTER W WITH ACUTE
+	0x1E84: "W\u0308",                  // LATIN CAPITAL LETTER W WITH DIAERESIS
+	0x1E85: "w\u0308",                  // LATIN SMALL LETTER W WITH DIAERESIS
+	0x1E86: "W\u0307",                  // LATIN CAPITAL LETTER W WITH DOT ABOVE
+	0x1E87: "w\u0307",                  // LATIN SMALL LETTER W WITH DOT ABOVE
+	0x1E88: "W\u0323",                  // LATIN CAPITAL LETTER W WITH DOT BELOW
+	0x1E89: "w\u0323",                  // LATIN SMALL LETTER W WITH DOT BELOW
+	0x1E8A: "X\u0307",                  // LATIN CAPITAL LETTER X WITH DOT ABOVE
+	0x1E8B: "x\u0307",                  // LATIN SMALL LETTER X WITH DOT ABOVE
+	0x1E8C: "X\u0308",                  // LATIN CAPITAL LETTER X WITH DIAERESIS
+	0x1E8D: "x\u0308",                  // LATIN SMALL LETTER X WITH DIAERESIS
+	0x1E8E: "Y\u0307",                  // LATIN CAPITAL LETTER Y WITH DOT ABOVE
+	0x1E8F: "y\u0307",                  // LATIN SMALL LETTER Y WITH DOT ABOVE
+	0x1E90: "Z\u0302",                  // LATIN CAPITAL LETTER Z WITH CIRCUMFLEX
+	0x1E91: "z\u0302",                  // LATIN SMALL LETTER Z WITH CIRCUMFLEX
+	0x1E92: "Z\u0323",                  // LATIN CAPITAL LETTER Z WITH DOT BELOW
+	0x1E93: "z\u0323",                  // LATIN SMALL LETTER Z WITH DOT BELOW
+	0x1E97: "t\u0308",                  // LATIN SMALL LETTER T WITH DIAERESIS
+	0x1E98: "w\u030a",                  // LATIN SMALL LETTER W WITH RING ABOVE
+	0x1E99: "y\u030a",                  // LATIN SMALL LETTER Y WITH RING ABOVE
+	0x1EA0: "A\u0323",                  // LATIN CAPITAL LETTER A WITH DOT BELOW
+	0x1EA1: "a\u0323",                  // LATIN SMALL LETTER A WITH DOT BELOW
+	0x1EA2: "A\u0309",                  // LATIN CAPITAL LETTER A WITH HOOK ABOVE
+	0x1EA3: "a\u0309",                  // LATIN SMALL LETTER A WITH HOOK ABOVE
+	0x1EA4: "A\u0302\u0301",            // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND ACUTE
+	0x1EA5: "a\u0302\u0301",            // LATIN SMALL LETTER A WITH CIRCUMFLEX AND ACUTE
+	0x1EA6: "A\u0302\u0300",            // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND GRAVE
+	0x1EA7: "a\u0302\u0300",            // LATIN SMALL LETTER A WITH CIRCUMFLEX AND GRAVE
+	0x1EA8: "A\u0302\u0309",            // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EA9: "a\u0302\u0309",            // LATIN SMALL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EAA: "A\u0302\u0303",            // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND TILDE
+	0x1EAB: "a\u0302\u0303",            // LATIN SMALL LETTER A WITH CIRCUMFLEX AND TILDE
+	0x1EAC: "A\u0323\u0302",            // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+	0x1EAD: "a\u0323\u0302",            // LATIN SMALL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+	0x1EAE: "A\u0306\u0301",            // LATIN CAPITAL LETTER A WITH BREVE AND ACUTE
+	0x1EAF: "a\u0306\u0301",            // LATIN SMALL LETTER A WITH BREVE AND ACUTE
+	0x1EB0: "A\u0306\u0300",            // LATIN CAPITAL LETTER A WITH BREVE AND GRAVE
+	0x1EB1: "a\u0306\u0300",            // LATIN SMALL LETTER A WITH BREVE AND GRAVE
+	0x1EB2: "A\u0306\u0309",            // LATIN CAPITAL LETTER A WITH BREVE AND HOOK ABOVE
+	0x1EB3: "a\u0306\u0309",            // LATIN SMALL LETTER A WITH BREVE AND HOOK ABOVE
+	0x1EB4: "A\u0306\u0303",            // LATIN CAPITAL LETTER A WITH BREVE AND TILDE
+	0x1EB5: "a\u0306\u0303",            // LATIN SMALL LETTER A WITH BREVE AND TILDE
+	0x1EB6: "A\u0323\u0306",            // LATIN CAPITAL LETTER A WITH BREVE AND DOT BELOW
+	0x1EB7: "a\u0323\u0306",            // LATIN SMALL LETTER A WITH BREVE AND DOT BELOW
+	0x1EB8: "E\u0323",                  // LATIN CAPITAL LETTER E WITH DOT BELOW
+	0x1EB9: "e\u0323",                  // LATIN SMALL LETTER E WITH DOT BELOW
+	0x1EBA: "E\u0309",                  // LATIN CAPITAL LETTER E WITH HOOK ABOVE
+	0x1EBB: "e\u0309",                  // LATIN SMALL LETTER E WITH HOOK ABOVE
+	0x1EBC: "E\u0303",                  // LATIN CAPITAL LETTER E WITH TILDE
+	0x1EBD: "e\u0303",                  // LATIN SMALL LETTER E WITH TILDE
+	0x1EBE: "E\u0302\u0301",            // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND ACUTE
+	0x1EBF: "e\u0302\u0301",            // LATIN SMALL LETTER E WITH CIRCUMFLEX AND ACUTE
+	0x1EC0: "E\u0302\u0300",            // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND GRAVE
+	0x1EC1: "e\u0302\u0300",            // LATIN SMALL LETTER E WITH CIRCUMFLEX AND GRAVE
+	0x1EC2: "E\u0302\u0309",            // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EC3: "e\u0302\u0309",            // LATIN SMALL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EC4: "E\u0302\u0303",            // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND TILDE
+	0x1EC5: "e\u0302\u0303",            // LATIN SMALL LETTER E WITH CIRCUMFLEX AND TILDE
+	0x1EC6: "E\u0323\u0302",            // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+	0x1EC7: "e\u0323\u0302",            // LATIN SMALL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+	0x1EC8: "I\u0309",                  // LATIN CAPITAL LETTER I WITH HOOK ABOVE
+	0x1EC9: "i\u0309",                  // LATIN SMALL LETTER I WITH HOOK ABOVE
+	0x1ECA: "I\u0323",                  // LATIN CAPITAL LETTER I WITH DOT BELOW
+	0x1ECB: "i\u0323",                  // LATIN SMALL LETTER I WITH DOT BELOW
+	0x1ECC: "O\u0323",                  // LATIN CAPITAL LETTER O WITH DOT BELOW
+	0x1ECD: "o\u0323",                  // LATIN SMALL LETTER O WITH DOT BELOW
+	0x1ECE: "O\u0309",                  // LATIN CAPITAL LETTER O WITH HOOK ABOVE
+	0x1ECF: "o\u0309",                  // LATIN SMALL LETTER O WITH HOOK ABOVE
+	0x1ED0: "O\u0302\u0301",            // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND ACUTE
+	0x1ED1: "o\u0302\u0301",            // LATIN SMALL LETTER O WITH CIRCUMFLEX AND ACUTE
+	0x1ED2: "O\u0302\u0300",            // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND GRAVE
+	0x1ED3: "o\u0302\u0300",            // LATIN SMALL LETTER O WITH CIRCUMFLEX AND GRAVE
+	0x1ED4: "O\u0302\u0309",            // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1ED5: "o\u0302\u0309",            // LATIN SMALL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1ED6: "O\u0302\u0303",            // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND TILDE
+	0x1ED7: "o\u0302\u0303",            // LATIN SMALL LETTER O WITH CIRCUMFLEX AND TILDE
+	0x1ED8: "O\u0323\u0302",            // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+	0x1ED9: "o\u0323\u0302",            // LATIN SMALL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+	0x1EE4: "U\u0323",                  // LATIN CAPITAL LETTER U WITH DOT BELOW
+	0x1EE5: "u\u0323",                  // LATIN SMALL LETTER U WITH DOT BELOW
+	0x1EE6: "U\u0309",                  // LATIN CAPITAL LETTER U WITH HOOK ABOVE
+	0x1EE7: "u\u0309",                  // LATIN SMALL LETTER U WITH HOOK ABOVE
+	0x1EF2: "Y\u0300",                  // LATIN CAPITAL LETTER Y WITH GRAVE
+	0x1EF3: "y\u0300",                  // LATIN SMALL LETTER Y WITH GRAVE
+	0x1EF4: "Y\u0323",                  // LATIN CAPITAL LETTER Y WITH DOT BELOW
+	0x1EF5: "y\u0323",                  // LATIN SMALL LETTER Y WITH DOT BELOW
+	0x1EF6: "Y\u0309",                  // LATIN CAPITAL LETTER Y WITH HOOK ABOVE
+	0x1EF7: "y\u0309",                  // LATIN SMALL LETTER Y WITH HOOK ABOVE
+	0x1EF8: "Y\u0303",                  // LATIN CAPITAL LETTER Y WITH TILDE
+	0x1EF9: "y\u0303",                  // LATIN SMALL LETTER Y WITH TILDE
+	0x0374: "\u02b9",                   // GREEK NUMERAL SIGN
+	0x037E: ";",                        // GREEK QUESTION MARK
+	0x0386: "\u0391\u0301",             // GREEK CAPITAL LETTER ALPHA WITH TONOS
+	0x0387: "\u00b7",                   // GREEK ANO TELEIA
+	0x0388: "\u0395\u0301",             // GREEK CAPITAL LETTER EPSILON WITH TONOS
+	0x0389: "\u0397\u0301",             // GREEK CAPITAL LETTER ETA WITH TONOS
+	0x038A: "\u0399\u0301",             // GREEK CAPITAL LETTER IOTA WITH TONOS
+	0x038C: "\u039f\u0301",             // GREEK CAPITAL LETTER OMICRON WITH TONOS
+	0x038E: "\u03a5\u0301",             // GREEK CAPITAL LETTER UPSILON WITH TONOS
+	0x038F: "\u03a9\u0301",             // GREEK CAPITAL LETTER OMEGA WITH TONOS
+	0x0390: "\u03b9\u0308\u0301",       // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+	0x03AA: "\u0399\u0308",             // GREEK CAPITAL LETTER IOTA WITH DIALYTIKA
+	0x03AB: "\u03a5\u0308",             // GREEK CAPITAL LETTER UPSILON WITH DIALYTIKA
+	0x03AC: "\u03b1\u0301",             // GREEK SMALL LETTER ALPHA WITH TONOS
+	0x03AD: "\u03b5\u0301",             // GREEK SMALL LETTER EPSILON WITH TONOS
+	0x03AE: "\u03b7\u0301",             // GREEK SMALL LETTER ETA WITH TONOS
+	0x03AF: "\u03b9\u0301",             // GREEK SMALL LETTER IOTA WITH TONOS
+	0x03B0: "\u03c5\u0308\u0301",       // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS
+	0x03CA: "\u03b9\u0308",             // GREEK SMALL LETTER IOTA WITH DIALYTIKA
+	0x03CB: "\u03c5\u0308",             // GREEK SMALL LETTER UPSILON WITH DIALYTIKA
+	0x03CC: "\u03bf\u0301",             // GREEK SMALL LETTER OMICRON WITH TONOS
+	0x03CD: "\u03c5\u0301",             // GREEK SMALL LETTER UPSILON WITH TONOS
+	0x03CE: "\u03c9\u0301",             // GREEK SMALL LETTER OMEGA WITH TONOS
+	0x0400: "\u0415\u0300",             // CYRILLIC CAPITAL LETTER IE WITH GRAVE
+	0x0401: "\u0415\u0308",             // CYRILLIC CAPITAL LETTER IO
+	0x0403: "\u0413\u0301",             // CYRILLIC CAPITAL LETTER GJE
+	0x0407: "\u0406\u0308",             // CYRILLIC CAPITAL LETTER YI
+	0x040C: "\u041a\u0301",             // CYRILLIC CAPITAL LETTER KJE
+	0x040D: "\u0418\u0300",             // CYRILLIC CAPITAL LETTER I WITH GRAVE
+	0x040E: "\u0423\u0306",             // CYRILLIC CAPITAL LETTER SHORT U
+	0x0419: "\u0418\u0306",             // CYRILLIC CAPITAL LETTER SHORT I
+	0x0439: "\u0438\u0306",             // CYRILLIC SMALL LETTER SHORT I
+	0x0450: "\u0435\u0300",             // CYRILLIC SMALL LETTER IE WITH GRAVE
+	0x0451: "\u0435\u0308",             // CYRILLIC SMALL LETTER IO
+	0x0453: "\u0433\u0301",             // CYRILLIC SMALL LETTER GJE
+	0x0457: "\u0456\u0308",             // CYRILLIC SMALL LETTER YI
+	0x045C: "\u043a\u0301",             // CYRILLIC SMALL LETTER KJE
+	0x045D: "\u0438\u0300",             // CYRILLIC SMALL LETTER I WITH GRAVE
+	0x045E: "\u0443\u0306",             // CYRILLIC SMALL LETTER SHORT U
+	0x04C1: "\u0416\u0306",             // CYRILLIC CAPITAL LETTER ZHE WITH BREVE
+	0x04C2: "\u0436\u0306",             // CYRILLIC SMALL LETTER ZHE WITH BREVE
+	0x04D0: "\u0410\u0306",             // CYRILLIC CAPITAL LETTER A WITH BREVE
+	0x04D1: "\u0430\u0306",             // CYRILLIC SMALL LETTER A WITH BREVE
+	0x04D2: "\u0410\u0308",             // CYRILLIC CAPITAL LETTER A WITH DIAERESIS
+	0x04D3: "\u0430\u0308",             // CYRILLIC SMALL LETTER A WITH DIAERESIS
+	0x04D6: "\u0415\u0306",             // CYRILLIC CAPITAL LETTER IE WITH BREVE
+	0x04D7: "\u0435\u0306",             // CYRILLIC SMALL LETTER IE WITH BREVE
+	0x04DC: "\u0416\u0308",             // CYRILLIC CAPITAL LETTER ZHE WITH DIAERESIS
+	0x04DD: "\u0436\u0308",             // CYRILLIC SMALL LETTER ZHE WITH DIAERESIS
+	0x04DE: "\u0417\u0308",             // CYRILLIC CAPITAL LETTER ZE WITH DIAERESIS
+	0x04DF: "\u0437\u0308",             // CYRILLIC SMALL LETTER ZE WITH DIAERESIS
+	0x04E2: "\u0418\u0304",             // CYRILLIC CAPITAL LETTER I WITH MACRON
+	0x04E3: "\u0438\u0304",             // CYRILLIC SMALL LETTER I WITH MACRON
+	0x04E4: "\u0418\u0308",             // CYRILLIC CAPITAL LETTER I WITH DIAERESIS
+	0x04E5: "\u0438\u0308",             // CYRILLIC SMALL LETTER I WITH DIAERESIS
+	0x04E6: "\u041e\u0308",             // CYRILLIC CAPITAL LETTER O WITH DIAERESIS
+	0x04E7: "\u043e\u0308",             // CYRILLIC SMALL LETTER O WITH DIAERESIS
+	0x04EC: "\u042d\u0308",             // CYRILLIC CAPITAL LETTER E WITH DIAERESIS
+	0x04ED: "\u044d\u0308",             // CYRILLIC SMALL LETTER E WITH DIAERESIS
+	0x04EE: "\u0423\u0304",             // CYRILLIC CAPITAL LETTER U WITH MACRON
+	0x04EF: "\u0443\u0304",             // CYRILLIC SMALL LETTER U WITH MACRON
+	0x04F0: "\u0423\u0308",             // CYRILLIC CAPITAL LETTER U WITH DIAERESIS
+	0x04F1: "\u0443\u0308",             // CYRILLIC SMALL LETTER U WITH DIAERESIS
+	0x04F2: "\u0423\u030b",             // CYRILLIC CAPITAL LETTER U WITH DOUBLE ACUTE
+	0x04F3: "\u0443\u030b",             // CYRILLIC SMALL LETTER U WITH DOUBLE ACUTE
+	0x04F4: "\u0427\u0308",             // CYRILLIC CAPITAL LETTER CHE WITH DIAERESIS
+	0x04F5: "\u0447\u0308",             // CYRILLIC SMALL LETTER CHE WITH DIAERESIS
+	0x04F8: "\u042b\u0308",             // CYRILLIC CAPITAL LETTER YERU WITH DIAERESIS
+	0x04F9: "\u044b\u0308",             // CYRILLIC SMALL LETTER YERU WITH DIAERESIS
+	0x1F00: "\u03b1\u0313",             // GREEK SMALL LETTER ALPHA WITH PSILI
+	0x1F01: "\u03b1\u0314",             // GREEK SMALL LETTER ALPHA WITH DASIA
+	0x1F02: "\u03b1\u0313\u0300",       // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA
+	0x1F03: "\u03b1\u0314\u0300",       // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA
+	0x1F04: "\u03b1\u0313\u0301",       // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA
+	0x1F05: "\u03b1\u0314\u0301",       // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA
+	0x1F06: "\u03b1\u0313\u0342",       // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI
+	0x1F07: "\u03b1\u0314\u0342",       // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI
+	0x1F08: "\u0391\u0313",             // GREEK CAPITAL LETTER ALPHA WITH PSILI
+	0x1F09: "\u0391\u0314",             // GREEK CAPITAL LETTER ALPHA WITH DASIA
+	0x1F0A: "\u0391\u0313\u0300",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA
+	0x1F0B: "\u0391\u0314\u0300",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA
+	0x1F0C: "\u0391\u0313\u0301",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA
+	0x1F0D: "\u0391\u0314\u0301",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA
+	0x1F0E: "\u0391\u0313\u0342",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI
+	0x1F0F: "\u0391\u0314\u0342",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI
+	0x1F10: "\u03b5\u0313",             // GREEK SMALL LETTER EPSILON WITH PSILI
+	0x1F11: "\u03b5\u0314",             // GREEK SMALL LETTER EPSILON WITH DASIA
+	0x1F12: "\u03b5\u0313\u0300",       // GREEK SMALL LETTER EPSILON WITH PSILI AND VARIA
+	0x1F13: "\u03b5\u0314\u0300",       // GREEK SMALL LETTER EPSILON WITH DASIA AND VARIA
+	0x1F14: "\u03b5\u0313\u0301",       // GREEK SMALL LETTER EPSILON WITH PSILI AND OXIA
+	0x1F15: "\u03b5\u0314\u0301",       // GREEK SMALL LETTER EPSILON WITH DASIA AND OXIA
+	0x1F18: "\u0395\u0313",             // GREEK CAPITAL LETTER EPSILON WITH PSILI
+	0x1F19: "\u0395\u0314",             // GREEK CAPITAL LETTER EPSILON WITH DASIA
+	0x1F1A: "\u0395\u0313\u0300",       // GREEK CAPITAL LETTER EPSILON WITH PSILI AND VARIA
+	0x1F1B: "\u0395\u0314\u0300",       // GREEK CAPITAL LETTER EPSILON WITH DASIA AND VARIA
+	0x1F1C: "\u0395\u0313\u0301",       // GREEK CAPITAL LETTER EPSILON WITH PSILI AND OXIA
+	0x1F1D: "\u0395\u0314\u0301",       // GREEK CAPITAL LETTER EPSILON WITH DASIA AND OXIA
+	0x1F20: "\u03b7\u0313",             // GREEK SMALL LETTER ETA WITH PSILI
+	0x1F21: "\u03b7\u0314",             // GREEK SMALL LETTER ETA WITH DASIA
+	0x1F22: "\u03b7\u0313\u0300",       // GREEK SMALL LETTER ETA WITH PSILI AND VARIA
+	0x1F23: "\u03b7\u0314\u0300",       // GREEK SMALL LETTER ETA WITH DASIA AND VARIA
+	0x1F24: "\u03b7\u0313\u0301",       // GREEK SMALL LETTER ETA WITH PSILI AND OXIA
+	0x1F25: "\u03b7\u0314\u0301",       // GREEK SMALL LETTER ETA WITH DASIA AND OXIA
+	0x1F26: "\u03b7\u0313\u0342",       // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI
+	0x1F27: "\u03b7\u0314\u0342",       // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI
+	0x1F28: "\u0397\u0313",             // GREEK CAPITAL LETTER ETA WITH PSILI
+	0x1F29: "\u0397\u0314",             // GREEK CAPITAL LETTER ETA WITH DASIA
+	0x1F2A: "\u0397\u0313\u0300",       // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA
+	0x1F2B: "\u0397\u0314\u0300",       // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA
+	0x1F2C: "\u0397\u0313\u0301",       // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA
+	0x1F2D: "\u0397\u0314\u0301",       // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA
+	0x1F2E: "\u0397\u0313\u0342",       // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI
+	0x1F2F: "\u0397\u0314\u0342",       // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI
+	0x1F30: "\u03b9\u0313",             // GREEK SMALL LETTER IOTA WITH PSILI
+	0x1F31: "\u03b9\u0314",             // GREEK SMALL LETTER IOTA WITH DASIA
+	0x1F32: "\u03b9\u0313\u0300",       // GREEK SMALL LETTER IOTA WITH PSILI AND VARIA
+	0x1F33: "\u03b9\u0314\u0300",       // GREEK SMALL LETTER IOTA WITH DASIA AND VARIA
+	0x1F34: "\u03b9\u0313\u0301",       // GREEK SMALL LETTER IOTA WITH PSILI AND OXIA
+	0x1F35: "\u03b9\u0314\u0301",       // GREEK SMALL LETTER IOTA WITH DASIA AND OXIA
+	0x1F36: "\u03b9\u0313\u0342",       // GREEK SMALL LETTER IOTA WITH PSILI AND PERISPOMENI
+	0x1F37: "\u03b9\u0314\u0342",       // GREEK SMALL LETTER IOTA WITH DASIA AND PERISPOMENI
+	0x1F38: "\u0399\u0313",             // GREEK CAPITAL LETTER IOTA WITH PSILI
+	0x1F39: "\u0399\u0314",             // GREEK CAPITAL LETTER IOTA WITH DASIA
+	0x1F3A: "\u0399\u0313\u0300",       // GREEK CAPITAL LETTER IOTA WITH PSILI AND VARIA
+	0x1F3B: "\u0399\u0314\u0300",       // GREEK CAPITAL LETTER IOTA WITH DASIA AND VARIA
+	0x1F3C: "\u0399\u0313\u0301",       // GREEK CAPITAL LETTER IOTA WITH PSILI AND OXIA
+	0x1F3D: "\u0399\u0314\u0301",       // GREEK CAPITAL LETTER IOTA WITH DASIA AND OXIA
+	0x1F3E: "\u0399\u0313\u0342",       // GREEK CAPITAL LETTER IOTA WITH PSILI AND PERISPOMENI
+	0x1F3F: "\u0399\u0314\u0342",       // GREEK CAPITAL LETTER IOTA WITH DASIA AND PERISPOMENI
+	0x1F40: "\u03bf\u0313",             // GREEK SMALL LETTER OMICRON WITH PSILI
+	0x1F41: "\u03bf\u0314",             // GREEK SMALL LETTER OMICRON WITH DASIA
+	0x1F42: "\u03bf\u0313\u0300",       // GREEK SMALL LETTER OMICRON WITH PSILI AND VARIA
+	0x1F43: "\u03bf\u0314\u0300",       // GREEK SMALL LETTER OMICRON WITH DASIA AND VARIA
+	0x1F44: "\u03bf\u0313\u0301",       // GREEK SMALL LETTER OMICRON WITH PSILI AND OXIA
+	0x1F45: "\u03bf\u0314\u0301",       // GREEK SMALL LETTER OMICRON WITH DASIA AND OXIA
+	0x1F48: "\u039f\u0313",             // GREEK CAPITAL LETTER OMICRON WITH PSILI
+	0x1F49: "\u039f\u0314",             // GREEK CAPITAL LETTER OMICRON WITH DASIA
+	0x1F4A: "\u039f\u0313\u0300",       // GREEK CAPITAL LETTER OMICRON WITH PSILI AND VARIA
+	0x1F4B: "\u039f\u0314\u0300",       // GREEK CAPITAL LETTER OMICRON WITH DASIA AND VARIA
+	0x1F4C: "\u039f\u0313\u0301",       // GREEK CAPITAL LETTER OMICRON WITH PSILI AND OXIA
+	0x1F4D: "\u039f\u0314\u0301",       // GREEK CAPITAL LETTER OMICRON WITH DASIA AND OXIA
+	0x1F50: "\u03c5\u0313",             // GREEK SMALL LETTER UPSILON WITH PSILI
+	0x1F51: "\u03c5\u0314",             // GREEK SMALL LETTER UPSILON WITH DASIA
+	0x1F52: "\u03c5\u0313\u0300",       // GREEK SMALL LETTER UPSILON WITH PSILI AND VARIA
+	0x1F53: "\u03c5\u0314\u0300",       // GREEK SMALL LETTER UPSILON WITH DASIA AND VARIA
+	0x1F54: "\u03c5\u0313\u0301",       // GREEK SMALL LETTER UPSILON WITH PSILI AND OXIA
+	0x1F55: "\u03c5\u0314\u0301",       // GREEK SMALL LETTER UPSILON WITH DASIA AND OXIA
+	0x1F56: "\u03c5\u0313\u0342",       // GREEK SMALL LETTER UPSILON WITH PSILI AND PERISPOMENI
+	0x1F57: "\u03c5\u0314\u0342",       // GREEK SMALL LETTER UPSILON WITH DASIA AND PERISPOMENI
+	0x1F59: "\u03a5\u0314",             // GREEK CAPITAL LETTER UPSILON WITH DASIA
+	0x1F5B: "\u03a5\u0314\u0300",       // GREEK CAPITAL LETTER UPSILON WITH DASIA AND VARIA
+	0x1F5D: "\u03a5\u0314\u0301",       // GREEK CAPITAL LETTER UPSILON WITH DASIA AND OXIA
+	0x1F5F: "\u03a5\u0314\u0342",       // GREEK CAPITAL LETTER UPSILON WITH DASIA AND PERISPOMENI
+	0x1F60: "\u03c9\u0313",             // GREEK SMALL LETTER OMEGA WITH PSILI
+	0x1F61: "\u03c9\u0314",             // GREEK SMALL LETTER OMEGA WITH DASIA
+	0x1F62: "\u03c9\u0313\u0300",       // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA
+	0x1F63: "\u03c9\u0314\u0300",       // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA
+	0x1F64: "\u03c9\u0313\u0301",       // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA
+	0x1F65: "\u03c9\u0314\u0301",       // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA
+	0x1F66: "\u03c9\u0313\u0342",       // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI
+	0x1F67: "\u03c9\u0314\u0342",       // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI
+	0x1F68: "\u03a9\u0313",             // GREEK CAPITAL LETTER OMEGA WITH PSILI
+	0x1F69: "\u03a9\u0314",             // GREEK CAPITAL LETTER OMEGA WITH DASIA
+	0x1F6A: "\u03a9\u0313\u0300",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA
+	0x1F6B: "\u03a9\u0314\u0300",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA
+	0x1F6C: "\u03a9\u0313\u0301",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA
+	0x1F6D: "\u03a9\u0314\u0301",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA
+	0x1F6E: "\u03a9\u0313\u0342",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI
+	0x1F6F: "\u03a9\u0314\u0342",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI
+	0x1F70: "\u03b1\u0300",             // GREEK SMALL LETTER ALPHA WITH VARIA
+	0x1F71: "\u03b1\u0301",             // GREEK SMALL LETTER ALPHA WITH OXIA
+	0x1F72: "\u03b5\u0300",             // GREEK SMALL LETTER EPSILON WITH VARIA
+	0x1F73: "\u03b5\u0301",             // GREEK SMALL LETTER EPSILON WITH OXIA
+	0x1F74: "\u03b7\u0300",             // GREEK SMALL LETTER ETA WITH VARIA
+	0x1F75: "\u03b7\u0301",             // GREEK SMALL LETTER ETA WITH OXIA
+	0x1F76: "\u03b9\u0300",             // GREEK SMALL LETTER IOTA WITH VARIA
+	0x1F77: "\u03b9\u0301",             // GREEK SMALL LETTER IOTA WITH OXIA
+	0x1F78: "\u03bf\u0300",             // GREEK SMALL LETTER OMICRON WITH VARIA
+	0x1F79: "\u03bf\u0301",             // GREEK SMALL LETTER OMICRON WITH OXIA
+	0x1F7A: "\u03c5\u0300",             // GREEK SMALL LETTER UPSILON WITH VARIA
+	0x1F7B: "\u03c5\u0301",             // GREEK SMALL LETTER UPSILON WITH OXIA
+	0x1F7C: "\u03c9\u0300",             // GREEK SMALL LETTER OMEGA WITH VARIA
+	0x1F7D: "\u03c9\u0301",             // GREEK SMALL LETTER OMEGA WITH OXIA
+	0x1F80: "\u03b1\u0313\u0345",       // GREEK SMALL LETTER ALPHA WITH PSILI AND YPOGEGRAMMENI
+	0x1F81: "\u03b1\u0314\u0345",       // GREEK SMALL LETTER ALPHA WITH DASIA AND YPOGEGRAMMENI
+	0x1F82: "\u03b1\u0313\u0300\u0345", // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F83: "\u03b1\u0314\u0300\u0345", // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F84: "\u03b1\u0313\u0301\u0345", // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F85: "\u03b1\u0314\u0301\u0345", // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F86: "\u03b1\u0313\u0342\u0345", // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F87: "\u03b1\u0314\u0342\u0345", // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F88: "\u0391\u0313\u0345",       // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PROSGEGRAMMENI
+	0x1F89: "\u0391\u0314\u0345",       // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PROSGEGRAMMENI
+	0x1F8A: "\u0391\u0313\u0300\u0345", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F8B: "\u0391\u0314\u0300\u0345", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F8C: "\u0391\u0313\u0301\u0345", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F8D: "\u0391\u0314\u0301\u0345", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F8E: "\u0391\u0313\u0342\u0345", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F8F: "\u0391\u0314\u0342\u0345", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F90: "\u03b7\u0313\u0345",       // GREEK SMALL LETTER ETA WITH PSILI AND YPOGEGRAMMENI
+	0x1F91: "\u03b7\u0314\u0345",       // GREEK SMALL LETTER ETA WITH DASIA AND YPOGEGRAMMENI
+	0x1F92: "\u03b7\u0313\u0300\u0345", // GREEK SMALL LETTER ETA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F93: "\u03b7\u0314\u0300\u0345", // GREEK SMALL LETTER ETA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F94: "\u03b7\u0313\u0301\u0345", // GREEK SMALL LETTER ETA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F95: "\u03b7\u0314\u0301\u0345", // GREEK SMALL LETTER ETA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F96: "\u03b7\u0313\u0342\u0345", // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F97: "\u03b7\u0314\u0342\u0345", // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F98: "\u0397\u0313\u0345",       // GREEK CAPITAL LETTER ETA WITH PSILI AND PROSGEGRAMMENI
+	0x1F99: "\u0397\u0314\u0345",       // GREEK CAPITAL LETTER ETA WITH DASIA AND PROSGEGRAMMENI
+	0x1F9A: "\u0397\u0313\u0300\u0345", // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F9B: "\u0397\u0314\u0300\u0345", // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F9C: "\u0397\u0313\u0301\u0345", // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F9D: "\u0397\u0314\u0301\u0345", // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F9E: "\u0397\u0313\u0342\u0345", // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F9F: "\u0397\u0314\u0342\u0345", // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FA0: "\u03c9\u0313\u0345",       // GREEK SMALL LETTER OMEGA WITH PSILI AND YPOGEGRAMMENI
+	0x1FA1: "\u03c9\u0314\u0345",       // GREEK SMALL LETTER OMEGA WITH DASIA AND YPOGEGRAMMENI
+	0x1FA2: "\u03c9\u0313\u0300\u0345", // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1FA3: "\u03c9\u0314\u0300\u0345", // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1FA4: "\u03c9\u0313\u0301\u0345", // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1FA5: "\u03c9\u0314\u0301\u0345", // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1FA6: "\u03c9\u0313\u0342\u0345", // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA7: "\u03c9\u0314\u0342\u0345", // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA8: "\u03a9\u0313\u0345",       // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PROSGEGRAMMENI
+	0x1FA9: "\u03a9\u0314\u0345",       // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PROSGEGRAMMENI
+	0x1FAA: "\u03a9\u0313\u0300\u0345", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1FAB: "\u03a9\u0314\u0300\u0345", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1FAC: "\u03a9\u0313\u0301\u0345", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1FAD: "\u03a9\u0314\u0301\u0345", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1FAE: "\u03a9\u0313\u0342\u0345", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FAF: "\u03a9\u0314\u0342\u0345", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FB0: "\u03b1\u0306",             // GREEK SMALL LETTER ALPHA WITH VRACHY
+	0x1FB1: "\u03b1\u0304",             // GREEK SMALL LETTER ALPHA WITH MACRON
+	0x1FB2: "\u03b1\u0300\u0345",       // GREEK SMALL LETTER ALPHA WITH VARIA AND YPOGEGRAMMENI
+	0x1FB3: "\u03b1\u0345",             // GREEK SMALL LETTER ALPHA WITH YPOGEGRAMMENI
+	0x1FB4: "\u03b1\u0301\u0345",       // GREEK SMALL LETTER ALPHA WITH OXIA AND YPOGEGRAMMENI
+	0x1FB6: "\u03b1\u0342",             // GREEK SMALL LETTER ALPHA WITH PERISPOMENI
+	0x1FB7: "\u03b1\u0342\u0345",       // GREEK SMALL LETTER ALPHA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FB8: "\u0391\u0306",             // GREEK CAPITAL LETTER ALPHA WITH VRACHY
+	0x1FB9: "\u0391\u0304",             // GREEK CAPITAL LETTER ALPHA WITH MACRON
+	0x1FBA: "\u0391\u0300",             // GREEK CAPITAL LETTER ALPHA WITH VARIA
+	0x1FBB: "\u0391\u0301",             // GREEK CAPITAL LETTER ALPHA WITH OXIA
+	0x1FBC: "\u0391\u0345",             // GREEK CAPITAL LETTER ALPHA WITH PROSGEGRAMMENI
+	0x1FBE: "\u03b9",                   // GREEK PROSGEGRAMMENI
+	0x1FC2: "\u03b7\u0300\u0345",       // GREEK SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI
+	0x1FC3: "\u03b7\u0345",             // GREEK SMALL LETTER ETA WITH YPOGEGRAMMENI
+	0x1FC4: "\u03b7\u0301\u0345",       // GREEK SMALL LETTER ETA WITH OXIA AND YPOGEGRAMMENI
+	0x1FC6: "\u03b7\u0342",             // GREEK SMALL LETTER ETA WITH PERISPOMENI
+	0x1FC7: "\u03b7\u0342\u0345",       // GREEK SMALL LETTER ETA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FC8: "\u0395\u0300",             // GREEK CAPITAL LETTER EPSILON WITH VARIA
+	0x1FC9: "\u0395\u0301",             // GREEK CAPITAL LETTER EPSILON WITH OXIA
+	0x1FCA: "\u0397\u0300",             // GREEK CAPITAL LETTER ETA WITH VARIA
+	0x1FCB: "\u0397\u0301",             // GREEK CAPITAL LETTER ETA WITH OXIA
+	0x1FCC: "\u0397\u0345",             // GREEK CAPITAL LETTER ETA WITH PROSGEGRAMMENI
+	0x1FD0: "\u03b9\u0306",             // GREEK SMALL LETTER IOTA WITH VRACHY
+	0x1FD1: "\u03b9\u0304",             // GREEK SMALL LETTER IOTA WITH MACRON
+	0x1FD2: "\u03b9\u0308\u0300",       // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND VARIA
+	0x1FD3: "\u03b9\u0308\u0301",       // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND OXIA
+	0x1FD6: "\u03b9\u0342",             // GREEK SMALL LETTER IOTA WITH PERISPOMENI
+	0x1FD7: "\u03b9\u0308\u0342",       // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND PERISPOMENI
+	0x1FD8: "\u0399\u0306",             // GREEK CAPITAL LETTER IOTA WITH VRACHY
+	0x1FD9: "\u0399\u0304",             // GREEK CAPITAL LETTER IOTA WITH MACRON
+	0x1FDA: "\u0399\u0300",             // GREEK CAPITAL LETTER IOTA WITH VARIA
+	0x1FDB: "\u0399\u0301",             // GREEK CAPITAL LETTER IOTA WITH OXIA
+	0x1FE0: "\u03c5\u0306",             // GREEK SMALL LETTER UPSILON WITH VRACHY
+	0x1FE1: "\u03c5\u0304",             // GREEK SMALL LETTER UPSILON WITH MACRON
+	0x1FE2: "\u03c5\u0308\u0300",       // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND VARIA
+	0x1FE3: "\u03c5\u0308\u0301",       // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND OXIA
+	0x1FE4: "\u03c1\u0313",             // GREEK SMALL LETTER RHO WITH PSILI
+	0x1FE5: "\u03c1\u0314",             // GREEK SMALL LETTER RHO WITH DASIA
+	0x1FE6: "\u03c5\u0342",             // GREEK SMALL LETTER UPSILON WITH PERISPOMENI
+	0x1FE7: "\u03c5\u0308\u0342",       // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND PERISPOMENI
+	0x1FE8: "\u03a5\u0306",             // GREEK CAPITAL LETTER UPSILON WITH VRACHY
+	0x1FE9: "\u03a5\u0304",             // GREEK CAPITAL LETTER UPSILON WITH MACRON
+	0x1FEA: "\u03a5\u0300",             // GREEK CAPITAL LETTER UPSILON WITH VARIA
+	0x1FEB: "\u03a5\u0301",             // GREEK CAPITAL LETTER UPSILON WITH OXIA
+	0x1FEC: "\u03a1\u0314",             // GREEK CAPITAL LETTER RHO WITH DASIA
+	0x1FEF: "`",                        // GREEK VARIA
+	0x1FF2: "\u03c9\u0300\u0345",       // GREEK SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI
+	0x1FF3: "\u03c9\u0345",             // GREEK SMALL LETTER OMEGA WITH YPOGEGRAMMENI
+	0x1FF4: "\u03c9\u0301\u0345",       // GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	0x1FF6: "\u03c9\u0342",             // GREEK SMALL LETTER OMEGA WITH PERISPOMENI
+	0x1FF7: "\u03c9\u0342\u0345",       // GREEK SMALL LETTER OMEGA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FF8: "\u039f\u0300",             // GREEK CAPITAL LETTER OMICRON WITH VARIA
+	0x1FF9: "\u039f\u0301",             // GREEK CAPITAL LETTER OMICRON WITH OXIA
+	0x1FFA: "\u03a9\u0300",             // GREEK CAPITAL LETTER OMEGA WITH VARIA
+	0x1FFB: "\u03a9\u0301",             // GREEK CAPITAL LETTER OMEGA WITH OXIA
+	0x1FFC: "\u03a9\u0345",             // GREEK CAPITAL LETTER OMEGA WITH PROSGEGRAMMENI
+	0xFB1D: "\u05d9\u05b4",             // HEBREW LETTER YOD WITH HIRIQ
+	0xFB1F: "\u05f2\u05b7",             // HEBREW LIGATURE YIDDISH YOD YOD PATAH
+	0xFB2A: "\u05e9\u05c1",             // HEBREW LETTER SHIN WITH SHIN DOT
+	0xFB2C: "\u05e9\u05bc\u05c1",       // HEBREW LETTER SHIN WITH DAGESH AND SHIN DOT
+	0xFB2E: "\u05d0\u05b7",             // HEBREW LETTER ALEF WITH PATAH
+	0xFB2F: "\u05d0\u05b8",             // HEBREW LETTER ALEF WITH QAMATS
+	0xFB30: "\u05d0\u05bc",             // HEBREW LETTER ALEF WITH MAPIQ
+	0xFB31: "\u05d1\u05bc",             // HEBREW LETTER BET WITH DAGESH
+	0xFB32: "\u05d2\u05bc",             // HEBREW LETTER GIMEL WITH DAGESH
+	0xFB33: "\u05d3\u05bc",             // HEBREW LETTER DALET WITH DAGESH
+	0xFB34: "\u05d4\u05bc",             // HEBREW LETTER HE WITH MAPIQ
+	0xFB35: "\u05d5\u05bc",             // HEBREW LETTER VAV WITH DAGESH
+	0xFB36: "\u05d6\u05bc",             // HEBREW LETTER ZAYIN WITH DAGESH
+	0xFB38: "\u05d8\u05bc",             // HEBREW LETTER TET WITH DAGESH
+	0xFB39: "\u05d9\u05bc",             // HEBREW LETTER YOD WITH DAGESH
+	0xFB3A: "\u05da\u05bc",             // HEBREW LETTER FINAL KAF WITH DAGESH
+	0xFB3B: "\u05db\u05bc",             // HEBREW LETTER KAF WITH DAGESH
+	0xFB3C: "\u05dc\u05bc",             // HEBREW LETTER LAMED WITH DAGESH
+	0xFB3E: "\u05de\u05bc",             // HEBREW LETTER MEM WITH DAGESH
+	0xFB40: "\u05e0\u05bc",             // HEBREW LETTER NUN WITH DAGESH
+	0xFB41: "\u05e1\u05bc",             // HEBREW LETTER SAMEKH WITH DAGESH
+	0xFB43: "\u05e3\u05bc",             // HEBREW LETTER FINAL PE WITH DAGESH
+	0xFB44: "\u05e4\u05bc",             // HEBREW LETTER PE WITH DAGESH
+	0xFB46: "\u05e6\u05bc",             // HEBREW LETTER TSADI WITH DAGESH
+	0xFB47: "\u05e7\u05bc",             // HEBREW LETTER QOF WITH DAGESH
+	0xFB48: "\u05e8\u05bc",             // HEBREW LETTER RESH WITH DAGESH
+	0xFB49: "\u05e9\u05bc",             // HEBREW LETTER SHIN WITH DAGESH
+	0xFB4A: "\u05ea\u05bc",             // HEBREW LETTER TAV WITH DAGESH
+	0xFB4B: "\u05d5\u05b9",             // HEBREW LETTER VAV WITH HOLAM
+	0xFB4C: "\u05d1\u05bf",             // HEBREW LETTER BET WITH RAFE
+	0xFB4D: "\u05db\u05bf",             // HEBREW LETTER KAF WITH RAFE
+	0xFB4E: "\u05e4\u05bf",             // HEBREW LETTER PE WITH RAFE
+}
@@ -0,0 +1,576 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+/*
+https://www.loc.gov/marc/specifications/specchartables.html
+https://www.loc.gov/marc/specifications/codetables.xml
+
+    The MARC-8 character sets are identified by the final character of
+    the ISO 2022 escape sequence that designates them. The graphic
+    sets are shown here keyed by their 7-bit code (0x21-0x7E) so that
+    the same table serves whether the set has been designated as G0
+    (0x21-0x7E) or as G1 (0xA1-0xFE).
+*/
+
+const (
+	// Basic Latin (ASCII)
+	csBasicLatin = byte('B')
+	// Extended Latin (ANSEL)
+	csExtendedLatin = byte('E')
+	// Basic Hebrew
+	csBasicHebrew = byte('2')
+	// Basic Arabic
+	csBasicArabic = byte('3')
+	// Extended Arabic
+	csExtendedArabic = byte('4')
+	// Basic Cyrillic
+	csBasicCyrillic = byte('N')
+	// Extended Cyrillic
+	csExtendedCyrillic = byte('Q')
+	// Basic Greek
+	csBasicGreek = byte('S')
+	// East Asian Ideographs (EACC), the only multi-byte set
+	csEACC = byte('1')
+	// Subscripts (technique 1 escape)
+	csSubscript = byte('b')
+	// Greek symbols (technique 1 escape)
+	csGreekSymbol = byte('g')
+	// Superscripts (technique 1 escape)
+	csSuperscript = byte('p')
+)
+
+// marc8Sets maps the single-byte MARC-8 character sets to their
+// respective code tables. The Basic Latin set is ASCII and is handled
+// directly; the EACC set is found in eaccToUCS.
+var marc8Sets = map[byte]map[byte]rune{
+	csExtendedLatin:    extendedLatin,
+	csBasicHebrew:      basicHebrew,
+	csBasicArabic:      basicArabic,
+	csExtendedArabic:   extendedArabic,
+	csBasicCyrillic:    basicCyrillic,
+	csExtendedCyrillic: extendedCyrillic,
+	csBasicGreek:       basicGreek,
+	csSubscript:        subscripts,
+	csGreekSymbol:      greekSymbols,
+	csSuperscript:      superscripts,
+}
+
+// marc8C1 maps the few MARC-8 C1 control characters that have a
+// Unicode equivalent
+var marc8C1 = map[byte]rune{
+	0x88: 0x0098, // non-sort begin
+	0x89: 0x009C, // non-sort end
+	0x8D: 0x200D, // joiner
+	0x8E: 0x200C, // non-joiner
+}
+
+// Extended Latin (ANSEL), normally designated as G1 (0xA1-0xFE)
+var extendedLatin = map[byte]rune{
+	0x21: 0x0141, // LATIN CAPITAL LETTER L WITH STROKE
+	0x22: 0x00D8, // LATIN CAPITAL LETTER O WITH STROKE
+	0x23: 0x0110, // LATIN CAPITAL LETTER D WITH STROKE
+	0x24: 0x00DE, // LATIN CAPITAL LETTER THORN
+	0x25: 0x00C6, // LATIN CAPITAL LETTER AE
+	0x26: 0x0152, // LATIN CAPITAL LIGATURE OE
+	0x27: 0x02B9, // MODIFIER LETTER PRIME (soft sign)
+	0x28: 0x00B7, // MIDDLE DOT
+	0x29: 0x266D, // MUSIC FLAT SIGN
+	0x2A: 0x00AE, // REGISTERED SIGN
+	0x2B: 0x00B1, // PLUS-MINUS SIGN
+	0x2C: 0x01A0, // LATIN CAPITAL LETTER O WITH HORN
+	0x2D: 0x01AF, // LATIN CAPITAL LETTER U WITH HORN
+	0x2E: 0x02BC, // MODIFIER LETTER APOSTROPHE (alif)
+	0x30: 0x02BB, // MODIFIER LETTER TURNED COMMA (ayn)
+	0x31: 0x0142, // LATIN SMALL LETTER L WITH STROKE
+	0x32: 0x00F8, // LATIN SMALL LETTER O WITH STROKE
+	0x33: 0x0111, // LATIN SMALL LETTER D WITH STROKE
+	0x34: 0x00FE, // LATIN SMALL LETTER THORN
+	0x35: 0x00E6, // LATIN SMALL LETTER AE
+	0x36: 0x0153, // LATIN SMALL LIGATURE OE
+	0x37: 0x02BA, // MODIFIER LETTER DOUBLE PRIME (hard sign)
+	0x38: 0x0131, // LATIN SMALL LETTER DOTLESS I
+	0x39: 0x00A3, // POUND SIGN
+	0x3A: 0x00F0, // LATIN SMALL LETTER ETH
+	0x3C: 0x01A1, // LATIN SMALL LETTER O WITH HORN
+	0x3D: 0x01B0, // LATIN SMALL LETTER U WITH HORN
+	0x40: 0x00B0, // DEGREE SIGN
+	0x41: 0x2113, // SCRIPT SMALL L
+	0x42: 0x2117, // SOUND RECORDING COPYRIGHT
+	0x43: 0x00A9, // COPYRIGHT SIGN
+	0x44: 0x266F, // MUSIC SHARP SIGN
+	0x45: 0x00BF, // INVERTED QUESTION MARK
+	0x46: 0x00A1, // INVERTED EXCLAMATION MARK
+	0x47: 0x00DF, // LATIN SMALL LETTER SHARP S
+	0x48: 0x20AC, // EURO SIGN
+	0x60: 0x0309, // COMBINING HOOK ABOVE
+	0x61: 0x0300, // COMBINING GRAVE ACCENT
+	0x62: 0x0301, // COMBINING ACUTE ACCENT
+	0x63: 0x0302, // COMBINING CIRCUMFLEX ACCENT
+	0x64: 0x0303, // COMBINING TILDE
+	0x65: 0x0304, // COMBINING MACRON
+	0x66: 0x0306, // COMBINING BREVE
+	0x67: 0x0307, // COMBINING DOT ABOVE
+	0x68: 0x0308, // COMBINING DIAERESIS
+	0x69: 0x030C, // COMBINING CARON
+	0x6A: 0x030A, // COMBINING RING ABOVE
+	0x6B: 0xFE20, // COMBINING LIGATURE LEFT HALF
+	0x6C: 0xFE21, // COMBINING LIGATURE RIGHT HALF
+	0x6D: 0x0315, // COMBINING COMMA ABOVE RIGHT
+	0x6E: 0x030B, // COMBINING DOUBLE ACUTE ACCENT
+	0x6F: 0x0310, // COMBINING CANDRABINDU
+	0x70: 0x0327, // COMBINING CEDILLA
+	0x71: 0x0328, // COMBINING OGONEK
+	0x72: 0x0323, // COMBINING DOT BELOW
+	0x73: 0x0324, // COMBINING DIAERESIS BELOW
+	0x74: 0x0325, // COMBINING RING BELOW
+	0x75: 0x0333, // COMBINING DOUBLE LOW LINE
+	0x76: 0x0332, // COMBINING LOW LINE
+	0x77: 0x0326, // COMBINING COMMA BELOW
+	0x78: 0x031C, // COMBINING LEFT HALF RING BELOW
+	0x79: 0x032E, // COMBINING BREVE BELOW
+	0x7A: 0xFE22, // COMBINING DOUBLE TILDE LEFT HALF
+	0x7B: 0xFE23, // COMBINING DOUBLE TILDE RIGHT HALF
+	0x7E: 0x0313, // COMBINING COMMA ABOVE
+}
+
+// Basic Hebrew, positions 0x21-0x3F (not listed) are as ASCII
+var basicHebrew = map[byte]rune{
+	0x40: 0x05B7, // HEBREW POINT PATAH
+	0x41: 0x05B8, // HEBREW POINT QAMATS
+	0x42: 0x05B6, // HEBREW POINT SEGOL
+	0x43: 0x05B5, // HEBREW POINT TSERE
+	0x44: 0x05B4, // HEBREW POINT HIRIQ
+	0x45: 0x05B9, // HEBREW POINT HOLAM
+	0x46: 0x05BB, // HEBREW POINT QUBUTS
+	0x47: 0x05B0, // HEBREW POINT SHEVA
+	0x48: 0x05B2, // HEBREW POINT HATAF PATAH
+	0x49: 0x05B3, // HEBREW POINT HATAF QAMATS
+	0x4A: 0x05B1, // HEBREW POINT HATAF SEGOL
+	0x4B: 0x05BC, // HEBREW POINT DAGESH OR MAPIQ
+	0x4C: 0x05BF, // HEBREW POINT RAFE
+	0x4D: 0x05C1, // HEBREW POINT SHIN DOT
+	0x4E: 0xFB1E, // HEBREW POINT JUDEO-SPANISH VARIKA
+	0x60: 0x05D0, // HEBREW LETTER ALEF
+	0x61: 0x05D1, // HEBREW LETTER BET
+	0x62: 0x05D2, // HEBREW LETTER GIMEL
+	0x63: 0x05D3, // HEBREW LETTER DALET
+	0x64: 0x05D4, // HEBREW LETTER HE
+	0x65: 0x05D5, // HEBREW LETTER VAV
+	0x66: 0x05D6, // HEBREW LETTER ZAYIN
+	0x67: 0x05D7, // HEBREW LETTER HET
+	0x68: 0x05D8, // HEBREW LETTER TET
+	0x69: 0x05D9, // HEBREW LETTER YOD
+	0x6A: 0x05DA, // HEBREW LETTER FINAL KAF
+	0x6B: 0x05DB, // HEBREW LETTER KAF
+	0x6C: 0x05DC, // HEBREW LETTER LAMED
+	0x6D: 0x05DD, // HEBREW LETTER FINAL MEM
+	0x6E: 0x05DE, // HEBREW LETTER MEM
+	0x6F: 0x05DF, // HEBREW LETTER FINAL NUN
+	0x70: 0x05E0, // HEBREW LETTER NUN
+	0x71: 0x05E1, // HEBREW LETTER SAMEKH
+	0x72: 0x05E2, // HEBREW LETTER AYIN
+	0x73: 0x05E3, // HEBREW LETTER FINAL PE
+	0x74: 0x05E4, // HEBREW LETTER PE
+	0x75: 0x05E5, // HEBREW LETTER FINAL TSADI
+	0x76: 0x05E6, // HEBREW LETTER TSADI
+	0x77: 0x05E7, // HEBREW LETTER QOF
+	0x78: 0x05E8, // HEBREW LETTER RESH
+	0x79: 0x05E9, // HEBREW LETTER SHIN
+	0x7A: 0x05EA, // HEBREW LETTER TAV
+	0x7B: 0x05F0, // HEBREW LIGATURE YIDDISH DOUBLE VAV
+	0x7C: 0x05F1, // HEBREW LIGATURE YIDDISH VAV YOD
+	0x7D: 0x05F2, // HEBREW LIGATURE YIDDISH DOUBLE YOD
+}
+
+// Basic Arabic, positions 0x21-0x40 not listed are as ASCII
+var basicArabic = map[byte]rune{
+	0x25: 0x066A, // ARABIC PERCENT SIGN
+	0x2C: 0x060C, // ARABIC COMMA
+	0x30: 0x0660, // ARABIC-INDIC DIGIT ZERO
+	0x31: 0x0661, // ARABIC-INDIC DIGIT ONE
+	0x32: 0x0662, // ARABIC-INDIC DIGIT TWO
+	0x33: 0x0663, // ARABIC-INDIC DIGIT THREE
+	0x34: 0x0664, // ARABIC-INDIC DIGIT FOUR
+	0x35: 0x0665, // ARABIC-INDIC DIGIT FIVE
+	0x36: 0x0666, // ARABIC-INDIC DIGIT SIX
+	0x37: 0x0667, // ARABIC-INDIC DIGIT SEVEN
+	0x38: 0x0668, // ARABIC-INDIC DIGIT EIGHT
+	0x39: 0x0669, // ARABIC-INDIC DIGIT NINE
+	0x3B: 0x061B, // ARABIC SEMICOLON
+	0x3F: 0x061F, // ARABIC QUESTION MARK
+	0x41: 0x0621, // ARABIC LETTER HAMZA
+	0x42: 0x0622, // ARABIC LETTER ALEF WITH MADDA ABOVE
+	0x43: 0x0623, // ARABIC LETTER ALEF WITH HAMZA ABOVE
+	0x44: 0x0624, // ARABIC LETTER WAW WITH HAMZA ABOVE
+	0x45: 0x0625, // ARABIC LETTER ALEF WITH HAMZA BELOW
+	0x46: 0x0626, // ARABIC LETTER YEH WITH HAMZA ABOVE
+	0x47: 0x0627, // ARABIC LETTER ALEF
+	0x48: 0x0628, // ARABIC LETTER BEH
+	0x49: 0x0629, // ARABIC LETTER TEH MARBUTA
+	0x4A: 0x062A, // ARABIC LETTER TEH
+	0x4B: 0x062B, // ARABIC LETTER THEH
+	0x4C: 0x062C, // ARABIC LETTER JEEM
+	0x4D: 0x062D, // ARABIC LETTER HAH
+	0x4E: 0x062E, // ARABIC LETTER KHAH
+	0x4F: 0x062F, // ARABIC LETTER DAL
+	0x50: 0x0630, // ARABIC LETTER THAL
+	0x51: 0x0631, // ARABIC LETTER REH
+	0x52: 0x0632, // ARABIC LETTER ZAIN
+	0x53: 0x0633, // ARABIC LETTER SEEN
+	0x54: 0x0634, // ARABIC LETTER SHEEN
+	0x55: 0x0635, // ARABIC LETTER SAD
+	0x56: 0x0636, // ARABIC LETTER DAD
+	0x57: 0x0637, // ARABIC LETTER TAH
+	0x58: 0x0638, // ARABIC LETTER ZAH
+	0x59: 0x0639, // ARABIC LETTER AIN
+	0x5A: 0x063A, // ARABIC LETTER GHAIN
+	0x60: 0x0640, // ARABIC TATWEEL
+	0x61: 0x0641, // ARABIC LETTER FEH
+	0x62: 0x0642, // ARABIC LETTER QAF
+	0x63: 0x0643, // ARABIC LETTER KAF
+	0x64: 0x0644, // ARABIC LETTER LAM
+	0x65: 0x0645, // ARABIC LETTER MEEM
+	0x66: 0x0646, // ARABIC LETTER NOON
+	0x67: 0x0647, // ARABIC LETTER HEH
+	0x68: 0x0648, // ARABIC LETTER WAW
+	0x69: 0x0649, // ARABIC LETTER ALEF MAKSURA
+	0x6A: 0x064A, // ARABIC LETTER YEH
+	0x6B: 0x064B, // ARABIC FATHATAN
+	0x6C: 0x064C, // ARABIC DAMMATAN
+	0x6D: 0x064D, // ARABIC KASRATAN
+	0x6E: 0x064E, // ARABIC FATHA
+	0x6F: 0x064F, // ARABIC DAMMA
+	0x70: 0x0650, // ARABIC KASRA
+	0x71: 0x0651, // ARABIC SHADDA
+	0x72: 0x0652, // ARABIC SUKUN
+	0x73: 0x0671, // ARABIC LETTER ALEF WASLA
+	0x74: 0x0670, // ARABIC LETTER SUPERSCRIPT ALEF
+}
+
+// Extended Arabic, normally designated as G1 (0xA1-0xFE)
+var extendedArabic = map[byte]rune{
+	0x21: 0x06FD, // DISTRIBUTED SIGN
+	0x22: 0x0672, // ARABIC LETTER ALEF WITH WAVY HAMZA ABOVE
+	0x23: 0x0673, // ARABIC LETTER ALEF WITH WAVY HAMZA BELOW
+	0x24: 0x0679, // ARABIC LETTER TTEH
+	0x25: 0x067A, // ARABIC LETTER TTEHEH
+	0x26: 0x067B, // ARABIC LETTER BEEH
+	0x27: 0x067C, // ARABIC LETTER TEH WITH RING
+	0x28: 0x067D, // ARABIC LETTER TEH WITH THREE DOTS ABOVE DOWNWARDS
+	0x29: 0x067E, // ARABIC LETTER PEH
+	0x2A: 0x067F, // ARABIC LETTER TEHEH
+	0x2B: 0x0680, // ARABIC LETTER BEHEH
+	0x2C: 0x0681, // ARABIC LETTER HAH WITH HAMZA ABOVE
+	0x2D: 0x0682, // ARABIC LETTER HAH WITH TWO ABOVE DOTS VERTICAL ABOVE
+	0x2E: 0x0683, // ARABIC LETTER NYEH
+	0x2F: 0x0684, // ARABIC LETTER DYEH
+	0x30: 0x0685, // ARABIC LETTER HAH WITH THREE DOTS ABOVE
+	0x31: 0x0686, // ARABIC LETTER TCHEH
+	0x32: 0x06BF, // ARABIC LETTER TCHEH WITH DOT ABOVE
+	0x33: 0x0687, // ARABIC LETTER TCHEHEH
+	0x34: 0x0688, // ARABIC LETTER DDAL
+	0x35: 0x0689, // ARABIC LETTER DAL WITH RING
+	0x36: 0x068A, // ARABIC LETTER DAL WITH DOT BELOW
+	0x37: 0x068B, // ARABIC LETTER DAL WITH DOT BELOW AND SMALL TAH
+	0x38: 0x068C, // ARABIC LETTER DAHAL
+	0x39: 0x068D, // ARABIC LETTER DDAHAL
+	0x3A: 0x068E, // ARABIC LETTER DUL
+	0x3B: 0x068F, // ARABIC LETTER DAL WITH THREE DOTS ABOVE DOWNWARDS
+	0x3C: 0x0690, // ARABIC LETTER DAL WITH FOUR DOTS ABOVE
+	0x3D: 0x0691, // ARABIC LETTER RREH
+	0x3E: 0x0692, // ARABIC LETTER REH WITH SMALL V
+	0x3F: 0x0693, // ARABIC LETTER REH WITH RING
+	0x40: 0x0694, // ARABIC LETTER REH WITH DOT BELOW
+	0x41: 0x0695, // ARABIC LETTER REH WITH SMALL V BELOW
+	0x42: 0x0696, // ARABIC LETTER REH WITH DOT BELOW AND DOT ABOVE
+	0x43: 0x0697, // ARABIC LETTER REH WITH TWO DOTS ABOVE
+	0x44: 0x0698, // ARABIC LETTER JEH
+	0x45: 0x0699, // ARABIC LETTER REH WITH FOUR DOTS ABOVE
+	0x46: 0x069A, // ARABIC LETTER SEEN WITH DOT BELOW AND DOT ABOVE
+	0x47: 0x069B, // ARABIC LETTER SEEN WITH THREE DOTS BELOW
+	0x48: 0x069C, // ARABIC LETTER SEEN WITH THREE DOTS BELOW AND THREE DOTS ABOVE
+	0x49: 0x06FA, // ARABIC LETTER SHEEN WITH DOT BELOW
+	0x4A: 0x069D, // ARABIC LETTER SAD WITH TWO DOTS BELOW
+	0x4B: 0x069E, // ARABIC LETTER SAD WITH THREE DOTS ABOVE
+	0x4C: 0x06FB, // ARABIC LETTER DAD WITH DOT BELOW
+	0x4D: 0x069F, // ARABIC LETTER TAH WITH THREE DOTS ABOVE
+	0x4E: 0x06A0, // ARABIC LETTER AIN WITH THREE DOTS ABOVE
+	0x4F: 0x06FC, // ARABIC LETTER GHAIN WITH DOT BELOW
+	0x50: 0x06A1, // ARABIC LETTER DOTLESS FEH
+	0x51: 0x06A2, // ARABIC LETTER FEH WITH DOT MOVED BELOW
+	0x52: 0x06A3, // ARABIC LETTER FEH WITH DOT BELOW
+	0x53: 0x06A4, // ARABIC LETTER VEH
+	0x54: 0x06A5, // ARABIC LETTER FEH WITH THREE DOTS BELOW
+	0x55: 0x06A6, // ARABIC LETTER PEHEH
+	0x56: 0x06A7, // ARABIC LETTER QAF WITH DOT ABOVE
+	0x57: 0x06A8, // ARABIC LETTER QAF WITH THREE DOTS ABOVE
+	0x58: 0x06A9, // ARABIC LETTER KEHEH
+	0x59: 0x06AA, // ARABIC LETTER SWASH KAF
+	0x5A: 0x06AB, // ARABIC LETTER KAF WITH RING
+	0x5B: 0x06AC, // ARABIC LETTER KAF WITH DOT ABOVE
+	0x5C: 0x06AD, // ARABIC LETTER NG
+	0x5D: 0x06AE, // ARABIC LETTER KAF WITH THREE DOTS BELOW
+	0x5E: 0x06AF, // ARABIC LETTER GAF
+	0x5F: 0x06B0, // ARABIC LETTER GAF WITH RING
+	0x60: 0x06B1, // ARABIC LETTER NGOEH
+	0x61: 0x06B2, // ARABIC LETTER GAF WITH TWO DOTS BELOW
+	0x62: 0x06B3, // ARABIC LETTER GUEH
+	0x63: 0x06B4, // ARABIC LETTER GAF WITH THREE DOTS ABOVE
+	0x64: 0x06B5, // ARABIC LETTER LAM WITH SMALL V
+	0x65: 0x06B6, // ARABIC LETTER LAM WITH DOT ABOVE
+	0x66: 0x06B7, // ARABIC LETTER LAM WITH THREE DOTS ABOVE
+	0x67: 0x06B8, // ARABIC LETTER LAM WITH THREE DOTS BELOW
+	0x68: 0x06BA, // ARABIC LETTER NOON GHUNNA
+	0x69: 0x06BB, // ARABIC LETTER RNOON
+	0x6A: 0x06BC, // ARABIC LETTER NOON WITH RING
+	0x6B: 0x06BD, // ARABIC LETTER NOON WITH THREE DOTS ABOVE
+	0x6C: 0x06B9, // ARABIC LETTER NOON WITH DOT BELOW
+	0x6D: 0x06BE, // ARABIC LETTER HEH DOACHASHMEE
+	0x6E: 0x06C0, // ARABIC LETTER HEH WITH YEH ABOVE
+	0x6F: 0x06C4, // ARABIC LETTER WAW WITH RING
+	0x70: 0x06C5, // ARABIC LETTER KIRGHIZ OE
+	0x71: 0x06C6, // ARABIC LETTER OE
+	0x72: 0x06CA, // ARABIC LETTER WAW WITH TWO DOTS ABOVE
+	0x73: 0x06CB, // ARABIC LETTER VE
+	0x74: 0x06CD, // ARABIC LETTER YEH WITH TAIL
+	0x75: 0x06CE, // ARABIC LETTER YEH WITH SMALL V
+	0x76: 0x06D0, // ARABIC LETTER E
+	0x77: 0x06D2, // ARABIC LETTER YEH BARREE
+	0x78: 0x06D3, // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE
+}
+
+// Basic Cyrillic, positions 0x21-0x3F are as ASCII
+var basicCyrillic = map[byte]rune{
+	0x40: 0x044E, // CYRILLIC SMALL LETTER YU
+	0x41: 0x0430, // CYRILLIC SMALL LETTER A
+	0x42: 0x0431, // CYRILLIC SMALL LETTER BE
+	0x43: 0x0446, // CYRILLIC SMALL LETTER TSE
+	0x44: 0x0434, // CYRILLIC SMALL LETTER DE
+	0x45: 0x0435, // CYRILLIC SMALL LETTER IE
+	0x46: 0x0444, // CYRILLIC SMALL LETTER EF
+	0x47: 0x0433, // CYRILLIC SMALL LETTER GHE
+	0x48: 0x0445, // CYRILLIC SMALL LETTER HA
+	0x49: 0x0438, // CYRILLIC SMALL LETTER I
+	0x4A: 0x0439, // CYRILLIC SMALL LETTER SHORT I
+	0x4B: 0x043A, // CYRILLIC SMALL LETTER KA
+	0x4C: 0x043B, // CYRILLIC SMALL LETTER EL
+	0x4D: 0x043C, // CYRILLIC SMALL LETTER EM
+	0x4E: 0x043D, // CYRILLIC SMALL LETTER EN
+	0x4F: 0x043E, // CYRILLIC SMALL LETTER O
+	0x50: 0x043F, // CYRILLIC SMALL LETTER PE
+	0x51: 0x044F, // CYRILLIC SMALL LETTER YA
+	0x52: 0x0440, // CYRILLIC SMALL LETTER ER
+	0x53: 0x0441, // CYRILLIC SMALL LETTER ES
+	0x54: 0x0442, // CYRILLIC SMALL LETTER TE
+	0x55: 0x0443, // CYRILLIC SMALL LETTER U
+	0x56: 0x0436, // CYRILLIC SMALL LETTER ZHE
+	0x57: 0x0432, // CYRILLIC SMALL LETTER VE
+	0x58: 0x044C, // CYRILLIC SMALL LETTER SOFT SIGN
+	0x59: 0x044B, // CYRILLIC SMALL LETTER YERU
+	0x5A: 0x0437, // CYRILLIC SMALL LETTER ZE
+	0x5B: 0x0448, // CYRILLIC SMALL LETTER SHA
+	0x5C: 0x044D, // CYRILLIC SMALL LETTER E
+	0x5D: 0x0449, // CYRILLIC SMALL LETTER SHCHA
+	0x5E: 0x0447, // CYRILLIC SMALL LETTER CHE
+	0x5F: 0x044A, // CYRILLIC SMALL LETTER HARD SIGN
+	0x60: 0x042E, // CYRILLIC CAPITAL LETTER YU
+	0x61: 0x0410, // CYRILLIC CAPITAL LETTER A
+	0x62: 0x0411, // CYRILLIC CAPITAL LETTER BE
+	0x63: 0x0426, // CYRILLIC CAPITAL LETTER TSE
+	0x64: 0x0414, // CYRILLIC CAPITAL LETTER DE
+	0x65: 0x0415, // CYRILLIC CAPITAL LETTER IE
+	0x66: 0x0424, // CYRILLIC CAPITAL LETTER EF
+	0x67: 0x0413, // CYRILLIC CAPITAL LETTER GHE
+	0x68: 0x0425, // CYRILLIC CAPITAL LETTER HA
+	0x69: 0x0418, // CYRILLIC CAPITAL LETTER I
+	0x6A: 0x0419, // CYRILLIC CAPITAL LETTER SHORT I
+	0x6B: 0x041A, // CYRILLIC CAPITAL LETTER KA
+	0x6C: 0x041B, // CYRILLIC CAPITAL LETTER EL
+	0x6D: 0x041C, // CYRILLIC CAPITAL LETTER EM
+	0x6E: 0x041D, // CYRILLIC CAPITAL LETTER EN
+	0x6F: 0x041E, // CYRILLIC CAPITAL LETTER O
+	0x70: 0x041F, // CYRILLIC CAPITAL LETTER PE
+	0x71: 0x042F, // CYRILLIC CAPITAL LETTER YA
+	0x72: 0x0420, // CYRILLIC CAPITAL LETTER ER
+	0x73: 0x0421, // CYRILLIC CAPITAL LETTER ES
+	0x74: 0x0422, // CYRILLIC CAPITAL LETTER TE
+	0x75: 0x0423, // CYRILLIC CAPITAL LETTER U
+	0x76: 0x0416, // CYRILLIC CAPITAL LETTER ZHE
+	0x77: 0x0412, // CYRILLIC CAPITAL LETTER VE
+	0x78: 0x042C, // CYRILLIC CAPITAL LETTER SOFT SIGN
+	0x79: 0x042B, // CYRILLIC CAPITAL LETTER YERU
+	0x7A: 0x0417, // CYRILLIC CAPITAL LETTER ZE
+	0x7B: 0x0428, // CYRILLIC CAPITAL LETTER SHA
+	0x7C: 0x042D, // CYRILLIC CAPITAL LETTER E
+	0x7D: 0x0429, // CYRILLIC CAPITAL LETTER SHCHA
+	0x7E: 0x0427, // CYRILLIC CAPITAL LETTER CHE
+}
+
+// Extended Cyrillic, normally designated as G1 (0xA1-0xFE)
+var extendedCyrillic = map[byte]rune{
+	0x40: 0x0491, // CYRILLIC SMALL LETTER GHE WITH UPTURN
+	0x41: 0x0452, // CYRILLIC SMALL LETTER DJE
+	0x42: 0x0453, // CYRILLIC SMALL LETTER GJE
+	0x43: 0x0454, // CYRILLIC SMALL LETTER UKRAINIAN IE
+	0x44: 0x0451, // CYRILLIC SMALL LETTER IO
+	0x45: 0x0455, // CYRILLIC SMALL LETTER DZE
+	0x46: 0x0456, // CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I
+	0x47: 0x0457, // CYRILLIC SMALL LETTER YI
+	0x48: 0x0458, // CYRILLIC SMALL LETTER JE
+	0x49: 0x0459, // CYRILLIC SMALL LETTER LJE
+	0x4A: 0x045A, // CYRILLIC SMALL LETTER NJE
+	0x4B: 0x045B, // CYRILLIC SMALL LETTER TSHE
+	0x4C: 0x045C, // CYRILLIC SMALL LETTER KJE
+	0x4D: 0x045E, // CYRILLIC SMALL LETTER SHORT U
+	0x4E: 0x045F, // CYRILLIC SMALL LETTER DZHE
+	0x50: 0x0463, // CYRILLIC SMALL LETTER YAT
+	0x51: 0x0473, // CYRILLIC SMALL LETTER FITA
+	0x52: 0x0475, // CYRILLIC SMALL LETTER IZHITSA
+	0x53: 0x046B, // CYRILLIC SMALL LETTER BIG YUS
+	0x5B: 0x005B, // LEFT SQUARE BRACKET
+	0x5D: 0x005D, // RIGHT SQUARE BRACKET
+	0x5F: 0x005F, // LOW LINE
+	0x60: 0x0490, // CYRILLIC CAPITAL LETTER GHE WITH UPTURN
+	0x61: 0x0402, // CYRILLIC CAPITAL LETTER DJE
+	0x62: 0x0403, // CYRILLIC CAPITAL LETTER GJE
+	0x63: 0x0404, // CYRILLIC CAPITAL LETTER UKRAINIAN IE
+	0x64: 0x0401, // CYRILLIC CAPITAL LETTER IO
+	0x65: 0x0405, // CYRILLIC CAPITAL LETTER DZE
+	0x66: 0x0406, // CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I
+	0x67: 0x0407, // CYRILLIC CAPITAL LETTER YI
+	0x68: 0x0408, // CYRILLIC CAPITAL LETTER JE
+	0x69: 0x0409, // CYRILLIC CAPITAL LETTER LJE
+	0x6A: 0x040A, // CYRILLIC CAPITAL LETTER NJE
+	0x6B: 0x040B, // CYRILLIC CAPITAL LETTER TSHE
+	0x6C: 0x040C, // CYRILLIC CAPITAL LETTER KJE
+	0x6D: 0x040E, // CYRILLIC CAPITAL LETTER SHORT U
+	0x6E: 0x040F, // CYRILLIC CAPITAL LETTER DZHE
+	0x6F: 0x042A, // CYRILLIC CAPITAL LETTER HARD SIGN
+	0x70: 0x0462, // CYRILLIC CAPITAL LETTER YAT
+	0x71: 0x0472, // CYRILLIC CAPITAL LETTER FITA
+	0x72: 0x0474, // CYRILLIC CAPITAL LETTER IZHITSA
+	0x73: 0x046A, // CYRILLIC CAPITAL LETTER BIG YUS
+}
+
+// Basic Greek, positions 0x28-0x40 not listed are as ASCII
+var basicGreek = map[byte]rune{
+	0x21: 0x0300, // COMBINING GRAVE ACCENT
+	0x22: 0x0301, // COMBINING ACUTE ACCENT
+	0x23: 0x0308, // COMBINING DIAERESIS
+	0x24: 0x0342, // COMBINING GREEK PERISPOMENI
+	0x25: 0x0313, // COMBINING COMMA ABOVE
+	0x26: 0x0314, // COMBINING REVERSED COMMA ABOVE
+	0x27: 0x0345, // COMBINING GREEK YPOGEGRAMMENI
+	0x30: 0x00AB, // LEFT-POINTING DOUBLE ANGLE QUOTATION MARK
+	0x31: 0x00BB, // RIGHT-POINTING DOUBLE ANGLE QUOTATION MARK
+	0x32: 0x201C, // LEFT DOUBLE QUOTATION MARK
+	0x33: 0x201D, // RIGHT DOUBLE QUOTATION MARK
+	0x34: 0x0374, // GREEK NUMERAL SIGN
+	0x35: 0x0375, // GREEK LOWER NUMERAL SIGN
+	0x3B: 0x0387, // GREEK ANO TELEIA
+	0x3F: 0x037E, // GREEK QUESTION MARK
+	0x41: 0x0391, // GREEK CAPITAL LETTER ALPHA
+	0x42: 0x0392, // GREEK CAPITAL LETTER BETA
+	0x44: 0x0393, // GREEK CAPITAL LETTER GAMMA
+	0x45: 0x0394, // GREEK CAPITAL LETTER DELTA
+	0x46: 0x0395, // GREEK CAPITAL LETTER EPSILON
+	0x47: 0x03DA, // GREEK LETTER STIGMA
+	0x48: 0x03DC, // GREEK LETTER DIGAMMA
+	0x49: 0x0396, // GREEK CAPITAL LETTER ZETA
+	0x4A: 0x0397, // GREEK CAPITAL LETTER ETA
+	0x4B: 0x0398, // GREEK CAPITAL LETTER THETA
+	0x4C: 0x0399, // GREEK CAPITAL LETTER IOTA
+	0x4D: 0x039A, // GREEK CAPITAL LETTER KAPPA
+	0x4E: 0x039B, // GREEK CAPITAL LETTER LAMDA
+	0x4F: 0x039C, // GREEK CAPITAL LETTER MU
+	0x50: 0x039D, // GREEK CAPITAL LETTER NU
+	0x51: 0x039E, // GREEK CAPITAL LETTER XI
+	0x52: 0x039F, // GREEK CAPITAL LETTER OMICRON
+	0x53: 0x03A0, // GREEK CAPITAL LETTER PI
+	0x54: 0x03DE, // GREEK LETTER KOPPA
+	0x55: 0x03A1, // GREEK CAPITAL LETTER RHO
+	0x56: 0x03A3, // GREEK CAPITAL LETTER SIGMA
+	0x58: 0x03A4, // GREEK CAPITAL LETTER TAU
+	0x59: 0x03A5, // GREEK CAPITAL LETTER UPSILON
+	0x5A: 0x03A6, // GREEK CAPITAL LETTER PHI
+	0x5B: 0x03A7, // GREEK CAPITAL LETTER CHI
+	0x5C: 0x03A8, // GREEK CAPITAL LETTER PSI
+	0x5D: 0x03A9, // GREEK CAPITAL LETTER OMEGA
+	0x5E: 0x03E0, // GREEK LETTER SAMPI
+	0x61: 0x03B1, // GREEK SMALL LETTER ALPHA
+	0x62: 0x03B2, // GREEK SMALL LETTER BETA
+	0x63: 0x03D0, // GREEK BETA SYMBOL
+	0x64: 0x03B3, // GREEK SMALL LETTER GAMMA
+	0x65: 0x03B4, // GREEK SMALL LETTER DELTA
+	0x66: 0x03B5, // GREEK SMALL LETTER EPSILON
+	0x67: 0x03DB, // GREEK SMALL LETTER STIGMA
+	0x68: 0x03DD, // GREEK SMALL LETTER DIGAMMA
+	0x69: 0x03B6, // GREEK SMALL LETTER ZETA
+	0x6A: 0x03B7, // GREEK SMALL LETTER ETA
+	0x6B: 0x03B8, // GREEK SMALL LETTER THETA
+	0x6C: 0x03B9, // GREEK SMALL LETTER IOTA
+	0x6D: 0x03BA, // GREEK SMALL LETTER KAPPA
+	0x6E: 0x03BB, // GREEK SMALL LETTER LAMDA
+	0x6F: 0x03BC, // GREEK SMALL LETTER MU
+	0x70: 0x03BD, // GREEK SMALL LETTER NU
+	0x71: 0x03BE, // GREEK SMALL LETTER XI
+	0x72: 0x03BF, // GREEK SMALL LETTER OMICRON
+	0x73: 0x03C0, // GREEK SMALL LETTER PI
+	0x74: 0x03DF, // GREEK SMALL LETTER KOPPA
+	0x75: 0x03C1, // GREEK SMALL LETTER RHO
+	0x76: 0x03C3, // GREEK SMALL LETTER SIGMA
+	0x77: 0x03C2, // GREEK SMALL LETTER FINAL SIGMA
+	0x78: 0x03C4, // GREEK SMALL LETTER TAU
+	0x79: 0x03C5, // GREEK SMALL LETTER UPSILON
+	0x7A: 0x03C6, // GREEK SMALL LETTER PHI
+	0x7B: 0x03C7, // GREEK SMALL LETTER CHI
+	0x7C: 0x03C8, // GREEK SMALL LETTER PSI
+	0x7D: 0x03C9, // GREEK SMALL LETTER OMEGA
+	0x7E: 0x03E1, // GREEK SMALL LETTER SAMPI
+}
+
+// Subscripts (ESC b)
+var subscripts = map[byte]rune{
+	0x28: 0x208D, // SUBSCRIPT LEFT PARENTHESIS
+	0x29: 0x208E, // SUBSCRIPT RIGHT PARENTHESIS
+	0x2B: 0x208A, // SUBSCRIPT PLUS SIGN
+	0x2D: 0x208B, // SUBSCRIPT MINUS
+	0x30: 0x2080, // SUBSCRIPT ZERO
+	0x31: 0x2081, // SUBSCRIPT ONE
+	0x32: 0x2082, // SUBSCRIPT TWO
+	0x33: 0x2083, // SUBSCRIPT THREE
+	0x34: 0x2084, // SUBSCRIPT FOUR
+	0x35: 0x2085, // SUBSCRIPT FIVE
+	0x36: 0x2086, // SUBSCRIPT SIX
+	0x37: 0x2087, // SUBSCRIPT SEVEN
+	0x38: 0x2088, // SUBSCRIPT EIGHT
+	0x39: 0x2089, // SUBSCRIPT NINE
+}
+
+// Greek symbols (ESC g)
+var greekSymbols = map[byte]rune{
+	0x61: 0x03B1, // GREEK SMALL LETTER ALPHA
+	0x62: 0x03B2, // GREEK SMALL LETTER BETA
+	0x63: 0x03B3, // GREEK SMALL LETTER GAMMA
+}
+
+// Superscripts (ESC p)
+var superscripts = map[byte]rune{
+	0x28: 0x207D, // SUPERSCRIPT LEFT PARENTHESIS
+	0x29: 0x207E, // SUPERSCRIPT RIGHT PARENTHESIS
+	0x2B: 0x207A, // SUPERSCRIPT PLUS SIGN
+	0x2D: 0x207B, // SUPERSCRIPT MINUS
+	0x30: 0x2070, // SUPERSCRIPT ZERO
+	0x31: 0x00B9, // SUPERSCRIPT ONE
+	0x32: 0x00B2, // SUPERSCRIPT TWO
+	0x33: 0x00B3, // SUPERSCRIPT THREE
+	0x34: 0x2074, // SUPERSCRIPT FOUR
+	0x35: 0x2075, // SUPERSCRIPT FIVE
+	0x36: 0x2076, // SUPERSCRIPT SIX
+	0x37: 0x2077, // SUPERSCRIPT SEVEN
+	0x38: 0x2078, // SUPERSCRIPT EIGHT
+	0x39: 0x2079, // SUPERSCRIPT NINE
+}
@@ -6,9 +6,9 @@ package marc21
 
 import (
 	"encoding/xml"
-	"fmt"
-	"html"
+	"io"
 	"os"
+	"strings"
 )
 
 /*
@@ -62,9 +62,7 @@ const CollectionXMLFooter = `</marc:collection>
 `
 
 // LoadXML reads a MARCXML document
-func LoadXML(filename string) (Collection, error) {
-
-	var doc Collection
+func LoadXML(filename string) (doc Collection, err error) {
 
 	f, err := os.Open(filename)
 	if err != nil {
@@ -76,46 +74,118 @@ func LoadXML(filename string) (Collection, error) {
 		}
 	}()
 
-	dec := xml.NewDecoder(f)
-	err = dec.Decode(&doc)
-	return doc, err
+	return ReadXML(f)
+}
+
+// ReadXML reads a MARCXML document from an io.Reader. Characters that
+// were escaped by an XMLWriter using EscapeIllegal are restored.
+func ReadXML(r io.Reader) (doc Collection, err error) {
+
+	dec := xml.NewDecoder(r)
+
+	unescape := false
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return doc, err
+		}
+		if isXMLEscapeInst(tok) {
+			unescape = true
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			err = dec.DecodeElement(&doc, &se)
+			if err != nil {
+				return doc, err
+			}
+			break
+		}
+	}
+
+	if unescape {
+		for _, rec := range doc.Records {
+			rec.unescapeXML()
+		}
+	}
+
+	return doc, nil
+}
+
+// UnmarshalXML decodes a MARCXML record element. The elements are
+// decoded in document order so that the position of each field is
+// recorded (see fieldOrder) and the original field order is kept when
+// the record is written.
+func (rec *Record) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+
+	seq := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "leader":
+				err = dec.DecodeElement(&rec.Leader, &t)
+			case "controlfield":
+				seq++
+				cf := &Controlfield{seq: seq}
+				err = dec.DecodeElement(cf, &t)
+				rec.Controlfields = append(rec.Controlfields, cf)
+			case "datafield":
+				seq++
+				df := &Datafield{seq: seq}
+				err = dec.DecodeElement(df, &t)
+				rec.Datafields = append(rec.Datafields, df)
+			default:
+				err = dec.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
 }
 
 // https://www.loc.gov/standards/marcxml/
 // <collection xsi:schemaLocation="http://www.loc.gov/MARC21/slim http://www.loc.gov/standards/marcxml/schema/MARC21slim.xsd">
 // looks like various samples do not mess with the <marc:TAG> and simply use <TAG>
 
-// AsXML converts an entire collection to XML
+// AsXML converts an entire collection to XML. Use an XMLWriter to
+// write large collections directly to a file.
 func (c Collection) AsXML() (ret string, err error) {
 
-	ret = CollectionXMLHeader
+	var b strings.Builder
 
+	w := NewXMLWriter(&b)
 	for _, rec := range c.Records {
-		rx, err := rec.AsXML()
+		err = w.Write(rec)
 		if err != nil {
 			return "", err
 		}
-		ret += rx
 	}
-	ret += CollectionXMLFooter
-	return ret, nil
+	err = w.Close()
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
 }
 
-// AsXML converts record to XML
+// AsXML converts record to XML, as an element of a collection
 func (rec Record) AsXML() (ret string, err error) {
 
-	ret = "\t<marc:record>\n"
-	ret += fmt.Sprintf("\t\t<marc:leader>%s</marc:leader>\n", html.EscapeString(rec.Leader.GetText()))
-	for _, cf := range rec.Controlfields {
-		ret += fmt.Sprintf("\t\t<marc:controlfield tag=%q>%s</marc:controlfield>\n", cf.GetTag(), html.EscapeString(cf.GetText()))
-	}
-	for _, df := range rec.Datafields {
-		ret += fmt.Sprintf("\t\t<marc:datafield tag=%q ind1=%q ind2=%q>\n", df.GetTag(), df.GetInd1(), df.GetInd2())
-		for _, sf := range df.Subfields {
-			ret += fmt.Sprintf("\t\t\t<marc:subfield code=%q>%s</marc:subfield>\n", sf.GetCode(), html.EscapeString(sf.GetText()))
-		}
-		ret += fmt.Sprintf("\t\t</marc:datafield>\n")
+	var b strings.Builder
+
+	w := NewXMLWriter(&b)
+	w.writeRecord(&rec, 1, false)
+	err = w.Flush()
+	if err != nil {
+		return "", err
 	}
-	ret += "\t</marc:record>\n"
-	return ret, nil
+
+	return b.String(), nil
 }
@@ -0,0 +1,550 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+https://www.loc.gov/marc/makrbrkr.html
+
+    MARCMaker (.mrk) files contain one field per line, each line
+    beginning with an equals sign, the tag (or LDR for the leader) and
+    two spaces:
+
+        =LDR  00000nam\\2200000\a\4500
+        =001  ocm12345
+        =245  10$aTitle :$bsubtitle /$cstatement.
+
+    Records are separated by a blank line. Blanks in the leader,
+    controlfields and indicators are represented by a backslash, and
+    the subfield delimiter by a dollar sign. Characters that would
+    otherwise be ambiguous, and the non-ASCII characters of the ANSEL
+    character set, are represented by mnemonics in curly braces, such
+    as {dollar}, {bsol} and {acute}.
+*/
+
+// ErrBadMRKLine indicates that a line of a MARCMaker file cannot be
+// parsed
+var ErrBadMRKLine = errors.New("malformed MARCMaker line")
+
+// mrkMnemonics maps the MARCMaker character mnemonics to the MARC-8
+// byte that they represent. For Unicode records the byte is converted
+// to the equivalent Unicode character.
+var mrkMnemonics = map[string]byte{
+	"esc":      0x1B,
+	"dollar":   0x24,
+	"bsol":     0x5C,
+	"lcub":     0x7B,
+	"rcub":     0x7D,
+	"joiner":   0x8D,
+	"nonjoin":  0x8E,
+	"Lstrok":   0xA1,
+	"Ostrok":   0xA2,
+	"Dstrok":   0xA3,
+	"THORN":    0xA4,
+	"AElig":    0xA5,
+	"OElig":    0xA6,
+	"softsign": 0xA7,
+	"middot":   0xA8,
+	"flat":     0xA9,
+	"reg":      0xAA,
+	"plusmn":   0xAB,
+	"Ohorn":    0xAC,
+	"Uhorn":    0xAD,
+	"mlrhring": 0xAE,
+	"mllhring": 0xB0,
+	"lstrok":   0xB1,
+	"ostrok":   0xB2,
+	"dstrok":   0xB3,
+	"thorn":    0xB4,
+	"aelig":    0xB5,
+	"oelig":    0xB6,
+	"hardsign": 0xB7,
+	"inodot":   0xB8,
+	"pound":    0xB9,
+	"eth":      0xBA,
+	"ohorn":    0xBC,
+	"uhorn":    0xBD,
+	"deg":      0xC0,
+	"scriptl":  0xC1,
+	"phono":    0xC2,
+	"copy":     0xC3,
+	"sharp":    0xC4,
+	"iquest":   0xC5,
+	"iexcl":    0xC6,
+	"eszett":   0xC7,
+	"euro":     0xC8,
+	"hooka":    0xE0,
+	"grave":    0xE1,
+	"acute":    0xE2,
+	"circ":     0xE3,
+	"tilde":    0xE4,
+	"macr":     0xE5,
+	"breve":    0xE6,
+	"dot":      0xE7,
+	"uml":      0xE8,
+	"caron":    0xE9,
+	"ring":     0xEA,
+	"llig":     0xEB,
+	"rlig":     0xEC,
+	"rcommaa":  0xED,
+	"dblac":    0xEE,
+	"candra":   0xEF,
+	"cedil":    0xF0,
+	"ogon":     0xF1,
+	"dotb":     0xF2,
+	"dbldotb":  0xF3,
+	"ringb":    0xF4,
+	"dblunder": 0xF5,
+	"under":    0xF6,
+	"commab":   0xF7,
+	"rcedil":   0xF8,
+	"breveb":   0xF9,
+	"ldbltil":  0xFA,
+	"rdbltil":  0xFB,
+	"commaa":   0xFE,
+}
+
+// mrkNames maps MARC-8 bytes back to their mnemonics, for writing
+var mrkNames = func() map[byte]string {
+	m := make(map[byte]string, len(mrkMnemonics))
+	for name, b := range mrkMnemonics {
+		m[b] = name
+	}
+	return m
+}()
+
+// mrkMarkNames maps the Unicode combining marks back to their
+// mnemonics, for writing Unicode records
+var mrkMarkNames = func() map[rune]string {
+	m := make(map[rune]string)
+	for b, name := range mrkNames {
+		if r := mnemonicRune(b); isCombining(r) {
+			m[r] = name
+		}
+	}
+	return m
+}()
+
+// isCombining returns true for the combining marks that are written
+// before the character they modify in MARC-8 and MARCMaker
+func isCombining(r rune) bool {
+	return unicode.Is(unicode.Mn, r)
+}
+
+// mnemonicRune returns the Unicode character for a MARC-8 byte
+// represented by a mnemonic
+func mnemonicRune(b byte) rune {
+	if b < 0x80 {
+		return rune(b)
+	}
+	if r, ok := marc8C1[b]; ok {
+		return r
+	}
+	return extendedLatin[b&0x7F]
+}
+
+// MRKReader reads MARC records from a MARCMaker (.mrk) file. It is used
+// in the same manner as the Reader:
+//
+//	rdr := marc21.NewMRKReader(f)
+//	for rdr.Next() {
+//		rec := rdr.Record()
+//		...
+//	}
+//	if err := rdr.Err(); err != nil {
+//		...
+//	}
+//
+// The mnemonics are resolved according to the character coding scheme
+// of the record (Leader/09): for Unicode records they become the
+// equivalent Unicode character and for MARC-8 records they become the
+// MARC-8 byte. As in MARC-8, the mnemonics for combining marks are
+// written before the character they modify; in Unicode records they are
+// moved after it. In addition to the LoC mnemonics {U+XXXX} may be
+// used for any Unicode character and {xXX} for any byte.
+type MRKReader struct {
+	r      *bufio.Reader
+	rec    *Record
+	err    error
+	index  int
+	offset int64
+}
+
+// NewMRKReader returns a new MRKReader that reads MARCMaker records
+// from r
+func NewMRKReader(r io.Reader) *MRKReader {
+	return &MRKReader{
+		r:     bufio.NewReader(r),
+		index: -1,
+	}
+}
+
+// Next advances the MRKReader to the next record, which will then be
+// available through the Record method. It returns false when there are
+// no more records, either by reaching the end of the input or due to an
+// error.
+func (r *MRKReader) Next() bool {
+
+	if r.err != nil {
+		return false
+	}
+
+	r.rec = nil
+
+	// Gather the lines of the record, joining any continuation lines
+	var lines []string
+	var offsets []int64
+	for {
+		offset := r.offset
+		line, err := r.r.ReadString('\n')
+		r.offset += int64(len(line))
+		if err != nil && err != io.EOF {
+			r.err = err
+			return false
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.TrimSpace(line) == "":
+			// A blank line ends the record
+		case strings.HasPrefix(line, "="):
+			lines = append(lines, line)
+			offsets = append(offsets, offset)
+		case len(lines) > 0:
+			lines[len(lines)-1] += line
+		default:
+			r.err = &ParseError{Record: r.index + 1, Offset: offset, Err: ErrBadMRKLine}
+			return false
+		}
+
+		if err == io.EOF || (len(lines) > 0 && strings.TrimSpace(line) == "") {
+			break
+		}
+	}
+
+	if len(lines) == 0 {
+		return false
+	}
+
+	rec := new(Record)
+	for i, line := range lines {
+		err := parseMRKLine(rec, line, i+1)
+		if err != nil {
+			err.(*ParseError).Record = r.index + 1
+			err.(*ParseError).Offset = offsets[i]
+			r.err = err
+			return false
+		}
+	}
+
+	r.rec = rec
+	r.index++
+	return true
+}
+
+// parseMRKLine parses a single line of a MARCMaker record and adds the
+// field to the record
+func parseMRKLine(rec *Record, line string, seq int) error {
+
+	// =TAG  data
+	if len(line) < 6 || line[4:6] != "  " {
+		return &ParseError{Err: ErrBadMRKLine}
+	}
+	tag := line[1:4]
+	data := line[6:]
+
+	unicode := len(rec.Leader.Text) < leaderLen || rec.Leader.Text[9] == 'a'
+
+	switch {
+	case tag == "LDR":
+		rec.Leader.Text = decodeMRK(strings.Replace(data, `\`, " ", -1), true)
+
+	case strings.HasPrefix(tag, "00"):
+		text := decodeMRK(strings.Replace(data, `\`, " ", -1), unicode)
+		rec.Controlfields = append(rec.Controlfields, &Controlfield{Tag: tag, Text: text, seq: seq})
+
+	default:
+		if len(data) < 2 {
+			return &ParseError{Tag: tag, Err: ErrBadMRKLine}
+		}
+		df := &Datafield{
+			Tag:  tag,
+			Ind1: strings.Replace(data[0:1], `\`, " ", 1),
+			Ind2: strings.Replace(data[1:2], `\`, " ", 1),
+			seq:  seq,
+		}
+
+		subs := strings.Split(data[2:], "$")
+		if subs[0] != "" {
+			return &ParseError{Tag: tag, Err: ErrBadMRKLine}
+		}
+		for _, s := range subs[1:] {
+			if s == "" {
+				continue
+			}
+			code, size := utf8.DecodeRuneInString(s)
+			df.Subfields = append(df.Subfields, &Subfield{
+				Code: string(code),
+				Text: decodeMRK(s[size:], unicode),
+			})
+		}
+
+		rec.Datafields = append(rec.Datafields, df)
+	}
+
+	return nil
+}
+
+// decodeMRK replaces the character mnemonics in a string
+func decodeMRK(s string, unicode bool) string {
+
+	if !strings.Contains(s, "{") {
+		return s
+	}
+
+	var b strings.Builder
+
+	// Combining marks waiting for the character they modify
+	var pending []rune
+	write := func(t string) {
+		if len(pending) > 0 && t != "" {
+			_, size := utf8.DecodeRuneInString(t)
+			b.WriteString(t[:size])
+			for _, r := range pending {
+				b.WriteRune(r)
+			}
+			pending = nil
+			t = t[size:]
+		}
+		b.WriteString(t)
+	}
+
+	for {
+		i := strings.IndexByte(s, '{')
+		if i < 0 {
+			break
+		}
+		j := strings.IndexByte(s[i:], '}')
+		if j < 0 {
+			break
+		}
+		write(s[:i])
+
+		t, ok := mnemonicText(s[i+1:i+j], unicode)
+		switch {
+		case !ok:
+			// Not a mnemonic, keep as is
+			write(s[i : i+j+1])
+		case unicode && utf8.RuneCountInString(t) == 1 && isCombining([]rune(t)[0]):
+			pending = append(pending, []rune(t)[0])
+		default:
+			write(t)
+		}
+		s = s[i+j+1:]
+	}
+	write(s)
+
+	// Marks with nothing to modify
+	for _, r := range pending {
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// mnemonicText returns the character(s) for a mnemonic, returning false
+// if the name is not a recognized mnemonic
+func mnemonicText(name string, unicode bool) (string, bool) {
+
+	if c, ok := mrkMnemonics[name]; ok {
+		if unicode {
+			return string(mnemonicRune(c)), true
+		}
+		return string([]byte{c}), true
+	}
+
+	switch {
+	case strings.HasPrefix(name, "U+") && len(name) > 2:
+		n, err := strconv.ParseUint(name[2:], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(n)) {
+			return "", false
+		}
+		if unicode {
+			return string(rune(n)), true
+		}
+		s, _ := UTF8ToMARC8(string(rune(n)))
+		return s, true
+
+	case strings.HasPrefix(name, "x") && len(name) == 3:
+		n, err := strconv.ParseUint(name[1:], 16, 8)
+		if err != nil {
+			return "", false
+		}
+		return string([]byte{byte(n)}), true
+	}
+
+	return "", false
+}
+
+// Record returns the current record
+func (r *MRKReader) Record() *Record {
+	return r.rec
+}
+
+// Err returns the first error that was encountered by the MRKReader.
+// The end of the input is not considered an error.
+func (r *MRKReader) Err() error {
+	return r.err
+}
+
+// Index returns the zero-based position of the current record in the
+// input
+func (r *MRKReader) Index() int {
+	return r.index
+}
+
+// MRKWriter writes MARC records as a MARCMaker (.mrk) file. Unicode
+// records are written as UTF-8, with only the characters having a
+// special meaning in MARCMaker (and any control characters) written as
+// mnemonics. MARC-8 records are written as ASCII, with the ANSEL
+// characters written as mnemonics and any other bytes written as {xXX},
+// so that the records may be read back without loss.
+type MRKWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// NewMRKWriter returns a new MRKWriter that writes to w
+func NewMRKWriter(w io.Writer) *MRKWriter {
+	return &MRKWriter{w: bufio.NewWriter(w)}
+}
+
+// Write writes a record, followed by a blank line
+func (w *MRKWriter) Write(rec *Record) error {
+	if w.err != nil {
+		return w.err
+	}
+	_, w.err = w.w.WriteString(rec.AsMRK() + "\n")
+	return w.err
+}
+
+// Flush writes any buffered data to the underlying io.Writer
+func (w *MRKWriter) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = w.w.Flush()
+	return w.err
+}
+
+// AsMRK converts a record to MARCMaker format
+func (rec Record) AsMRK() string {
+
+	var b strings.Builder
+
+	unicode := len(rec.Leader.Text) < leaderLen || rec.Leader.Text[9] == 'a'
+
+	fmt.Fprintf(&b, "=LDR  %s\n", blanksToMRK(encodeMRK(rec.Leader.GetText(), false)))
+
+	for _, f := range rec.fieldOrder() {
+		if f.control {
+			cf := rec.Controlfields[f.index]
+			fmt.Fprintf(&b, "=%s  %s\n", cf.GetTag(), blanksToMRK(encodeMRK(cf.GetText(), unicode)))
+			continue
+		}
+
+		df := rec.Datafields[f.index]
+		fmt.Fprintf(&b, "=%s  %s%s", df.GetTag(), blanksToMRK(df.GetInd1()), blanksToMRK(df.GetInd2()))
+		for _, sf := range df.Subfields {
+			b.WriteString("$" + encodeMRK(sf.GetCode(), unicode) + encodeMRK(sf.GetText(), unicode))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// blanksToMRK replaces the blanks in fixed length data with backslashes
+func blanksToMRK(s string) string {
+	return strings.Replace(s, " ", `\`, -1)
+}
+
+// encodeMRK replaces the characters that require mnemonics in a string.
+// For Unicode records the combining marks that follow a character are
+// written (as mnemonics) before it, as they are for MARC-8.
+func encodeMRK(s string, unicode bool) string {
+
+	var b strings.Builder
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		if unicode {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			j := i + size
+			for j < len(s) {
+				r, n := utf8.DecodeRuneInString(s[j:])
+				if !isCombining(r) {
+					break
+				}
+				if name, ok := mrkMarkNames[r]; ok {
+					b.WriteString("{" + name + "}")
+				} else {
+					fmt.Fprintf(&b, "{U+%04X}", r)
+				}
+				j += n
+			}
+			if j > i+size {
+				// Write the character, then skip the marks
+				b.WriteString(encodeMRK(s[i:i+size], unicode))
+				i = j
+				continue
+			}
+		}
+
+		switch {
+		case c == '$' || c == '\\' || c == '{' || c == '}' || c == 0x1B:
+			b.WriteString("{" + mrkNames[c] + "}")
+			i++
+		case c < 0x20 || c == 0x7F:
+			if unicode {
+				fmt.Fprintf(&b, "{U+%04X}", c)
+			} else {
+				fmt.Fprintf(&b, "{x%02X}", c)
+			}
+			i++
+		case c < 0x80:
+			b.WriteByte(c)
+			i++
+		case unicode:
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				fmt.Fprintf(&b, "{x%02X}", c)
+			} else {
+				b.WriteString(s[i : i+size])
+			}
+			i += size
+		default:
+			if name, ok := mrkNames[c]; ok {
+				b.WriteString("{" + name + "}")
+			} else {
+				fmt.Fprintf(&b, "{x%02X}", c)
+			}
+			i++
+		}
+	}
+
+	return b.String()
+}
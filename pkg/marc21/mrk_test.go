@@ -0,0 +1,136 @@
+package marc21
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMRKRoundTrip(t *testing.T) {
+
+	utf8Rec := newTestRecord("1")
+	utf8Rec.Leader.Text = "00000nam a2200000 a 4500"
+	utf8Rec.Datafields[1].Subfields[0].Text = `Prices in $ {and} \ Café \x01`
+
+	marc8Rec := newTestRecord("2")
+	marc8Rec.Leader.Text = "00000nam  2200000 a 4500"
+	marc8Rec.Datafields[1].Subfields[0].Text = "Caf\xe2e \x1b(2Hebrew\x1bs \x80"
+
+	var b bytes.Buffer
+	w := NewMRKWriter(&b)
+	for _, rec := range []*Record{utf8Rec, marc8Rec} {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("Write() failed: %q", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() failed: %q", err)
+	}
+
+	out := b.String()
+	for _, s := range []string{
+		"=LDR  00000nam\\a2200000\\a\\4500\n",
+		"=008  180115s2017\\\\\\\\nyu",
+		"=100  1\\$aSmith, John.\n",
+		"$aPrices in {dollar} {lcub}and{rcub} {bsol} Café {bsol}x01$b",
+		"$aCaf{acute}e {esc}(2Hebrew{esc}s {x80}$b",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("AsMRK() output is missing %q:\n%s", s, out)
+		}
+	}
+
+	rdr := NewMRKReader(&b)
+	var recs []*Record
+	for rdr.Next() {
+		recs = append(recs, rdr.Record())
+	}
+	if rdr.Err() != nil {
+		t.Fatalf("Err() = %q", rdr.Err())
+	}
+	if len(recs) != 2 {
+		t.Fatalf("read %d records", len(recs))
+	}
+	if recs[0].String() != utf8Rec.String() {
+		t.Errorf("read %v, expected %v", recs[0], utf8Rec)
+	}
+	if recs[1].String() != marc8Rec.String() {
+		t.Errorf("read %v, expected %v", recs[1], marc8Rec)
+	}
+}
+
+func TestMRKCombiningMarks(t *testing.T) {
+
+	tests := []struct {
+		name string
+		text string
+		mrk  string
+	}{
+		{"Precomposed", "Café", "Café"},
+		{"Decomposed", "Cafe\u0301", "Caf{acute}e"},
+		{"Multiple marks", "a\u0302\u0301b", "{circ}{acute}ab"},
+		{"Mark without mnemonic", "o\u0308\u031bx", "{uml}{U+031B}ox"},
+	}
+
+	for _, tc := range tests {
+		rec := newTestRecord("1")
+		rec.Datafields[1].Subfields[0].Text = tc.text
+
+		out := rec.AsMRK()
+		if !strings.Contains(out, "$a"+tc.mrk+"$b") {
+			t.Errorf("%s: AsMRK() = %q, expected %q", tc.name, out, tc.mrk)
+		}
+
+		rdr := NewMRKReader(strings.NewReader(out))
+		if !rdr.Next() {
+			t.Fatalf("%s: Next() failed: %v", tc.name, rdr.Err())
+		}
+		if got := rdr.Record().Datafields[1].Subfields[0].Text; got != tc.text {
+			t.Errorf("%s: read %q, expected %q", tc.name, got, tc.text)
+		}
+	}
+}
+
+func TestMRKReader(t *testing.T) {
+
+	mrk := "\r\n=LDR  00000nam\\\\2200000\\a\\4500\r\n" +
+		"=001  1\r\n" +
+		"=245  10$aA long title that has been{dollar}\r\n" +
+		"  continued on a second line$c{copy}1999, Fran{cedil}cois.\r\n" +
+		"\r\n" +
+		"\r\n" +
+		"=LDR  00000nam\\a2200000\\a\\4500\n" +
+		"=001  2\n" +
+		"=245  10$aFran{cedil}cois {U+00E9} {unknown}\n"
+
+	rdr := NewMRKReader(strings.NewReader(mrk))
+
+	expected := []string{
+		"A long title that has been$  continued on a second line|\xc31999, Fran\xf0cois.",
+		"Franc\u0327ois \u00e9 {unknown}",
+	}
+
+	i := 0
+	for rdr.Next() {
+		var s []string
+		for _, sf := range rdr.Record().GetDatafields("245")[0].Subfields {
+			s = append(s, sf.Text)
+		}
+		if i < len(expected) && strings.Join(s, "|") != expected[i] {
+			t.Errorf("record %d: read %q, expected %q", i, strings.Join(s, "|"), expected[i])
+		}
+		i++
+	}
+	if rdr.Err() != nil || i != 2 {
+		t.Errorf("read %d records, Err() = %v", i, rdr.Err())
+	}
+
+	rdr = NewMRKReader(strings.NewReader("=LDR  00000nam\\a2200000\\a\\4500\n=245\n"))
+	if rdr.Next() {
+		t.Errorf("Next() succeeded on a malformed line")
+	}
+	pe, ok := rdr.Err().(*ParseError)
+	if !ok || pe.Offset != 31 {
+		t.Errorf("Err() = %v", rdr.Err())
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+// The controlfields and datafields of a record are held in separate
+// slices, while the source record may interleave them (the directory
+// of a MARC record need not list the control fields first). So that
+// the original order can be reproduced when the record is written, the
+// readers record the position of each field in the source record
+// (its seq). When writing, the two slices are merged using these
+// positions. Fields that have no position (those added after reading)
+// follow the field that precedes them in their slice (or precede the
+// first field, if there is no such field), so the order of each slice
+// is always respected.
+
+// fieldPos identifies a field of a record by slice and index
+type fieldPos struct {
+	control bool
+	index   int
+}
+
+// fieldOrder returns the order in which the fields of the record are
+// to be written
+func (rec Record) fieldOrder() []fieldPos {
+
+	cfKeys := make([]int, len(rec.Controlfields))
+	for i, cf := range rec.Controlfields {
+		cfKeys[i] = cf.seq
+	}
+	dfKeys := make([]int, len(rec.Datafields))
+	for i, df := range rec.Datafields {
+		dfKeys[i] = df.seq
+	}
+
+	return mergeOrder(cfKeys, dfKeys)
+}
+
+// mergeOrder merges the controlfield and datafield positions into a
+// single order
+func mergeOrder(cfKeys, dfKeys []int) []fieldPos {
+
+	inheritKeys(cfKeys)
+	inheritKeys(dfKeys)
+
+	order := make([]fieldPos, 0, len(cfKeys)+len(dfKeys))
+
+	i, j := 0, 0
+	for i < len(cfKeys) || j < len(dfKeys) {
+		if j >= len(dfKeys) || (i < len(cfKeys) && cfKeys[i] <= dfKeys[j]) {
+			order = append(order, fieldPos{control: true, index: i})
+			i++
+		} else {
+			order = append(order, fieldPos{index: j})
+			j++
+		}
+	}
+
+	return order
+}
+
+// inheritKeys gives each field that has no position the position of
+// the preceding field. Fields at the start of the slice that have no
+// position take the position of the first field that has one.
+func inheritKeys(keys []int) {
+	first := 0
+	for _, k := range keys {
+		if k != 0 {
+			first = k
+			break
+		}
+	}
+	prev := first
+	for i, k := range keys {
+		if k == 0 {
+			keys[i] = prev
+		}
+		prev = keys[i]
+	}
+}
@@ -0,0 +1,114 @@
+package marc21
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFieldOrder(t *testing.T) {
+
+	// A record having a datafield between the controlfields
+	var rec Record
+	err := rec.UnmarshalJSON([]byte(`{"leader":"00000nam a2200000 a 4500","fields":[` +
+		`{"001":"1"},` +
+		`{"100":{"ind1":"1","ind2":" ","subfields":[{"a":"Smith, John."}]}},` +
+		`{"008":"180115s2017    nyu           000 0 eng d"},` +
+		`{"245":{"ind1":"1","ind2":"0","subfields":[{"a":"A title"}]}}` +
+		`]}`))
+	if err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %q", err)
+	}
+
+	marc, err := rec.RecordAsMARC()
+	if err != nil {
+		t.Fatalf("RecordAsMARC() failed: %q", err)
+	}
+
+	parsed, err := ParseRecord(marc)
+	if err != nil {
+		t.Fatalf("ParseRecord() failed: %q", err)
+	}
+	again, err := parsed.RecordAsMARC()
+	if err != nil {
+		t.Fatalf("RecordAsMARC() failed: %q", err)
+	}
+	if string(again) != string(marc) {
+		t.Errorf("round trip changed the record:\n%q\n%q", marc, again)
+	}
+
+	// Fields added after reading follow their predecessor in the slice
+	parsed.Controlfields = append(parsed.Controlfields, &Controlfield{Tag: "009", Text: "x"})
+	parsed.Datafields = append(parsed.Datafields, &Datafield{Tag: "500", Ind1: " ", Ind2: " "})
+
+	var tags []string
+	for _, p := range parsed.fieldOrder() {
+		if p.control {
+			tags = append(tags, parsed.Controlfields[p.index].Tag)
+		} else {
+			tags = append(tags, parsed.Datafields[p.index].Tag)
+		}
+	}
+	expected := "001 100 008 009 245 500"
+	if strings.Join(tags, " ") != expected {
+		t.Errorf("fieldOrder() = %q, expected %q", strings.Join(tags, " "), expected)
+	}
+}
+
+func TestFieldOrderXML(t *testing.T) {
+
+	doc := `<collection xmlns="http://www.loc.gov/MARC21/slim"><record>` +
+		`<leader>00000nam a2200000 a 4500</leader>` +
+		`<controlfield tag="001">1</controlfield>` +
+		`<datafield tag="100" ind1="1" ind2=" "><subfield code="a">Smith, John.</subfield></datafield>` +
+		`<controlfield tag="008">180115s2017    nyu           000 0 eng d</controlfield>` +
+		`<datafield tag="245" ind1="1" ind2="0"><subfield code="a">A title</subfield></datafield>` +
+		`</record></collection>`
+	expected := "001 100 008 245"
+
+	tagOrder := func(rec *Record) string {
+		var tags []string
+		for _, p := range rec.fieldOrder() {
+			if p.control {
+				tags = append(tags, rec.Controlfields[p.index].Tag)
+			} else {
+				tags = append(tags, rec.Datafields[p.index].Tag)
+			}
+		}
+		return strings.Join(tags, " ")
+	}
+
+	rdr := NewXMLReader(strings.NewReader(doc))
+	if !rdr.Next() {
+		t.Fatalf("Next() failed: %v", rdr.Err())
+	}
+	rec := rdr.Record()
+
+	// XML to XML
+	out, err := Collection{Records: []*Record{rec}}.AsXML()
+	if err != nil {
+		t.Fatalf("AsXML() failed: %q", err)
+	}
+	c, err := ReadXML(strings.NewReader(out))
+	if err != nil {
+		t.Fatalf("ReadXML() failed: %q", err)
+	}
+	if len(c.Records) != 1 {
+		t.Fatalf("ReadXML() read %d records", len(c.Records))
+	}
+	if got := tagOrder(c.Records[0]); got != expected {
+		t.Errorf("XML round trip order = %q, expected %q", got, expected)
+	}
+
+	// XML to binary
+	marc, err := rec.RecordAsMARC()
+	if err != nil {
+		t.Fatalf("RecordAsMARC() failed: %q", err)
+	}
+	parsed, err := ParseRecord(marc)
+	if err != nil {
+		t.Fatalf("ParseRecord() failed: %q", err)
+	}
+	if got := tagOrder(parsed); got != expected {
+		t.Errorf("XML to MARC order = %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ParseMode determines how the parser responds to structural problems
+// in a MARC record
+type ParseMode int
+
+const (
+	// Strict rejects records that have structural problems in the
+	// directory or datafields. Controlfields that are not properly
+	// terminated are dropped and reported as warnings.
+	Strict ParseMode = iota
+	// Lenient recovers what it can from records that have structural
+	// problems and reports each problem as a warning
+	Lenient
+)
+
+// ParseOptions controls the parsing of MARC records
+type ParseOptions struct {
+	// Mode is the strict/lenient mode for the parser
+	Mode ParseMode
+	// ConvertMARC8 indicates that MARC-8 encoded records are to be
+	// converted to UTF-8
+	ConvertMARC8 bool
+	// KeepRaw indicates that the record is to retain the bytes it was
+	// parsed from, so that it may be written unchanged if it is not
+	// modified (see Record.Dirty). The record refers to the bytes
+	// rather than copying them, so they should not be modified. MARC-8
+	// records that are converted to UTF-8 (see ConvertMARC8) do not
+	// retain their bytes, as they no longer match the record.
+	KeepRaw bool
+}
+
+// ParseWarning describes a structural problem in a MARC record that was
+// recovered from (or ignored) during parsing
+type ParseWarning struct {
+	// Tag is the tag of the field having the problem, if known
+	Tag string
+	// Entry is the zero-based index of the directory entry for the
+	// field, or -1 if the problem is not with a specific entry
+	Entry int
+	// Offset is the byte offset of the problem within the record
+	Offset int
+	// Err is the kind of problem, one of the causes of a ParseError
+	// (such as ErrFieldOutOfBounds) or ErrRepeatedControlNumber. Use
+	// errors.Is to test for it.
+	Err error
+	// Message describes the problem
+	Message string
+}
+
+// Implement the Stringer interface for "Pretty-printing"
+func (w ParseWarning) String() string {
+	if w.Tag == "" {
+		return fmt.Sprintf("offset %d: %s", w.Offset, w.Message)
+	}
+	return fmt.Sprintf("%s (entry %d, offset %d): %s", w.Tag, w.Entry, w.Offset, w.Message)
+}
+
+// parseState carries the options and accumulated warnings through the
+// parsing of a single record
+type parseState struct {
+	opts     ParseOptions
+	warnings []ParseWarning
+}
+
+// lenient indicates whether the parser is to recover from problems
+func (ps *parseState) lenient() bool {
+	return ps.opts.Mode == Lenient
+}
+
+// warn adds a warning of the specified kind to the parse results
+func (ps *parseState) warn(kind error, tag string, entry, offset int, format string, a ...interface{}) {
+	ps.warnings = append(ps.warnings, ParseWarning{
+		Tag:     tag,
+		Entry:   entry,
+		Offset:  offset,
+		Err:     kind,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// ParseRecordWithOptions takes the bytes for a MARC record and returns
+// the parsed record structure. Any problems that were recovered from
+// are available in the Warnings of the record.
+func ParseRecordWithOptions(rawRec []byte, opts ParseOptions) (rec *Record, err error) {
+
+	if len(rawRec) <= leaderLen {
+		return nil, newParseError(0, "", ErrRecordTooShort)
+	}
+
+	ps := &parseState{opts: opts}
+
+	rec = new(Record)
+
+	rec.Leader.Text = string(rawRec[:24])
+
+	dir, err := parseDirectory(rawRec, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	baseDataAddress, err := toInt(rawRec[12:17])
+	if err == nil && (baseDataAddress <= leaderLen || baseDataAddress > len(rawRec)) {
+		err = ErrBadBaseAddress
+	}
+	if err != nil {
+		if !ps.lenient() {
+			return nil, newParseError(12, "", err)
+		}
+		// The data starts immediately after the directory
+		baseDataAddress = leaderLen + bytes.IndexByte(rawRec[leaderLen:], fieldTerminator) + 1
+		ps.warn(ErrBadBaseAddress, "", -1, 12, "invalid base address of data %q, using %d", rawRec[12:17], baseDataAddress)
+	}
+
+	rec.Controlfields, err = extractControlfields(rawRec, baseDataAddress, dir, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.Datafields, err = extractDatafields(rawRec, baseDataAddress, dir, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.Warnings = ps.warnings
+
+	converted := false
+	if opts.ConvertMARC8 {
+		code, _ := rec.CharacterCodingScheme()
+		converted = code != "a"
+		err = rec.ConvertToUTF8()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.KeepRaw && len(rec.Warnings) == 0 && !converted {
+		rec.keepRaw(rawRec)
+	}
+
+	return rec, nil
+}
@@ -0,0 +1,149 @@
+package marc21
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// setFieldLength overwrites the field length of a directory entry
+// in the raw record
+func setFieldLength(raw []byte, entry, length int) {
+	i := leaderLen + 12*entry
+	copy(raw[i+3:i+7], fmt.Sprintf("%04d", length))
+}
+
+func TestParseModes(t *testing.T) {
+
+	raw := newTestMARC(t, "1")
+
+	// Shorten the 100 field (entry 2) so that it is missing the field
+	// terminator
+	i := leaderLen + 12*2
+	length, _ := toInt(raw[i+3 : i+7])
+	setFieldLength(raw, 2, length-1)
+
+	_, err := ParseRecord(raw)
+	if err == nil {
+		t.Errorf("ParseRecord() succeeded on a bad record")
+	}
+
+	rec, err := ParseRecordWithOptions(raw, ParseOptions{Mode: Lenient})
+	if err != nil {
+		t.Fatalf("ParseRecordWithOptions(Lenient) failed: %q", err)
+	}
+
+	if len(rec.Warnings) != 1 {
+		t.Fatalf("ParseRecordWithOptions(Lenient) returned warnings %v", rec.Warnings)
+	}
+	w := rec.Warnings[0]
+	if w.Tag != "100" || w.Entry != 2 || !errors.Is(w.Err, ErrNoFieldTerminator) {
+		t.Errorf("ParseRecordWithOptions(Lenient) returned warning %v", w)
+	}
+
+	dfs := rec.GetDatafields("100")
+	if len(dfs) != 1 || dfs[0].GetSubfields("a")[0].Text != "Smith, John." {
+		t.Errorf("ParseRecordWithOptions(Lenient) did not recover the 100 field")
+	}
+	if len(rec.GetDatafields("245")) != 1 {
+		t.Errorf("ParseRecordWithOptions(Lenient) did not recover the 245 field")
+	}
+}
+
+func TestParseControlfieldWarning(t *testing.T) {
+
+	raw := newTestMARC(t, "1")
+
+	// Lengthen the 001 field (entry 0) so that it overlaps the 008
+	length, _ := toInt(raw[leaderLen+3 : leaderLen+7])
+	setFieldLength(raw, 0, length+1)
+
+	rec, err := ParseRecord(raw)
+	if err != nil {
+		t.Fatalf("ParseRecord() failed: %q", err)
+	}
+
+	if len(rec.Warnings) != 1 || rec.Warnings[0].Tag != "001" || !errors.Is(rec.Warnings[0].Err, ErrNoFieldTerminator) {
+		t.Errorf("ParseRecord() returned warnings %v", rec.Warnings)
+	}
+	if rec.GetControlfield("008") == "" {
+		t.Errorf("ParseRecord() did not extract the 008 field")
+	}
+}
+
+func TestParseError(t *testing.T) {
+
+	data := newTestMARC(t, "1", "2", "3")
+	recLen := len(data) / 3
+
+	// Break the 100 field (entry 2) of the second record
+	raw := data[recLen : 2*recLen]
+	i := leaderLen + 12*2
+	length, _ := toInt(raw[i+3 : i+7])
+	setFieldLength(raw, 2, length-1)
+
+	rdr := NewReader(bytes.NewReader(data))
+	for rdr.Next() {
+		if rdr.Record() == nil {
+			break
+		}
+	}
+
+	err := rdr.Err()
+	if !errors.Is(err, ErrNoFieldTerminator) {
+		t.Fatalf("Err() = %v, expected %v", err, ErrNoFieldTerminator)
+	}
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Err() did not return a ParseError")
+	}
+
+	base, _ := toInt(raw[12:17])
+	start, _ := toInt(raw[i+7 : i+12])
+	if pe.Record != 1 || pe.Tag != "100" || pe.Offset != int64(recLen+base+start) {
+		t.Errorf("Err() returned %+v", pe)
+	}
+
+	// Bad record length
+	_, err = NextRecord(bytes.NewReader([]byte("0x123")))
+	if !errors.Is(err, ErrNotInteger) {
+		t.Errorf("NextRecord() = %v, expected %v", err, ErrNotInteger)
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+
+	raw := newTestMARC(t, "1")
+
+	// Point the 100 field (entry 2) past the end of the record
+	bad := append([]byte(nil), raw...)
+	setFieldLength(bad, 2, 9999)
+
+	_, err := ParseRecord(bad)
+	if !errors.Is(err, ErrFieldOutOfBounds) {
+		t.Errorf("ParseRecord() = %v, expected %v", err, ErrFieldOutOfBounds)
+	}
+
+	rec, err := ParseRecordWithOptions(bad, ParseOptions{Mode: Lenient})
+	if err != nil {
+		t.Fatalf("ParseRecordWithOptions(Lenient) failed: %q", err)
+	}
+	if len(rec.GetDatafields("100")) != 0 || len(rec.GetDatafields("245")) != 1 {
+		t.Errorf("ParseRecordWithOptions(Lenient) returned %v", rec)
+	}
+
+	// Base address beyond the end of the record
+	bad = append([]byte(nil), raw...)
+	copy(bad[12:17], "99999")
+	_, err = ParseRecord(bad)
+	if !errors.Is(err, ErrBadBaseAddress) {
+		t.Errorf("ParseRecord() = %v, expected %v", err, ErrBadBaseAddress)
+	}
+
+	_, err = ParseRecord(raw[:leaderLen])
+	if !errors.Is(err, ErrRecordTooShort) {
+		t.Errorf("ParseRecord() = %v, expected %v", err, ErrRecordTooShort)
+	}
+}
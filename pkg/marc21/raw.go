@@ -0,0 +1,101 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+// A record that is parsed with the KeepRaw option retains the bytes it
+// was parsed from along with a hash of its parsed content. As long as
+// the content matches the hash the record is not dirty and the Writer
+// (and RecordAsMARC) emit the original bytes unchanged rather than
+// re-encoding the record. Records that had structural problems (see
+// Warnings) do not retain their raw bytes, as the original would not
+// pass the checks of the Writer, and neither do MARC-8 records that are
+// converted to UTF-8 when parsed (see ConvertMARC8), as they no longer
+// match the original.
+
+// rawRecord holds the original bytes of a parsed record
+type rawRecord struct {
+	data []byte
+	sum  uint64
+}
+
+// keepRaw retains the raw bytes of a record
+func (rec *Record) keepRaw(data []byte) {
+	rec.raw = &rawRecord{data: data, sum: rec.contentHash()}
+}
+
+// Raw returns the bytes that the record was parsed from, or nil if the
+// raw bytes were not kept. The bytes are returned even if the record
+// has since been modified.
+func (rec Record) Raw() []byte {
+	if rec.raw == nil {
+		return nil
+	}
+	return rec.raw.data
+}
+
+// Dirty indicates whether the record has been modified since it was
+// parsed. Records that have no raw bytes are always dirty.
+func (rec Record) Dirty() bool {
+	return rec.raw == nil || rec.contentHash() != rec.raw.sum
+}
+
+// MarkDirty discards the raw bytes of the record, so that it is
+// re-encoded when written
+func (rec *Record) MarkDirty() {
+	rec.raw = nil
+}
+
+// contentHash returns a (64 bit FNV-1a) hash of the content of the
+// record, including the field order. The hash is calculated without
+// copying the content, as it is checked for each record that is written.
+func (rec Record) contentHash() uint64 {
+
+	h := hashString(fnvOffset64, rec.Leader.Text)
+	for _, f := range rec.fieldOrder() {
+		if f.control {
+			cf := rec.Controlfields[f.index]
+			h = hashParts(h, 'c', cf.Tag, cf.Text)
+			continue
+		}
+		df := rec.Datafields[f.index]
+		h = hashParts(h, 'd', df.Tag, df.Ind1, df.Ind2)
+		for _, sf := range df.Subfields {
+			h = hashParts(h, 's', sf.Code, sf.Text)
+		}
+	}
+
+	return h
+}
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// hashParts adds length prefixed strings to the hash so that the
+// boundaries between the parts are unambiguous
+func hashParts(h uint64, kind byte, parts ...string) uint64 {
+	h = hashByte(h, kind)
+	for _, s := range parts {
+		for i := 0; i < 64; i += 8 {
+			h = hashByte(h, byte(uint64(len(s))>>i))
+		}
+		h = hashString(h, s)
+	}
+	return h
+}
+
+// hashString adds the bytes of a string to the hash
+func hashString(h uint64, s string) uint64 {
+	for i := 0; i < len(s); i++ {
+		h = hashByte(h, s[i])
+	}
+	return h
+}
+
+// hashByte adds a byte to the hash
+func hashByte(h uint64, c byte) uint64 {
+	return (h ^ uint64(c)) * fnvPrime64
+}
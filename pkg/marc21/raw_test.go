@@ -0,0 +1,96 @@
+package marc21
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestKeepRaw(t *testing.T) {
+
+	raw := newTestMARC(t, "1")
+
+	rec, err := ParseRecord(raw)
+	if err != nil {
+		t.Fatalf("ParseRecord() failed: %q", err)
+	}
+	if rec.Raw() != nil || !rec.Dirty() {
+		t.Errorf("a record parsed without KeepRaw has raw bytes")
+	}
+
+	rec, err = ParseRecordWithOptions(raw, ParseOptions{KeepRaw: true})
+	if err != nil {
+		t.Fatalf("ParseRecordWithOptions() failed: %q", err)
+	}
+	if !bytes.Equal(rec.Raw(), raw) || rec.Dirty() {
+		t.Fatalf("Raw() = %q, Dirty() = %v", rec.Raw(), rec.Dirty())
+	}
+
+	// The unmodified record is written as the original bytes
+	marc, err := rec.RecordAsMARC()
+	if err != nil {
+		t.Fatalf("RecordAsMARC() failed: %q", err)
+	}
+	if &marc[0] != &raw[0] {
+		t.Errorf("RecordAsMARC() did not return the raw bytes")
+	}
+
+	var b bytes.Buffer
+	w := NewWriter(&b)
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("Write() failed: %q", err)
+	}
+	if !bytes.Equal(b.Bytes(), raw) {
+		t.Errorf("Write() wrote %q, expected %q", b.Bytes(), raw)
+	}
+
+	// Modified records are re-encoded
+	rec.GetDatafields("245")[0].Subfields[0].Text = "Another title :"
+	if !rec.Dirty() {
+		t.Errorf("Dirty() = false after modifying a subfield")
+	}
+	marc, err = rec.RecordAsMARC()
+	if err != nil {
+		t.Fatalf("RecordAsMARC() failed: %q", err)
+	}
+	if !bytes.Contains(marc, []byte("Another title :")) {
+		t.Errorf("RecordAsMARC() = %q", marc)
+	}
+
+	rec.GetDatafields("245")[0].Subfields[0].Text = "A title :"
+	if rec.Dirty() {
+		t.Errorf("Dirty() = true after reverting the modification")
+	}
+	rec.MarkDirty()
+	if rec.Raw() != nil || !rec.Dirty() {
+		t.Errorf("MarkDirty() did not discard the raw bytes")
+	}
+
+	// Converted records are dirty
+	m8 := newTestRecord("2")
+	m8.Leader.Text = "00000nam  2200000 a 4500"
+	m8.Datafields[1].Subfields[0].Text = "Caf\xe2e"
+	raw, err = m8.RecordAsMARC()
+	if err != nil {
+		t.Fatalf("RecordAsMARC() failed: %q", err)
+	}
+	rec, err = ParseRecordWithOptions(raw, ParseOptions{KeepRaw: true, ConvertMARC8: true})
+	if err != nil {
+		t.Fatalf("ParseRecordWithOptions() failed: %q", err)
+	}
+	if rec.Raw() != nil || !rec.Dirty() {
+		t.Errorf("a converted record has raw bytes")
+	}
+
+	// UTF-8 records are not affected by ConvertMARC8
+	raw, err = newTestRecord("3").RecordAsMARC()
+	if err != nil {
+		t.Fatalf("RecordAsMARC() failed: %q", err)
+	}
+	rec, err = ParseRecordWithOptions(raw, ParseOptions{KeepRaw: true, ConvertMARC8: true})
+	if err != nil {
+		t.Fatalf("ParseRecordWithOptions() failed: %q", err)
+	}
+	if !bytes.Equal(rec.Raw(), raw) || rec.Dirty() {
+		t.Errorf("Raw() = %q, Dirty() = %v for a UTF-8 record", rec.Raw(), rec.Dirty())
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"bufio"
+	"io"
+)
+
+// Reader reads MARC records from an io.Reader. It is used in the
+// same manner as a bufio.Scanner:
+//
+//	rdr := marc21.NewReader(f)
+//	for rdr.Next() {
+//		rec := rdr.Record()
+//		...
+//	}
+//	if err := rdr.Err(); err != nil {
+//		...
+//	}
+//
+// Reads are buffered and the full length of each record is read
+// regardless of how the underlying reader chunks the data, so pipes,
+// compressed streams and network connections may be read directly.
+// Stray line endings between records (as inserted by some vendors) are
+// ignored.
+type Reader struct {
+	// ConvertMARC8 indicates that MARC-8 encoded records are to be
+	// converted to UTF-8 when parsed
+	ConvertMARC8 bool
+	// Mode is the strict/lenient mode used when parsing records
+	Mode ParseMode
+	// KeepRaw indicates that parsed records are to retain their raw
+	// bytes, so that unmodified records are written unchanged. This does
+	// not apply to MARC-8 records that are converted (see ConvertMARC8).
+	KeepRaw bool
+	// SkipCorrupt indicates that records that cannot be read or parsed
+	// are to be skipped rather than ending the reading. For records
+	// having a bad length or terminator the Reader scans forward to
+	// the next record terminator and resumes from there. The skipped
+	// data is reported by Skipped. When set, each record is parsed by
+	// Next rather than by Record.
+	SkipCorrupt bool
+	// Quarantine, if set, receives the raw bytes of any data that is
+	// skipped when SkipCorrupt is set
+	Quarantine io.Writer
+
+	r       *bufio.Reader
+	raw     []byte
+	rec     *Record
+	err     error
+	index   int
+	offset  int64
+	next    int64
+	skipped []Skip
+}
+
+// Skip describes a range of the input that was skipped because it
+// could not be read or parsed as a MARC record
+type Skip struct {
+	// Offset is the byte offset of the start of the skipped data
+	Offset int64
+	// Length is the number of bytes skipped
+	Length int64
+	// Err is the reason the data was skipped
+	Err error
+}
+
+// NewReader returns a new Reader that reads MARC records from r
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r:     bufio.NewReaderSize(r, maxRecordSize),
+		index: -1,
+	}
+}
+
+// Next advances the Reader to the next record, which will then be
+// available through the Raw and Record methods. It returns false when
+// there are no more records, either by reaching the end of the input
+// or due to an error.
+func (r *Reader) Next() bool {
+
+	if r.err != nil {
+		return false
+	}
+
+	r.rec = nil
+	r.raw = nil
+	r.skipped = nil
+
+	for {
+		rawRec, err := r.readNext()
+		if err == io.EOF {
+			return false
+		}
+
+		var rec *Record
+		if err == nil && r.SkipCorrupt {
+			rec, err = r.parse(rawRec)
+			if err != nil {
+				start := r.next
+				r.next += int64(len(rawRec))
+				err = r.skip(start, rawRec, locateError(err, r.index+1, start))
+				if err != nil {
+					r.err = err
+					return false
+				}
+				continue
+			}
+		}
+
+		if err != nil {
+			err = locateError(err, r.index+1, r.next)
+			if r.SkipCorrupt {
+				err = r.resync(err)
+				if err == nil {
+					continue
+				}
+			}
+			r.err = err
+			return false
+		}
+
+		r.raw = rawRec
+		r.rec = rec
+		r.index++
+		r.offset = r.next
+		r.next += int64(len(rawRec))
+
+		return true
+	}
+}
+
+// readNext reads the next record from the buffered input. The input is
+// only consumed when a properly framed record is found, so that the
+// Reader can be resynchronized from the start of any bad data.
+func (r *Reader) readNext() (rawRec []byte, err error) {
+
+	// Ignore any stray line endings
+	for {
+		b, err := r.r.Peek(1)
+		if err != nil {
+			return nil, err
+		}
+		if b[0] != '\n' && b[0] != '\r' {
+			break
+		}
+		_, _ = r.r.Discard(1)
+		r.next++
+	}
+
+	b, err := r.r.Peek(5)
+	if err != nil {
+		return nil, newParseError(len(b), "", unexpectedEOF(err))
+	}
+
+	recLen, err := recordLength(b)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = r.r.Peek(recLen)
+	if err != nil {
+		return nil, newParseError(len(b), "", unexpectedEOF(err))
+	}
+
+	if b[recLen-1] != recordTerminator {
+		return nil, newParseError(recLen-1, "", ErrNoRecordTerminator)
+	}
+
+	rawRec = make([]byte, recLen)
+	copy(rawRec, b)
+	_, err = r.r.Discard(recLen)
+
+	return rawRec, err
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF for use when
+// the end of the input is reached part way through a record
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// resync discards the input up to and including the next record
+// terminator and records the skipped data
+func (r *Reader) resync(cause error) error {
+
+	var raw []byte
+	start := r.next
+
+	for {
+		b, err := r.r.ReadSlice(recordTerminator)
+		r.next += int64(len(b))
+		raw = append(raw, b...)
+
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+		break
+	}
+
+	return r.skip(start, raw, cause)
+}
+
+// skip records a range of skipped input and writes the skipped data to
+// the quarantine writer, if there is one
+func (r *Reader) skip(offset int64, raw []byte, cause error) error {
+
+	r.skipped = append(r.skipped, Skip{Offset: offset, Length: int64(len(raw)), Err: cause})
+
+	if r.Quarantine != nil && len(raw) > 0 {
+		_, err := r.Quarantine.Write(raw)
+		return err
+	}
+	return nil
+}
+
+// parse parses a raw record using the options of the Reader
+func (r *Reader) parse(rawRec []byte) (*Record, error) {
+	return ParseRecordWithOptions(rawRec, ParseOptions{Mode: r.Mode, ConvertMARC8: r.ConvertMARC8, KeepRaw: r.KeepRaw})
+}
+
+// Raw returns the unparsed bytes of the current record
+func (r *Reader) Raw() []byte {
+	return r.raw
+}
+
+// Record returns the parsed current record. If the record cannot be
+// parsed then nil is returned, the error is available from Err, and
+// subsequent calls to Next return false.
+func (r *Reader) Record() *Record {
+
+	if r.rec != nil || r.raw == nil {
+		return r.rec
+	}
+
+	rec, err := r.parse(r.raw)
+	if err != nil {
+		r.err = locateError(err, r.index, r.offset)
+		return nil
+	}
+
+	r.rec = rec
+	return r.rec
+}
+
+// Skipped returns the ranges of the input that were skipped when
+// advancing to the current record (or to the end of the input)
+func (r *Reader) Skipped() []Skip {
+	return r.skipped
+}
+
+// Err returns the first error that was encountered by the Reader. The
+// end of the input is not considered an error.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Index returns the zero-based position of the current record in the
+// input. Skipped data is not counted.
+func (r *Reader) Index() int {
+	return r.index
+}
+
+// Offset returns the byte offset of the start of the current record in
+// the input
+func (r *Reader) Offset() int64 {
+	return r.offset
+}
@@ -0,0 +1,166 @@
+package marc21
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+// newTestRecord returns a small, well formed, bibliographic record
+func newTestRecord(id string) *Record {
+	return &Record{
+		Leader: Leader{Text: "00000nam a2200000 a 4500"},
+		Controlfields: []*Controlfield{
+			{Tag: "001", Text: id},
+			{Tag: "008", Text: "180115s2017    nyu           000 0 eng d"},
+		},
+		Datafields: []*Datafield{
+			{Tag: "100", Ind1: "1", Ind2: " ", Subfields: []*Subfield{
+				{Code: "a", Text: "Smith, John."},
+			}},
+			{Tag: "245", Ind1: "1", Ind2: "0", Subfields: []*Subfield{
+				{Code: "a", Text: "A title :"},
+				{Code: "b", Text: "subtitle /"},
+				{Code: "c", Text: "John Smith."},
+			}},
+		},
+	}
+}
+
+// newTestMARC returns the binary MARC for a series of test records
+func newTestMARC(t *testing.T, ids ...string) []byte {
+	var b []byte
+	for _, id := range ids {
+		marc, err := newTestRecord(id).RecordAsMARC()
+		if err != nil {
+			t.Fatalf("RecordAsMARC() failed: %q", err)
+		}
+		b = append(b, marc...)
+	}
+	return b
+}
+
+func TestReaderShortReads(t *testing.T) {
+
+	data := newTestMARC(t, "1", "2", "3")
+
+	readers := map[string]io.Reader{
+		"OneByteReader": iotest.OneByteReader(bytes.NewReader(data)),
+		"HalfReader":    iotest.HalfReader(bytes.NewReader(data)),
+		"DataErrReader": iotest.DataErrReader(bytes.NewReader(data)),
+	}
+
+	for name, r := range readers {
+		rdr := NewReader(r)
+
+		var ids []string
+		var offset int64
+		for rdr.Next() {
+			if rdr.Offset() != offset {
+				t.Errorf("%s: Offset() = %d, expected %d", name, rdr.Offset(), offset)
+			}
+			offset += int64(len(rdr.Raw()))
+
+			rec := rdr.Record()
+			if rec == nil {
+				break
+			}
+			ids = append(ids, rec.GetControlfield("001"))
+		}
+
+		if err := rdr.Err(); err != nil {
+			t.Errorf("%s: Err() = %q", name, err)
+		}
+		if len(ids) != 3 || ids[2] != "3" {
+			t.Errorf("%s: read records %v", name, ids)
+		}
+		if rdr.Index() != 2 {
+			t.Errorf("%s: Index() = %d, expected 2", name, rdr.Index())
+		}
+	}
+}
+
+func TestReaderTruncated(t *testing.T) {
+
+	data := newTestMARC(t, "1", "2")
+	rdr := NewReader(bytes.NewReader(data[:len(data)-10]))
+
+	count := 0
+	for rdr.Next() {
+		count++
+	}
+
+	if count != 1 {
+		t.Errorf("Next() returned %d records, expected 1", count)
+	}
+	if !errors.Is(rdr.Err(), io.ErrUnexpectedEOF) {
+		t.Errorf("Err() = %v, expected %v", rdr.Err(), io.ErrUnexpectedEOF)
+	}
+}
+
+func TestReaderSkipCorrupt(t *testing.T) {
+
+	garbage := []byte("x0123 this is not a MARC record\x1e\x1d")
+
+	bad := newTestMARC(t, "3")
+	// Remove the field terminator from the 100 field (entry 2)
+	i := leaderLen + 12*2
+	length, _ := toInt(bad[i+3 : i+7])
+	setFieldLength(bad, 2, length-1)
+
+	var data []byte
+	data = append(data, newTestMARC(t, "1")...)
+	data = append(data, "\r\n"...)
+	data = append(data, garbage...)
+	data = append(data, newTestMARC(t, "2")...)
+	data = append(data, '\n')
+	data = append(data, bad...)
+	data = append(data, newTestMARC(t, "4")...)
+
+	// Without recovery the reading stops at the garbage
+	rdr := NewReader(bytes.NewReader(data))
+	count := 0
+	for rdr.Next() {
+		count++
+	}
+	if count != 1 || !errors.Is(rdr.Err(), ErrNotInteger) {
+		t.Errorf("Next() read %d records, Err() = %v", count, rdr.Err())
+	}
+
+	var quarantine bytes.Buffer
+	rdr = NewReader(bytes.NewReader(data))
+	rdr.SkipCorrupt = true
+	rdr.Quarantine = &quarantine
+
+	var ids []string
+	var skips []Skip
+	for rdr.Next() {
+		skips = append(skips, rdr.Skipped()...)
+		ids = append(ids, rdr.Record().GetControlfield("001"))
+	}
+	skips = append(skips, rdr.Skipped()...)
+
+	if err := rdr.Err(); err != nil {
+		t.Fatalf("Err() = %q", err)
+	}
+	if len(ids) != 3 || ids[0] != "1" || ids[1] != "2" || ids[2] != "4" {
+		t.Errorf("Next() read records %v", ids)
+	}
+
+	if len(skips) != 2 {
+		t.Fatalf("Skipped() returned %v", skips)
+	}
+	rec1Len := int64(len(newTestMARC(t, "1")))
+	if skips[0].Offset != rec1Len+2 || skips[0].Length != int64(len(garbage)) {
+		t.Errorf("Skipped() returned %+v", skips[0])
+	}
+	if !errors.Is(skips[1].Err, ErrNoFieldTerminator) || skips[1].Length != int64(len(bad)) {
+		t.Errorf("Skipped() returned %+v", skips[1])
+	}
+
+	if !bytes.Equal(quarantine.Bytes(), append(garbage, bad...)) {
+		t.Errorf("Quarantine received %q", quarantine.Bytes())
+	}
+}
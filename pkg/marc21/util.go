@@ -4,11 +4,6 @@
 
 package marc21
 
-import (
-	"errors"
-	"strconv"
-)
-
 // Utility and helper functions
 
 // shortCodeLookup performs lookups on single-character reference tables (maps)
@@ -36,32 +31,19 @@ func pluckByte(b string, i int) (s string) {
 }
 
 // toInt converts a byte array of digits to its corresponding integer
-// value
+// value. Only ASCII digits are accepted (no signs or whitespace) as
+// the lengths and positions in a MARC record are never negative.
 func toInt(b []byte) (ret int, err error) {
-	ret, err = strconv.Atoi(string(b))
-	if err != nil {
 
-		var digits = map[string]int{
-			"0": 0,
-			"1": 1,
-			"2": 2,
-			"3": 3,
-			"4": 4,
-			"5": 5,
-			"6": 6,
-			"7": 7,
-			"8": 8,
-			"9": 9,
-		}
+	if len(b) == 0 {
+		return 0, ErrNotInteger
+	}
 
-		ret = 0
-		for i := range b {
-			x, ok := digits[string(b[i])]
-			if !ok {
-				return 0, errors.New("toInt(): Not an integer")
-			}
-			ret = (10 * ret) + x
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return 0, ErrNotInteger
 		}
+		ret = (10 * ret) + int(c-'0')
 	}
 	return ret, nil
 }
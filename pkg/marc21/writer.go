@@ -0,0 +1,399 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+/*
+https://www.loc.gov/marc/specifications/specrecstruc.html
+
+    The directory entry records the length of each field in four
+    characters and its starting position in five characters, and the
+    leader records the length of the record in five characters. Hence
+    no field may exceed 9999 bytes (including the field terminator) and
+    no record may exceed 99999 bytes.
+*/
+
+// maxFieldSize is the maximum length of a field, including the field
+// terminator
+const maxFieldSize = 9999
+
+// LimitPolicy determines how the Writer handles fields and records that
+// exceed the maximum sizes allowed in a MARC record
+type LimitPolicy int
+
+const (
+	// RejectOversize returns an error for fields and records that are
+	// too long
+	RejectOversize LimitPolicy = iota
+	// TruncateOversize truncates fields that are too long. Records that
+	// are too long are truncated by dropping the trailing datafields.
+	// The fields that were truncated or dropped are reported by the
+	// Truncated method of the Writer.
+	TruncateOversize
+	// SplitOversize splits datafields that are too long into several
+	// fields having the same tag and indicators (splitting between
+	// subfields), and splits records that are too long into several
+	// records, each having the leader and controlfields of the
+	// original. Controlfields and single subfields that are too long
+	// are truncated (and reported as for TruncateOversize).
+	SplitOversize
+)
+
+// Truncation describes a field that was shortened, or a datafield that
+// was dropped, so that a record could be written within the limits of
+// the MARC format
+type Truncation struct {
+	// Tag is the tag of the field
+	Tag string
+	// Length is the length of the field, including the field
+	// terminator, before it was truncated
+	Length int
+	// Dropped indicates that the whole datafield was dropped, rather
+	// than shortened, so that the record is no more than 99999 bytes
+	Dropped bool
+}
+
+// Writer writes MARC records to an io.Writer. Each record is checked
+// before it is written so that the output is always readable:
+//
+//   - the leader must be 24 bytes long
+//   - each tag must be 3 bytes long
+//   - each indicator must be a single byte (an empty indicator is
+//     written as a blank)
+//   - no field may exceed 9999 bytes and no record may exceed 99999
+//     bytes, see Policy
+//
+// Records that fail these checks are not written and a ValidationError
+// is returned. Records that were parsed with KeepRaw and have not been
+// modified are written as the original bytes.
+type Writer struct {
+	// Policy determines how fields and records that are too long are
+	// handled. The default is to reject them.
+	Policy LimitPolicy
+
+	w         io.Writer
+	records   int
+	bytes     int64
+	truncated []Truncation
+	// truncatedRecords is the number of records that were truncated
+	truncatedRecords int
+}
+
+// NewWriter returns a new Writer that writes MARC records to w
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes a record. With the SplitOversize policy a single record
+// may be written as several records. With the TruncateOversize and
+// SplitOversize policies the record may be written with fields
+// truncated or dropped (without an error being returned), use
+// Truncated to find out which.
+func (w *Writer) Write(rec *Record) error {
+
+	recs, truncated, err := encodeRecord(rec, w.Policy)
+	w.truncated = truncated
+	if len(truncated) > 0 && err == nil {
+		w.truncatedRecords++
+	}
+	if err != nil {
+		if ve, ok := err.(*ValidationError); ok {
+			ve.Record = w.records
+		}
+		return err
+	}
+
+	for _, b := range recs {
+		n, err := w.w.Write(b)
+		w.bytes += int64(n)
+		if err != nil {
+			return err
+		}
+		w.records++
+	}
+
+	return nil
+}
+
+// Records returns the number of records written
+func (w *Writer) Records() int {
+	return w.records
+}
+
+// Bytes returns the number of bytes written
+func (w *Writer) Bytes() int64 {
+	return w.bytes
+}
+
+// Truncated returns the fields that were truncated or dropped when
+// writing the last record
+func (w *Writer) Truncated() []Truncation {
+	return w.truncated
+}
+
+// TruncatedRecords returns the number of records that were written with
+// fields truncated or dropped
+func (w *Writer) TruncatedRecords() int {
+	return w.truncatedRecords
+}
+
+// encodedField is a field that has been encoded for writing
+type encodedField struct {
+	tag  string
+	data []byte
+	seq  int
+}
+
+// encodeRecord checks a record against the limits of the MARC format
+// and returns the encoded record(s) and the fields that were truncated
+// to fit
+func encodeRecord(rec *Record, policy LimitPolicy) (recs [][]byte, truncated []Truncation, err error) {
+
+	if !rec.Dirty() {
+		return [][]byte{rec.raw.data}, nil, nil
+	}
+
+	if len(rec.Leader.Text) != leaderLen {
+		return nil, nil, &ValidationError{Record: -1, Err: ErrBadLeader}
+	}
+
+	unicode := rec.Leader.Text[9] == 'a'
+
+	var cfs []encodedField
+	var dfs []encodedField
+
+	// Pack the control fields
+	for _, cf := range rec.Controlfields {
+
+		if cf.GetText() == "" {
+			continue
+		}
+		if len(cf.GetTag()) != 3 {
+			return nil, nil, &ValidationError{Record: -1, Tag: cf.GetTag(), Err: ErrBadTag}
+		}
+
+		b := []byte(cf.GetText())
+		b = append(b, fieldTerminator)
+
+		if len(b) > maxFieldSize {
+			if policy == RejectOversize {
+				return nil, nil, &ValidationError{Record: -1, Tag: cf.GetTag(), Err: ErrFieldTooLong}
+			}
+			truncated = append(truncated, Truncation{Tag: cf.GetTag(), Length: len(b)})
+			b = truncateField(b, maxFieldSize, unicode)
+		}
+
+		cfs = append(cfs, encodedField{tag: cf.GetTag(), data: b, seq: cf.seq})
+	}
+
+	// Pack the data fields/sub-fields
+	for _, df := range rec.Datafields {
+
+		if len(df.GetTag()) != 3 {
+			return nil, nil, &ValidationError{Record: -1, Tag: df.GetTag(), Err: ErrBadTag}
+		}
+		if len(df.GetInd1()) != 1 || len(df.GetInd2()) != 1 {
+			return nil, nil, &ValidationError{Record: -1, Tag: df.GetTag(), Err: ErrBadIndicator}
+		}
+
+		b := encodeDatafield(df, df.Subfields)
+
+		if len(b) > maxFieldSize {
+			switch policy {
+			case RejectOversize:
+				return nil, nil, &ValidationError{Record: -1, Tag: df.GetTag(), Err: ErrFieldTooLong}
+			case TruncateOversize:
+				truncated = append(truncated, Truncation{Tag: df.GetTag(), Length: len(b)})
+				b = truncateField(b, maxFieldSize, unicode)
+			case SplitOversize:
+				fields, length := splitDatafield(df, unicode)
+				if length > 0 {
+					truncated = append(truncated, Truncation{Tag: df.GetTag(), Length: length})
+				}
+				for _, s := range fields {
+					dfs = append(dfs, encodedField{tag: df.GetTag(), data: s, seq: df.seq})
+				}
+				continue
+			}
+		}
+
+		dfs = append(dfs, encodedField{tag: df.GetTag(), data: b, seq: df.seq})
+	}
+
+	// Group the fields into records
+	size := recordSize(cfs)
+	start := 0
+	for i, f := range dfs {
+		fs := 12 + len(f.data)
+		if size+fs <= maxRecordSize {
+			size += fs
+			continue
+		}
+
+		switch policy {
+		case RejectOversize:
+			return nil, nil, &ValidationError{Record: -1, Err: ErrRecordTooLong}
+		case TruncateOversize:
+			for _, d := range dfs[i:] {
+				truncated = append(truncated, Truncation{Tag: d.tag, Length: len(d.data), Dropped: true})
+			}
+			return [][]byte{assembleRecord(rec.Leader.Text, cfs, dfs[:i])}, truncated, nil
+		}
+
+		if i == start {
+			// A single datafield will not fit with the controlfields
+			return nil, nil, &ValidationError{Record: -1, Tag: f.tag, Err: ErrRecordTooLong}
+		}
+		recs = append(recs, assembleRecord(rec.Leader.Text, cfs, dfs[start:i]))
+		start = i
+		size = recordSize(cfs) + fs
+	}
+
+	if size > maxRecordSize {
+		// Only possible when the controlfields alone are too long
+		return nil, nil, &ValidationError{Record: -1, Err: ErrRecordTooLong}
+	}
+	recs = append(recs, assembleRecord(rec.Leader.Text, cfs, dfs[start:]))
+
+	return recs, truncated, nil
+}
+
+// recordSize returns the size of a record containing only the
+// specified fields
+func recordSize(fields []encodedField) int {
+	size := leaderLen + 1 + 1
+	for _, f := range fields {
+		size += 12 + len(f.data)
+	}
+	return size
+}
+
+// encodeDatafield returns the bytes for a datafield having the
+// specified subfields
+func encodeDatafield(df *Datafield, subfields []*Subfield) []byte {
+
+	b := []byte(df.GetInd1())
+	b = append(b, []byte(df.GetInd2())...)
+
+	for _, sf := range subfields {
+		b = append(b, delimiter)
+		b = append(b, []byte(sf.GetCode())...)
+		b = append(b, []byte(sf.GetText())...)
+	}
+	b = append(b, fieldTerminator)
+
+	return b
+}
+
+// splitDatafield splits a datafield that is too long into several
+// fields, splitting between subfields. When the last of the fields
+// has to be truncated (as it consists of a single subfield that is too
+// long) its length before truncation is returned.
+func splitDatafield(df *Datafield, unicode bool) (fields [][]byte, truncated int) {
+
+	var subfields []*Subfield
+	size := 3
+	for _, sf := range df.Subfields {
+		sfs := 1 + len(sf.GetCode()) + len(sf.GetText())
+		if size+sfs > maxFieldSize && len(subfields) > 0 {
+			fields = append(fields, encodeDatafield(df, subfields))
+			subfields = nil
+			size = 3
+		}
+		subfields = append(subfields, sf)
+		size += sfs
+	}
+
+	b := encodeDatafield(df, subfields)
+	if len(b) > maxFieldSize {
+		// A single subfield that is too long
+		truncated = len(b)
+		b = truncateField(b, maxFieldSize, unicode)
+	}
+
+	return append(fields, b), truncated
+}
+
+// truncateField truncates an encoded field to the specified length,
+// keeping the field terminator. The field is not truncated part way
+// through a character. For MARC-8 records the field is also not
+// truncated within an escape sequence or between combining characters
+// and the character they modify, and it ends with a return to the
+// default character sets.
+func truncateField(b []byte, length int, unicode bool) []byte {
+
+	n := length - 1
+	var reset []byte
+	if unicode {
+		for n > 0 && !utf8.RuneStart(b[n]) {
+			n--
+		}
+	} else {
+		n, reset = marc8Truncate(b[:len(b)-1], n)
+	}
+	// Do not leave a dangling subfield delimiter
+	for n > 0 && (b[n-1] == delimiter) {
+		n--
+	}
+
+	t := make([]byte, n, n+len(reset)+1)
+	copy(t, b[:n])
+	t = append(t, reset...)
+	return append(t, fieldTerminator)
+}
+
+// assembleRecord builds a MARC record from the leader and the encoded
+// fields, in the original field order
+func assembleRecord(leader string, cfs, dfs []encodedField) (marc []byte) {
+
+	var rawDir []byte
+	var data []byte
+
+	cfKeys := make([]int, len(cfs))
+	for i, f := range cfs {
+		cfKeys[i] = f.seq
+	}
+	dfKeys := make([]int, len(dfs))
+	for i, f := range dfs {
+		dfKeys[i] = f.seq
+	}
+
+	for _, p := range mergeOrder(cfKeys, dfKeys) {
+		var f encodedField
+		if p.control {
+			f = cfs[p.index]
+		} else {
+			f = dfs[p.index]
+		}
+		rawDir = append(rawDir, []byte(f.tag)...)
+		rawDir = append(rawDir, []byte(fmt.Sprintf("%04d", len(f.data)))...)
+		rawDir = append(rawDir, []byte(fmt.Sprintf("%05d", len(data)))...)
+		data = append(data, f.data...)
+	}
+	rawDir = append(rawDir, fieldTerminator)
+
+	// Build the leader
+	recLen := []byte(fmt.Sprintf("%05d", leaderLen+len(rawDir)+len(data)+1))
+	recBaseDataAddress := []byte(fmt.Sprintf("%05d", leaderLen+len(rawDir)))
+
+	ldr := []byte(leader)
+	for i := 0; i <= 4; i++ {
+		ldr[i] = recLen[i]
+		ldr[i+12] = recBaseDataAddress[i]
+	}
+
+	// Final assembly
+	marc = append(marc, ldr...)
+	marc = append(marc, rawDir...)
+	marc = append(marc, data...)
+	marc = append(marc, recordTerminator)
+
+	return marc
+}
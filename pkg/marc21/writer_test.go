@@ -0,0 +1,173 @@
+package marc21
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+
+	var b bytes.Buffer
+	w := NewWriter(&b)
+
+	for _, id := range []string{"1", "2"} {
+		if err := w.Write(newTestRecord(id)); err != nil {
+			t.Fatalf("Write() failed: %q", err)
+		}
+	}
+
+	if w.Records() != 2 || w.Bytes() != int64(b.Len()) {
+		t.Errorf("Records() = %d, Bytes() = %d", w.Records(), w.Bytes())
+	}
+	if !bytes.Equal(b.Bytes(), newTestMARC(t, "1", "2")) {
+		t.Errorf("Write() wrote %q", b.Bytes())
+	}
+}
+
+func TestWriterValidation(t *testing.T) {
+
+	tests := []struct {
+		edit func(rec *Record)
+		tag  string
+		err  error
+	}{
+		{func(rec *Record) { rec.Leader.Text = "00000nam" }, "", ErrBadLeader},
+		{func(rec *Record) { rec.Controlfields[0].Tag = "1" }, "1", ErrBadTag},
+		{func(rec *Record) { rec.Datafields[0].Tag = "1000" }, "1000", ErrBadTag},
+		{func(rec *Record) { rec.Datafields[0].Ind1 = "10" }, "100", ErrBadIndicator},
+		{func(rec *Record) { rec.Datafields[0].Subfields[0].Text = strings.Repeat("x", 10000) }, "100", ErrFieldTooLong},
+		{func(rec *Record) {
+			for i := 0; i < 20; i++ {
+				rec.Datafields = append(rec.Datafields, &Datafield{Tag: "500", Subfields: []*Subfield{
+					{Code: "a", Text: strings.Repeat("x", 9000)},
+				}})
+			}
+		}, "", ErrRecordTooLong},
+	}
+
+	for i, test := range tests {
+		rec := newTestRecord("1")
+		test.edit(rec)
+
+		var b bytes.Buffer
+		w := NewWriter(&b)
+		err := w.Write(rec)
+
+		var ve *ValidationError
+		if !errors.Is(err, test.err) || !errors.As(err, &ve) || ve.Tag != test.tag || ve.Record != 0 {
+			t.Errorf("test %d: Write() = %v, expected %v", i, err, test.err)
+		}
+		if b.Len() != 0 || w.Records() != 0 {
+			t.Errorf("test %d: Write() wrote an invalid record", i)
+		}
+	}
+}
+
+func TestWriterPolicies(t *testing.T) {
+
+	rec := newTestRecord("1")
+	rec.Datafields[0].Subfields[0].Text = strings.Repeat("x", 10000)
+	for i := 0; i < 20; i++ {
+		rec.Datafields = append(rec.Datafields, &Datafield{Tag: "505", Subfields: []*Subfield{
+			{Code: "a", Text: strings.Repeat("y", 5000)},
+			{Code: "a", Text: strings.Repeat("z", 5000)},
+		}})
+	}
+
+	for _, policy := range []LimitPolicy{TruncateOversize, SplitOversize} {
+		var b bytes.Buffer
+		w := NewWriter(&b)
+		w.Policy = policy
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("policy %d: Write() failed: %q", policy, err)
+		}
+
+		rdr := NewReader(&b)
+		var recs []*Record
+		for rdr.Next() {
+			recs = append(recs, rdr.Record())
+		}
+		if rdr.Err() != nil || len(recs) != w.Records() {
+			t.Fatalf("policy %d: read %d records, Err() = %v", policy, len(recs), rdr.Err())
+		}
+
+		count := 0
+		for _, r := range recs {
+			if r.GetControlfield("001") != "1" {
+				t.Errorf("policy %d: record is missing the 001", policy)
+			}
+			count += len(r.GetDatafields("505"))
+			if len(r.GetDatafields("100")) == 1 && len(r.GetDatafields("100")[0].Subfields[0].Text) != maxFieldSize-5 {
+				t.Errorf("policy %d: 100 field was not truncated", policy)
+			}
+		}
+
+		dropped, truncated100 := 0, false
+		for _, tr := range w.Truncated() {
+			switch {
+			case tr.Dropped:
+				dropped++
+			case tr.Length <= maxFieldSize:
+				t.Errorf("policy %d: unexpected truncation %v", policy, tr)
+			case tr.Tag == "100":
+				truncated100 = true
+			}
+		}
+		if !truncated100 {
+			t.Errorf("policy %d: 100 field truncation was not reported", policy)
+		}
+		if w.TruncatedRecords() != 1 {
+			t.Errorf("policy %d: TruncatedRecords() = %d", policy, w.TruncatedRecords())
+		}
+
+		switch policy {
+		case TruncateOversize:
+			if len(recs) != 1 || count >= 20 || count+dropped != 20 {
+				t.Errorf("policy %d: wrote %d records with %d 505 fields, %d dropped", policy, len(recs), count, dropped)
+			}
+		case SplitOversize:
+			if len(recs) < 2 || count != 40 || dropped != 0 {
+				t.Errorf("policy %d: wrote %d records with %d 505 fields", policy, len(recs), count)
+			}
+		}
+	}
+}
+
+func TestTruncateFieldMARC8(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		field string
+	}{
+		// Cyrillic, ending part way through the escape back to Latin
+		{"escape", "\x1fa" + strings.Repeat("a", 9985) + "\x1b(NABCDEFGH\x1b(B"},
+		// EACC triplets that do not line up with the cut
+		{"EACC", "\x1fa" + strings.Repeat("a", 9980) + "\x1b$1" + strings.Repeat("\x21\x30\x21", 10) + "\x1b(B"},
+		// A combining mark must stay with the character it modifies
+		{"combining", "\x1fa" + strings.Repeat("a", 9995) + "\xe2e\xe2e\xe2e"},
+	}
+
+	for _, tc := range tests {
+		b := truncateField([]byte(tc.field+"\x1e"), maxFieldSize, false)
+		if len(b) > maxFieldSize || b[len(b)-1] != fieldTerminator {
+			t.Errorf("%s: truncated to %d bytes", tc.name, len(b))
+			continue
+		}
+		s := string(b[:len(b)-1])
+		if !strings.HasPrefix(tc.field, strings.TrimSuffix(strings.TrimSuffix(s, "\x1b(B"), "\x1bs")) {
+			t.Errorf("%s: truncated field is not a prefix of the field", tc.name)
+		}
+
+		// The field should end in the default character sets
+		d := newMARC8Decoder()
+		d.decode(s)
+		if d.g0 != csBasicLatin || d.g1 != csExtendedLatin || len(d.pending) != 0 {
+			t.Errorf("%s: truncated field ends in G0 %q, G1 %q", tc.name, d.g0, d.g1)
+		}
+		if (tc.name != "EACC" || len(eaccToUCS) > 0) && strings.ContainsRune(MARC8ToUTF8(s), '\ufffd') {
+			t.Errorf("%s: truncated field does not decode", tc.name)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// marcxmlNamespace is the namespace of MARCXML elements
+const marcxmlNamespace = "http://www.loc.gov/MARC21/slim"
+
+// XMLReader reads MARC records from a MARCXML document one record at a
+// time, so that documents of any size may be read without loading the
+// whole document into memory. It is used in the same manner as the
+// Reader:
+//
+//	rdr := marc21.NewXMLReader(f)
+//	for rdr.Next() {
+//		rec := rdr.Record()
+//		...
+//	}
+//	if err := rdr.Err(); err != nil {
+//		...
+//	}
+//
+// Both prefixed (<marc:record>) and unprefixed (<record>) elements are
+// read, and the document root may be either a <collection> or a single
+// <record>. Records that are embedded in other documents (such as
+// OAI-PMH responses) are also found, provided that they are either in
+// the MARCXML namespace or in no namespace. Characters that were escaped
+// by an XMLWriter using EscapeIllegal are restored.
+type XMLReader struct {
+	dec      *xml.Decoder
+	rec      *Record
+	err      error
+	index    int
+	unescape bool
+}
+
+// NewXMLReader returns a new XMLReader that reads MARCXML from r
+func NewXMLReader(r io.Reader) *XMLReader {
+	return &XMLReader{
+		dec:   xml.NewDecoder(r),
+		index: -1,
+	}
+}
+
+// Next advances the XMLReader to the next record, which will then be
+// available through the Record method. It returns false when there are
+// no more records, either by reaching the end of the input or due to an
+// error.
+func (r *XMLReader) Next() bool {
+
+	if r.err != nil {
+		return false
+	}
+
+	r.rec = nil
+
+	for {
+		offset := r.dec.InputOffset()
+		tok, err := r.dec.Token()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			r.err = r.xmlError(offset, err)
+			return false
+		}
+
+		if isXMLEscapeInst(tok) {
+			r.unescape = true
+			continue
+		}
+
+		se, ok := tok.(xml.StartElement)
+		if !ok || !isMARCXMLElement(se.Name, "record") {
+			continue
+		}
+
+		rec := new(Record)
+		err = r.dec.DecodeElement(rec, &se)
+		if err != nil {
+			r.err = r.xmlError(offset, unexpectedEOF(err))
+			return false
+		}
+		if r.unescape {
+			rec.unescapeXML()
+		}
+
+		r.rec = rec
+		r.index++
+		return true
+	}
+}
+
+// isMARCXMLElement indicates whether an element name is that of the
+// specified MARCXML element. Elements that have no namespace, or that
+// use an undeclared "marc" prefix, are accepted as MARCXML.
+func isMARCXMLElement(name xml.Name, local string) bool {
+	if name.Local != local {
+		return false
+	}
+	switch name.Space {
+	case marcxmlNamespace, "", "marc":
+		return true
+	}
+	return false
+}
+
+// xmlError returns a ParseError for an error in reading the record
+// that starts at the specified offset
+func (r *XMLReader) xmlError(offset int64, err error) error {
+	return &ParseError{Record: r.index + 1, Offset: offset, Err: err}
+}
+
+// Record returns the current record
+func (r *XMLReader) Record() *Record {
+	return r.rec
+}
+
+// Err returns the first error that was encountered by the XMLReader.
+// The end of the input is not considered an error.
+func (r *XMLReader) Err() error {
+	return r.err
+}
+
+// Index returns the zero-based position of the current record in the
+// input
+func (r *XMLReader) Index() int {
+	return r.index
+}
+
+// isXMLEscapeInst indicates whether a token is the processing
+// instruction that marks a document as having escaped characters
+func isXMLEscapeInst(tok xml.Token) bool {
+	pi, ok := tok.(xml.ProcInst)
+	return ok && pi.Target == xmlEscapeTarget && string(bytes.TrimSpace(pi.Inst)) == xmlEscapeInst
+}
+
+// unescapeXML restores the characters of a string that were escaped by
+// an XMLWriter using EscapeIllegal
+func unescapeXML(s string) string {
+
+	if strings.IndexFunc(s, isXMLEscape) < 0 {
+		return s
+	}
+
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if isXMLEscape(r) {
+			b = append(b, byte(r-xmlEscapeBase))
+		} else {
+			b = utf8.AppendRune(b, r)
+		}
+	}
+	return string(b)
+}
+
+// unescapeXML restores the characters of a record that were escaped by
+// an XMLWriter using EscapeIllegal
+func (rec *Record) unescapeXML() {
+	rec.Leader.Text = unescapeXML(rec.Leader.Text)
+	for _, cf := range rec.Controlfields {
+		cf.Tag = unescapeXML(cf.Tag)
+		cf.Text = unescapeXML(cf.Text)
+	}
+	for _, df := range rec.Datafields {
+		df.Tag = unescapeXML(df.Tag)
+		df.Ind1 = unescapeXML(df.Ind1)
+		df.Ind2 = unescapeXML(df.Ind2)
+		for _, sf := range df.Subfields {
+			sf.Code = unescapeXML(sf.Code)
+			sf.Text = unescapeXML(sf.Text)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package marc21
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestXMLReader(t *testing.T) {
+
+	docs := map[string]string{
+		"prefixed": `<?xml version="1.0" encoding="UTF-8"?>
+<marc:collection xmlns:marc="http://www.loc.gov/MARC21/slim">
+  <marc:record>
+    <marc:leader>00000nam a2200000 a 4500</marc:leader>
+    <marc:controlfield tag="001">1</marc:controlfield>
+    <marc:datafield tag="245" ind1="1" ind2="0">
+      <marc:subfield code="a">A title &amp; more</marc:subfield>
+    </marc:datafield>
+  </marc:record>
+  <marc:record>
+    <marc:leader>00000nam a2200000 a 4500</marc:leader>
+    <marc:controlfield tag="001">2</marc:controlfield>
+  </marc:record>
+</marc:collection>`,
+		"default namespace": `<collection xmlns="http://www.loc.gov/MARC21/slim">
+  <record>
+    <leader>00000nam a2200000 a 4500</leader>
+    <controlfield tag="001">1</controlfield>
+    <datafield tag="245" ind1="1" ind2="0">
+      <subfield code="a">A title &amp; more</subfield>
+    </datafield>
+  </record>
+  <record><controlfield tag="001">2</controlfield></record>
+</collection>`,
+		"embedded": `<OAI-PMH xmlns="http://www.openarchives.org/OAI/2.0/"><ListRecords>
+  <record><metadata>
+    <marc:record xmlns:marc="http://www.loc.gov/MARC21/slim">
+      <marc:controlfield tag="001">1</marc:controlfield>
+      <marc:datafield tag="245" ind1="1" ind2="0">
+        <marc:subfield code="a">A title &amp; more</marc:subfield>
+      </marc:datafield>
+    </marc:record>
+  </metadata></record>
+  <record><metadata>
+    <record xmlns="http://www.loc.gov/MARC21/slim"><controlfield tag="001">2</controlfield></record>
+  </metadata></record>
+</ListRecords></OAI-PMH>`,
+	}
+
+	for name, doc := range docs {
+		rdr := NewXMLReader(strings.NewReader(doc))
+
+		var ids []string
+		for rdr.Next() {
+			rec := rdr.Record()
+			ids = append(ids, rec.GetControlfield("001"))
+			if rdr.Index() == 0 {
+				dfs := rec.GetDatafields("245")
+				if len(dfs) != 1 || dfs[0].GetSubfields("a")[0].Text != "A title & more" {
+					t.Errorf("%s: Record() returned %v", name, rec)
+				}
+			}
+		}
+
+		if err := rdr.Err(); err != nil {
+			t.Errorf("%s: Err() = %q", name, err)
+		}
+		if len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+			t.Errorf("%s: read records %v", name, ids)
+		}
+	}
+}
+
+func TestXMLReaderSingleRecord(t *testing.T) {
+
+	doc := `<?xml version="1.0"?>
+<record xmlns="http://www.loc.gov/MARC21/slim">
+  <leader>00000nam a2200000 a 4500</leader>
+  <controlfield tag="001">1</controlfield>
+</record>`
+
+	rdr := NewXMLReader(strings.NewReader(doc))
+	count := 0
+	for rdr.Next() {
+		count++
+		if rdr.Record().Leader.Text != "00000nam a2200000 a 4500" {
+			t.Errorf("Record() returned %v", rdr.Record())
+		}
+	}
+	if count != 1 || rdr.Err() != nil {
+		t.Errorf("Next() read %d records, Err() = %v", count, rdr.Err())
+	}
+}
+
+func TestXMLReaderTruncated(t *testing.T) {
+
+	doc := `<collection>
+  <record><controlfield tag="001">1</controlfield></record>
+  <record><controlfield tag="001">2</controlf`
+
+	rdr := NewXMLReader(strings.NewReader(doc))
+	count := 0
+	for rdr.Next() {
+		count++
+	}
+
+	if count != 1 {
+		t.Errorf("Next() read %d records, expected 1", count)
+	}
+	pe, ok := rdr.Err().(*ParseError)
+	if !ok || pe.Record != 1 {
+		t.Errorf("Err() = %v", rdr.Err())
+	}
+}
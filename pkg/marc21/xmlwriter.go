@@ -0,0 +1,348 @@
+// Copyright 2017-2018 Gregory Siems. All rights reserved.
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package marc21
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// IllegalCharMode determines how the XMLWriter handles characters that
+// may not appear in an XML 1.0 document (most of the C0 control
+// characters, such as stray subfield delimiters, plus any invalid UTF-8)
+type IllegalCharMode int
+
+const (
+	// StripIllegal removes illegal characters from the output
+	StripIllegal IllegalCharMode = iota
+	// EscapeIllegal writes each byte of an illegal character (or of
+	// invalid UTF-8) as the private use character U+F0XX, where XX is
+	// the value of the byte, so that no data is lost. Characters that
+	// are already in that range are escaped in the same way. The
+	// document is marked with a <?go-marc21 escape-illegal?>
+	// processing instruction, and the XMLReader and ReadXML restore the
+	// original characters when reading a document so marked.
+	EscapeIllegal
+)
+
+// ErrSingleRecord is returned when attempting to write more than one
+// record to an XMLWriter that has SingleRecord set
+var ErrSingleRecord = errors.New("marc21: only one record may be written to a single record document")
+
+const marcxmlSchemaLocation = "http://www.loc.gov/MARC21/slim http://www.loc.gov/standards/marcxml/schema/MARC21slim.xsd"
+
+// XMLWriter writes MARC records as a MARCXML document to an io.Writer.
+// The options are set after calling NewXMLWriter and before the first
+// call to Write:
+//
+//	w := marc21.NewXMLWriter(f)
+//	w.Prefix = ""
+//	for _, rec := range recs {
+//		err := w.Write(rec)
+//		...
+//	}
+//	err := w.Close()
+//
+// The defaults produce the same document as Collection.AsXML.
+type XMLWriter struct {
+	// Prefix is the namespace prefix used for the MARCXML elements. The
+	// default is "marc". When empty the MARCXML namespace is declared as
+	// the default namespace and the elements are unprefixed.
+	Prefix string
+	// Indent is the string written for each level of nesting. The
+	// default is a tab. When empty the records are written without
+	// indentation or line breaks.
+	Indent string
+	// OmitSchemaLocation indicates that the xsi:schemaLocation is not to
+	// be written on the root element
+	OmitSchemaLocation bool
+	// SingleRecord indicates that the root element of the document is a
+	// single <record> rather than a <collection>
+	SingleRecord bool
+	// IllegalChars determines how characters that are illegal in XML 1.0
+	// are handled
+	IllegalChars IllegalCharMode
+
+	w       *bufio.Writer
+	started bool
+	count   int
+	err     error
+}
+
+// NewXMLWriter returns a new XMLWriter that writes to w
+func NewXMLWriter(w io.Writer) *XMLWriter {
+	return &XMLWriter{
+		Prefix: "marc",
+		Indent: "\t",
+		w:      bufio.NewWriter(w),
+	}
+}
+
+// Write writes a record to the document, preceded by the XML
+// declaration and opening root element if it is the first record
+func (w *XMLWriter) Write(rec *Record) error {
+
+	if w.err != nil {
+		return w.err
+	}
+
+	if w.SingleRecord {
+		if w.count > 0 {
+			return ErrSingleRecord
+		}
+		w.writeDeclaration()
+		w.writeRecord(rec, 0, true)
+	} else {
+		if !w.started {
+			w.writeDeclaration()
+			w.writeRoot("collection")
+		}
+		w.writeRecord(rec, 1, false)
+	}
+
+	w.started = true
+	w.count++
+
+	return w.err
+}
+
+// Close writes the closing root element and flushes the output. It
+// does not close the underlying io.Writer.
+func (w *XMLWriter) Close() error {
+
+	if w.err != nil {
+		return w.err
+	}
+
+	if !w.SingleRecord {
+		if !w.started {
+			w.writeDeclaration()
+			w.writeRoot("collection")
+		}
+		w.writeString("</" + w.name("collection") + ">\n")
+	}
+	w.started = true
+
+	if w.err != nil {
+		return w.err
+	}
+	w.err = w.w.Flush()
+	return w.err
+}
+
+// Flush writes any buffered data to the underlying io.Writer
+func (w *XMLWriter) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = w.w.Flush()
+	return w.err
+}
+
+// writeString writes a string to the output, retaining the first
+// error encountered
+func (w *XMLWriter) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.w.WriteString(s)
+}
+
+// name returns the (possibly prefixed) name of an element
+func (w *XMLWriter) name(local string) string {
+	if w.Prefix == "" {
+		return local
+	}
+	return w.Prefix + ":" + local
+}
+
+// newline ends a line of output when indenting
+func (w *XMLWriter) newline() {
+	if w.Indent != "" {
+		w.writeString("\n")
+	}
+}
+
+// indent writes the indentation for the specified depth
+func (w *XMLWriter) indent(depth int) {
+	if w.Indent != "" {
+		w.writeString(strings.Repeat(w.Indent, depth))
+	}
+}
+
+// writeDeclaration writes the XML declaration, and the processing
+// instruction that marks a document as having escaped characters
+func (w *XMLWriter) writeDeclaration() {
+	w.writeString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	if w.IllegalChars == EscapeIllegal {
+		w.writeString("<?" + xmlEscapeTarget + " " + xmlEscapeInst + "?>\n")
+	}
+}
+
+// writeRoot writes the opening tag, with the namespace declarations,
+// of the root element
+func (w *XMLWriter) writeRoot(local string) {
+
+	sep := " "
+	if w.Indent != "" {
+		sep = "\n    "
+	}
+
+	w.writeString("<" + w.name(local))
+	if w.Prefix == "" {
+		w.writeString(fmt.Sprintf(" xmlns=%q", marcxmlNamespace))
+	} else {
+		w.writeString(fmt.Sprintf(" xmlns:%s=%q", w.Prefix, marcxmlNamespace))
+	}
+	if !w.OmitSchemaLocation {
+		w.writeString(sep + `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`)
+		w.writeString(sep + fmt.Sprintf("xsi:schemaLocation=%q", marcxmlSchemaLocation))
+	}
+	w.writeString(">")
+	w.newline()
+}
+
+// writeRecord writes a record element at the specified depth
+func (w *XMLWriter) writeRecord(rec *Record, depth int, root bool) {
+
+	w.indent(depth)
+	if root {
+		w.writeRoot("record")
+	} else {
+		w.writeString("<" + w.name("record") + ">")
+		w.newline()
+	}
+
+	w.indent(depth + 1)
+	w.writeString("<" + w.name("leader") + ">")
+	w.writeString(w.escape(rec.Leader.GetText(), false))
+	w.writeString("</" + w.name("leader") + ">")
+	w.newline()
+
+	for _, p := range rec.fieldOrder() {
+		if p.control {
+			w.writeControlfield(rec.Controlfields[p.index], depth+1)
+		} else {
+			w.writeDatafield(rec.Datafields[p.index], depth+1)
+		}
+	}
+
+	w.indent(depth)
+	w.writeString("</" + w.name("record") + ">")
+	if root || w.Indent != "" {
+		w.writeString("\n")
+	}
+}
+
+// writeAttr writes an attribute of an element
+func (w *XMLWriter) writeAttr(name, value string) {
+	w.writeString(" " + name + `="` + w.escape(value, true) + `"`)
+}
+
+// escape escapes the special characters in text or attribute values
+// and handles any characters that are illegal in XML 1.0
+func (w *XMLWriter) escape(s string, attr bool) string {
+
+	var b strings.Builder
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if (r == utf8.RuneError && size == 1) || !isXMLChar(r) ||
+			(w.IllegalChars == EscapeIllegal && isXMLEscape(r)) {
+			if w.IllegalChars == EscapeIllegal {
+				for _, c := range []byte(s[i : i+size]) {
+					b.WriteRune(xmlEscapeBase + rune(c))
+				}
+			}
+			i += size
+			continue
+		}
+		i += size
+
+		switch {
+		case r == '&':
+			b.WriteString("&amp;")
+		case r == '<':
+			b.WriteString("&lt;")
+		case r == '>':
+			b.WriteString("&gt;")
+		case r == '"' && attr:
+			b.WriteString("&quot;")
+		case r == '\r' || (attr && (r == '\n' || r == '\t')):
+			// Escaped so that they survive end-of-line and attribute
+			// value normalization
+			fmt.Fprintf(&b, "&#x%X;", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// The escaping of illegal characters (see EscapeIllegal)
+const (
+	xmlEscapeBase   = rune(0xF000)
+	xmlEscapeTarget = "go-marc21"
+	xmlEscapeInst   = "escape-illegal"
+)
+
+// isXMLEscape indicates whether a character is one of those used for
+// escaping the bytes of illegal characters
+func isXMLEscape(r rune) bool {
+	return r >= xmlEscapeBase && r <= xmlEscapeBase+0xFF
+}
+
+// isXMLChar indicates whether a character is allowed in an XML 1.0
+// document
+//
+//	Char ::= #x9 | #xA | #xD | [#x20-#xD7FF] | [#xE000-#xFFFD] | [#x10000-#x10FFFF]
+func isXMLChar(r rune) bool {
+	return r == 0x09 || r == 0x0A || r == 0x0D ||
+		(r >= 0x20 && r <= 0xD7FF) ||
+		(r >= 0xE000 && r <= 0xFFFD) ||
+		(r >= 0x10000 && r <= 0x10FFFF)
+}
+
+// writeControlfield writes a controlfield element at the specified depth
+func (w *XMLWriter) writeControlfield(cf *Controlfield, depth int) {
+	w.indent(depth)
+	w.writeString("<" + w.name("controlfield"))
+	w.writeAttr("tag", cf.GetTag())
+	w.writeString(">")
+	w.writeString(w.escape(cf.GetText(), false))
+	w.writeString("</" + w.name("controlfield") + ">")
+	w.newline()
+}
+
+// writeDatafield writes a datafield element, with its subfields, at the
+// specified depth
+func (w *XMLWriter) writeDatafield(df *Datafield, depth int) {
+	w.indent(depth)
+	w.writeString("<" + w.name("datafield"))
+	w.writeAttr("tag", df.GetTag())
+	w.writeAttr("ind1", df.GetInd1())
+	w.writeAttr("ind2", df.GetInd2())
+	w.writeString(">")
+	w.newline()
+
+	for _, sf := range df.Subfields {
+		w.indent(depth + 1)
+		w.writeString("<" + w.name("subfield"))
+		w.writeAttr("code", sf.GetCode())
+		w.writeString(">")
+		w.writeString(w.escape(sf.GetText(), false))
+		w.writeString("</" + w.name("subfield") + ">")
+		w.newline()
+	}
+
+	w.indent(depth)
+	w.writeString("</" + w.name("datafield") + ">")
+	w.newline()
+}
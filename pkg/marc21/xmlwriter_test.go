@@ -0,0 +1,127 @@
+package marc21
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestXMLWriterRoundTrip(t *testing.T) {
+
+	type setup func(w *XMLWriter)
+
+	setups := map[string]setup{
+		"defaults":          func(w *XMLWriter) {},
+		"default namespace": func(w *XMLWriter) { w.Prefix = "" },
+		"compact":           func(w *XMLWriter) { w.Indent = ""; w.OmitSchemaLocation = true },
+	}
+
+	for name, fn := range setups {
+		var b bytes.Buffer
+		w := NewXMLWriter(&b)
+		fn(w)
+
+		for _, id := range []string{"1", "2"} {
+			rec := newTestRecord(id)
+			rec.Datafields[1].Subfields[0].Text = `"Quoted" & <bracketed>`
+			if err := w.Write(rec); err != nil {
+				t.Fatalf("%s: Write() failed: %q", name, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("%s: Close() failed: %q", name, err)
+		}
+
+		rdr := NewXMLReader(&b)
+		count := 0
+		for rdr.Next() {
+			count++
+			dfs := rdr.Record().GetDatafields("245")
+			if len(dfs) != 1 || dfs[0].GetSubfields("a")[0].Text != `"Quoted" & <bracketed>` {
+				t.Errorf("%s: read %v", name, rdr.Record())
+			}
+		}
+		if count != 2 || rdr.Err() != nil {
+			t.Errorf("%s: read %d records, Err() = %v", name, count, rdr.Err())
+		}
+	}
+}
+
+func TestXMLWriterOptions(t *testing.T) {
+
+	var b bytes.Buffer
+	w := NewXMLWriter(&b)
+	w.Prefix = ""
+	w.OmitSchemaLocation = true
+	w.SingleRecord = true
+
+	if err := w.Write(newTestRecord("1")); err != nil {
+		t.Fatalf("Write() failed: %q", err)
+	}
+	if err := w.Write(newTestRecord("2")); err != ErrSingleRecord {
+		t.Errorf("Write() = %v, expected %v", err, ErrSingleRecord)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() failed: %q", err)
+	}
+
+	s := b.String()
+	if !strings.Contains(s, "\n<record xmlns=\"http://www.loc.gov/MARC21/slim\">\n") {
+		t.Errorf("unexpected root element in %s", s)
+	}
+	if strings.Contains(s, "collection") || strings.Contains(s, "schemaLocation") || strings.Contains(s, "marc:") {
+		t.Errorf("unexpected output %s", s)
+	}
+}
+
+func TestXMLWriterIllegalChars(t *testing.T) {
+
+	rec := newTestRecord("1")
+	rec.Datafields[0].Subfields[0].Text = "Smith,\x1fdJohn\x00.\xff\uf041\ufffe"
+
+	expected := map[IllegalCharMode]string{
+		StripIllegal:  "Smith,dJohn.\uf041",
+		EscapeIllegal: "Smith,\uf01fdJohn\uf000.\uf0ff\uf0ef\uf081\uf081\uf0ef\uf0bf\uf0be",
+	}
+
+	for mode, text := range expected {
+		var b bytes.Buffer
+		w := NewXMLWriter(&b)
+		w.IllegalChars = mode
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("Write() failed: %q", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close() failed: %q", err)
+		}
+
+		if !strings.Contains(b.String(), ">"+text+"<") {
+			t.Errorf("mode %d: expected %q in %s", mode, text, b.String())
+		}
+
+		// The escaped characters are restored when read back
+		if mode != EscapeIllegal {
+			continue
+		}
+		doc, err := ReadXML(bytes.NewReader(b.Bytes()))
+		if err != nil || len(doc.Records) != 1 {
+			t.Fatalf("ReadXML() returned %d records, %v", len(doc.Records), err)
+		}
+		if got := doc.Records[0].Datafields[0].Subfields[0].Text; got != rec.Datafields[0].Subfields[0].Text {
+			t.Errorf("ReadXML() = %q, expected %q", got, rec.Datafields[0].Subfields[0].Text)
+		}
+		rdr := NewXMLReader(bytes.NewReader(b.Bytes()))
+		if !rdr.Next() {
+			t.Fatalf("XMLReader.Next() failed: %v", rdr.Err())
+		}
+		if got := rdr.Record().Datafields[0].Subfields[0].Text; got != rec.Datafields[0].Subfields[0].Text {
+			t.Errorf("XMLReader = %q, expected %q", got, rec.Datafields[0].Subfields[0].Text)
+		}
+	}
+
+	// Documents that are not marked as escaped are read as is
+	doc, err := ReadXML(strings.NewReader("<collection><record><leader>\uf041</leader></record></collection>"))
+	if err != nil || doc.Records[0].Leader.Text != "\uf041" {
+		t.Errorf("ReadXML() = %v, %v", doc.Records, err)
+	}
+}